@@ -6,13 +6,16 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"strconv"
 
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
 	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
 
 	api "github.com/FantasyRL/go-mcp-demo/api/model/api"
 	"github.com/FantasyRL/go-mcp-demo/api/pack"
 	"github.com/FantasyRL/go-mcp-demo/internal/host/application"
+	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
 	"github.com/cloudwego/hertz/pkg/app"
 	consts "github.com/cloudwego/hertz/pkg/protocol/consts"
 	"github.com/cloudwego/hertz/pkg/protocol/sse"
@@ -55,7 +58,7 @@ func Chat(ctx context.Context, c *app.RequestContext) {
 		pack.RespError(c, errno.AuthInvalid)
 		return
 	}
-	msg, err := application.NewHost(ctx, clientSet).ChatOpenAI(uid, req.ConversationID, req.Message, imageData)
+	msg, err := application.NewHost(ctx, clientSet).ChatOpenAI(uid, req.ConversationID, req.Message, imageData, req.Resources)
 	if err != nil {
 		pack.RespError(c, err)
 		return
@@ -97,25 +100,38 @@ func ChatSSE(ctx context.Context, c *app.RequestContext) {
 	w := sse.NewWriter(c)
 	defer w.Close()
 
-	emit := func(_ string, v any) error {
-		switch x := v.(type) {
-		case string: // 用于 [DONE]
-			return w.WriteEvent("", "", []byte(x))
-		case json.RawMessage:
-			return w.WriteEvent("", "", x)
-		default:
-			b, _ := json.Marshal(v)
-			return w.WriteEvent("", "", b)
-		}
+	writeError := func(err error) {
+		b, _ := json.Marshal(application.NewChatStreamErrorEvent(err))
+		_ = w.WriteEvent("", constant.SSEEventError, b)
 	}
 	uid, ok := utils.ExtractStuID(ctx)
 	if !ok {
-		_ = emit("error", map[string]any{"error": "unauthorized"})
+		writeError(errno.AuthError)
 		return
 	}
 
-	if err := application.NewHost(ctx, clientSet).StreamChatOpenAI(ctx, uid, req.ConversationID, req.Message, imageData, emit); err != nil {
-		_ = emit("error", map[string]any{"error": err.Error()})
+	host := application.NewHost(ctx, clientSet)
+	// 携带 Last-Event-ID 视为断线重连：不再发起新一轮生成，只续传之后的事件
+	var lastEventID int64
+	if id := sse.GetLastEventID(&c.Request); id != "" {
+		if lastEventID, err = strconv.ParseInt(id, 10, 64); err != nil {
+			writeError(errno.Errorf(errno.ParamInvalidCode, "invalid Last-Event-ID: %s", id))
+			return
+		}
+	} else if err = host.StartChatStream(uid, req.ConversationID, req.Message, imageData, req.Resources); err != nil {
+		writeError(err)
+		return
+	}
+
+	write := func(ev *repository.ChatStreamEvent) error {
+		var id string
+		if ev.ID > 0 {
+			id = strconv.FormatInt(ev.ID, 10)
+		}
+		return w.WriteEvent(id, ev.Event, ev.Data)
+	}
+	if err = host.AttachChatStream(ctx, uid, req.ConversationID, lastEventID, write); err != nil {
+		writeError(err)
 		return
 	}
 }
@@ -706,3 +722,138 @@ func DailySchedule(ctx context.Context, c *app.RequestContext) {
 	}
 	pack.RespData(c, resp)
 }
+
+// GetUserUsage .
+// @router /api/v1/user/usage [GET]
+func GetUserUsage(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetUserUsageRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	uid, ok := utils.ExtractStuID(ctx)
+	if !ok {
+		pack.RespError(c, errno.AuthInvalid)
+		return
+	}
+
+	usage, err := application.NewHost(ctx, clientSet).GetUserUsage(uid)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp := &api.GetUserUsageResponse{
+		Usage: usage,
+	}
+	pack.RespData(c, resp)
+}
+
+// ChatApproval .
+// @router /api/v1/chat/approval [POST]
+func ChatApproval(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ChatApprovalRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	w := sse.NewWriter(c)
+	defer w.Close()
+
+	writeError := func(err error) {
+		b, _ := json.Marshal(application.NewChatStreamErrorEvent(err))
+		_ = w.WriteEvent("", constant.SSEEventError, b)
+	}
+	uid, ok := utils.ExtractStuID(ctx)
+	if !ok {
+		writeError(errno.AuthError)
+		return
+	}
+
+	// 恢复后的生成与 ChatSSE 共用事件缓冲，断线后同样可以通过 ChatSSE 携带 Last-Event-ID 续传
+	host := application.NewHost(ctx, clientSet)
+	err = host.ResumeChatStream(uid, req.ConversationID, application.ToolApproval{
+		Approved:    req.Approved,
+		ToolCallIDs: req.GetToolCallIds(),
+		Reason:      req.GetReason(),
+	})
+	if err != nil {
+		writeError(err)
+		return
+	}
+
+	write := func(ev *repository.ChatStreamEvent) error {
+		var id string
+		if ev.ID > 0 {
+			id = strconv.FormatInt(ev.ID, 10)
+		}
+		return w.WriteEvent(id, ev.Event, ev.Data)
+	}
+	if err = host.AttachChatStream(ctx, uid, req.ConversationID, 0, write); err != nil {
+		writeError(err)
+		return
+	}
+}
+
+// ListMCPPrompts .
+// @router /api/v1/mcp/prompts [GET]
+func ListMCPPrompts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListMCPPromptsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if _, ok := utils.ExtractStuID(ctx); !ok {
+		pack.RespError(c, errno.AuthInvalid)
+		return
+	}
+
+	prompts, err := application.NewHost(ctx, clientSet).ListMCPPrompts()
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp := &api.ListMCPPromptsResponse{
+		Prompts: pack.BuildMCPPromptList(prompts),
+	}
+	pack.RespData(c, resp)
+}
+
+// ListMCPResources .
+// @router /api/v1/mcp/resources [GET]
+func ListMCPResources(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListMCPResourcesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	uid, ok := utils.ExtractStuID(ctx)
+	if !ok {
+		pack.RespError(c, errno.AuthInvalid)
+		return
+	}
+
+	resources, err := application.NewHost(ctx, clientSet).ListMCPResources(uid)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+
+	resp := &api.ListMCPResourcesResponse{
+		Resources: pack.BuildMCPResourceList(resources),
+	}
+	pack.RespData(c, resp)
+}
@@ -0,0 +1,495 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+	"github.com/redis/go-redis/v9"
+	. "github.com/smartystreets/goconvey/convey"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+
+	"github.com/FantasyRL/go-mcp-demo/api/model/model"
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_policy"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+)
+
+// testConfig 与部署时一致的最小配置；模型提供方与 MCP 服务在 newTestClientSet 中指向本地桩服务
+const testConfig = `
+mcp:
+  transport: http
+  tool_call:
+    concurrency: 4
+`
+
+// chatDDL docker/sql/init.sql 中对话与用量两张表的 SQLite 版本
+const chatDDL = `
+create table conversations(
+    id              text    primary key,
+    user_id         text    not null,
+    messages        text    not null,
+    is_summarized   integer not null default 0,
+    title           text,
+    compact_summary text    not null default '',
+    compacted_until integer not null default 0,
+    created_at      datetime not null default current_timestamp,
+    updated_at      datetime not null default current_timestamp,
+    deleted_at      datetime
+);
+create table token_usages(
+    id                text    primary key default (lower(hex(randomblob(16)))),
+    user_id           text    not null,
+    conversation_id   text    not null default '',
+    usage_date        date    not null,
+    prompt_tokens     integer not null default 0,
+    completion_tokens integer not null default 0,
+    total_tokens      integer not null default 0,
+    request_count     integer not null default 0,
+    created_at        datetime not null default current_timestamp,
+    updated_at        datetime not null default current_timestamp
+);
+create unique index uk_token_usages_user_conversation_date
+    on token_usages (user_id, conversation_id, usage_date);
+`
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "api-handler")
+	if err != nil {
+		panic(err)
+	}
+	path := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(path, []byte(testConfig), 0o600); err != nil {
+		panic(err)
+	}
+	config.Load(path, "host")
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// stubReply 桩模型一轮的回复
+type stubReply struct {
+	content string
+	calls   []ai_provider.ChatToolCall
+}
+
+// stubModel 本地模拟 OpenAI 兼容的流式 /chat/completions，按顺序返回预设的回复
+type stubModel struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	replies []stubReply
+}
+
+func newStubModel(replies ...stubReply) *stubModel {
+	s := &stubModel{replies: replies}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *stubModel) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	reply := stubReply{content: "done"}
+	if len(s.replies) > 0 {
+		reply, s.replies = s.replies[0], s.replies[1:]
+	}
+	s.mu.Unlock()
+
+	finish := "stop"
+	delta := map[string]any{"content": reply.content}
+	if len(reply.calls) > 0 {
+		finish = "tool_calls"
+		calls := make([]map[string]any, 0, len(reply.calls))
+		for i, tc := range reply.calls {
+			calls = append(calls, map[string]any{
+				"index": i, "id": tc.ID, "type": "function",
+				"function": map[string]any{"name": tc.Name, "arguments": tc.Arguments},
+			})
+		}
+		delta["tool_calls"] = calls
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	chunk := func(choices any, usage any) {
+		b, _ := json.Marshal(map[string]any{
+			"id": "chatcmpl-stub", "object": "chat.completion.chunk", "model": "stub-model",
+			"choices": choices, "usage": usage,
+		})
+		_, _ = fmt.Fprintf(w, "data: %s\n\n", b)
+	}
+	chunk([]map[string]any{{"index": 0, "delta": delta}}, nil)
+	chunk([]map[string]any{{"index": 0, "delta": map[string]any{}, "finish_reason": finish}}, nil)
+	chunk([]any{}, map[string]any{"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15})
+	_, _ = fmt.Fprint(w, "data: [DONE]\n\n")
+}
+
+// newMCPServer 提供两个工具：steps 分两步上报进度，remove 需要用户确认
+func newMCPServer() *httptest.Server {
+	ts := &tool_set.ToolSet{HandlerFunc: map[string]mcpserver.ToolHandlerFunc{}}
+	tool_set.AddTool(ts, "steps", "分两步完成", func(ctx context.Context, _ struct{}) (string, error) {
+		_ = tool_set.ReportProgress(ctx, 1, 2, "half")
+		_ = tool_set.ReportProgress(ctx, 2, 2, "")
+		// 进度可能经 GET 监听流送达，稍等再返回，避免与结果赛跑
+		time.Sleep(100 * time.Millisecond)
+		return "stepped", nil
+	})
+	tool_set.AddTool(ts, "remove", "删除数据", func(context.Context, struct{}) (string, error) {
+		return "removed", nil
+	})
+	core := mcpserver.NewMCPServer("test", "0.0.1", mcpserver.WithToolCapabilities(true))
+	for _, tool := range ts.Tools {
+		core.AddTool(*tool, ts.HandlerFunc[tool.Name])
+	}
+	return httptest.NewServer(mcpserver.NewStreamableHTTPServer(core))
+}
+
+// newTestClientSet 以桩模型、进程内 MCP 服务、miniredis 与 SQLite 组装 handler 使用的 clientSet
+func newTestClientSet(stub *stubModel, mcpURL string, redisAddr string) *base.ClientSet {
+	config.AiProvider.Providers = []config.AiProviderEntry{{
+		Name:    "stub",
+		Type:    constant.AiProviderTypeOpenAI,
+		BaseURL: stub.URL,
+		APIKey:  "test-key",
+		Models:  []config.AiModelConfig{{Name: "stub-model", Tools: true}},
+	}}
+	gormDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Discard})
+	if err != nil {
+		panic(err)
+	}
+	// 内存库每个连接各自独立，只保留一个连接
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		panic(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	if err = gormDB.Exec(chatDDL).Error; err != nil {
+		panic(err)
+	}
+	mcpCli, err := mcp_client.NewMCPClient(mcpURL)
+	if err != nil {
+		panic(err)
+	}
+	policy := tool_policy.NewEngine()
+	if err = policy.Load(config.MCPPolicyConfig{Rules: []config.ToolPolicyRule{
+		{Name: "confirm_remove", Confirm: []string{"remove"}},
+	}}); err != nil {
+		panic(err)
+	}
+	return &base.ClientSet{
+		MCPCli:        mcpCli,
+		AiProviderCli: ai_provider.NewAiProviderClient(),
+		ActualDB:      gormDB,
+		Cache:         redis.NewClient(&redis.Options{Addr: redisAddr}),
+		ToolPolicy:    policy,
+	}
+}
+
+// newTestServer 在本地端口上启动只注册两个流式接口的 hertz 服务，请求头 X-Test-User 代替鉴权中间件写入的用户。
+// SSE 需要真实连接上的分块写出，ut.PerformRequest 不支持
+func newTestServer() (*server.Hertz, string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	h := server.New(server.WithHostPorts(addr), server.WithExitWaitTime(0))
+	login := func(ctx context.Context, c *app.RequestContext) {
+		if user := string(c.GetHeader("X-Test-User")); user != "" {
+			ctx = utils.WithStuID(ctx, user)
+		}
+		c.Next(ctx)
+	}
+	h.POST("/api/v1/chat/sse", login, ChatSSE)
+	h.POST("/api/v1/chat/approval", login, ChatApproval)
+	go func() { _ = h.Run() }()
+	for i := 0; i < 100; i++ {
+		if conn, err := net.Dial("tcp", addr); err == nil {
+			_ = conn.Close()
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return h, "http://" + addr
+}
+
+// sseFrame 响应中的一条 SSE 事件
+type sseFrame struct {
+	ID    string
+	Event string
+	Data  string
+}
+
+func parseSSE(body []byte) []sseFrame {
+	var frames []sseFrame
+	var cur sseFrame
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if cur.Event != "" || cur.Data != "" {
+				frames = append(frames, cur)
+			}
+			cur = sseFrame{}
+		case strings.HasPrefix(line, "id:"):
+			cur.ID = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+		case strings.HasPrefix(line, "event:"):
+			cur.Event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			cur.Data += strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		}
+	}
+	if cur.Event != "" || cur.Data != "" {
+		frames = append(frames, cur)
+	}
+	return frames
+}
+
+func frameEvents(frames []sseFrame) []string {
+	out := make([]string, 0, len(frames))
+	for _, f := range frames {
+		out = append(out, f.Event)
+	}
+	return out
+}
+
+// payloads 每种事件在线上对应的版本化结构
+var payloads = map[string]func() any{
+	constant.SSEEventDelta:         func() any { return &model.ChatStreamDeltaEvent{} },
+	constant.SSEEventStartToolCall: func() any { return &model.ChatStreamStartToolCallEvent{} },
+	constant.SSEEventToolCall:      func() any { return &model.ChatStreamToolCallEvent{} },
+	constant.SSEEventToolProgress:  func() any { return &model.ChatStreamToolProgressEvent{} },
+	constant.SSEEventToolResult:    func() any { return &model.ChatStreamToolResultEvent{} },
+	constant.SSEEventApproval:      func() any { return &model.ChatStreamApprovalRequiredEvent{} },
+	constant.SSEEventUsage:         func() any { return &model.ChatStreamUsageEvent{} },
+	constant.SSEEventDone:          func() any { return &model.ChatStreamDoneEvent{} },
+	constant.SSEEventError:         func() any { return &model.ChatStreamErrorEvent{} },
+}
+
+// decodeFrame 按事件名严格解码 data，出现结构外的字段即视为线上格式不符
+func decodeFrame(f sseFrame) (any, error) {
+	newPayload, ok := payloads[f.Event]
+	if !ok {
+		return nil, fmt.Errorf("unknown event %q", f.Event)
+	}
+	v := newPayload()
+	dec := json.NewDecoder(strings.NewReader(f.Data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// payloadVersion 取出解码后 payload 的版本号
+func payloadVersion(v any) int32 {
+	return v.(interface{ GetVersion() int32 }).GetVersion()
+}
+
+func Test_ChatSSE_wireFormat(t *testing.T) {
+	Convey("Test the SSE wire format of chat stream events", t, func() {
+		const user = "u1"
+		mr := miniredis.NewMiniRedis()
+		So(mr.Start(), ShouldBeNil)
+		defer mr.Close()
+		stub := newStubModel(
+			stubReply{calls: []ai_provider.ChatToolCall{{ID: "call_steps", Name: "steps", Arguments: `{}`}}},
+			stubReply{content: "hello"},
+			stubReply{calls: []ai_provider.ChatToolCall{{ID: "call_remove", Name: "remove", Arguments: `{}`}}},
+			stubReply{content: "bye"},
+		)
+		defer stub.Close()
+		mcpSrv := newMCPServer()
+		// MCP 客户端保持着 GET 监听流，httptest.Server.Close 会一直等它结束，这里先断开连接
+		defer func() {
+			_ = mcpSrv.Listener.Close()
+			mcpSrv.CloseClientConnections()
+		}()
+		saved := clientSet
+		clientSet = newTestClientSet(stub, mcpSrv.URL, mr.Addr())
+		defer func() {
+			clientSet.MCPCli.Close()
+			clientSet = saved
+		}()
+		h, baseURL := newTestServer()
+		defer func() { _ = h.Close() }()
+
+		post := func(path, user, lastEventID string, body []byte) []sseFrame {
+			req, err := http.NewRequest(http.MethodPost, baseURL+path, bytes.NewReader(body))
+			So(err, ShouldBeNil)
+			req.Header.Set("X-Test-User", user)
+			if lastEventID != "" {
+				req.Header.Set("Last-Event-ID", lastEventID)
+			}
+			if body != nil {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			resp, err := http.DefaultClient.Do(req)
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+			So(resp.Header.Get("Content-Type"), ShouldStartWith, "text/event-stream")
+			data, err := io.ReadAll(resp.Body)
+			So(err, ShouldBeNil)
+			return parseSSE(data)
+		}
+		chat := func(conv, message, user, lastEventID string) []sseFrame {
+			q := url.Values{"conversation_id": {conv}, "message": {message}}
+			return post("/api/v1/chat/sse?"+q.Encode(), user, lastEventID, nil)
+		}
+		// checkFrames 校验每条事件都能解码为当前版本的 payload，缓冲事件带递增的 id
+		checkFrames := func(frames []sseFrame) []any {
+			out := make([]any, 0, len(frames))
+			last := int64(0)
+			for _, f := range frames {
+				v, err := decodeFrame(f)
+				So(err, ShouldBeNil)
+				So(payloadVersion(v), ShouldEqual, constant.SSEPayloadVersion)
+				if f.Event == constant.SSEEventError {
+					out = append(out, v)
+					continue
+				}
+				id, err := strconv.ParseInt(f.ID, 10, 64)
+				So(err, ShouldBeNil)
+				So(id, ShouldBeGreaterThan, last)
+				last = id
+				out = append(out, v)
+			}
+			return out
+		}
+
+		const conv = "0d6f6a5e-2d0b-4c1e-9f3a-000000000001"
+		frames := chat(conv, "run the steps", user, "")
+		events := checkFrames(frames)
+
+		Convey("a tool round streams every event type with its versioned payload", func() {
+			// 两条进度通知可能乱序送达，落后的那条被丢弃，这里按去重后的顺序比较
+			So(slices.Compact(frameEvents(frames)), ShouldResemble, []string{
+				constant.SSEEventStartToolCall,
+				constant.SSEEventToolCall,
+				constant.SSEEventToolProgress,
+				constant.SSEEventToolResult,
+				constant.SSEEventDelta,
+				constant.SSEEventUsage,
+				constant.SSEEventDone,
+			})
+			call := &model.ChatStreamToolCall{ID: "call_steps", Name: "steps", Arguments: `{}`}
+
+			start := events[0].(*model.ChatStreamStartToolCallEvent)
+			So(start.Round, ShouldEqual, 1)
+			So(start.ToolCalls, ShouldResemble, []*model.ChatStreamToolCall{call})
+
+			toolCall := events[1].(*model.ChatStreamToolCallEvent)
+			So(toolCall.Round, ShouldEqual, 1)
+			So(toolCall.ID, ShouldEqual, call.ID)
+			So(toolCall.Name, ShouldEqual, call.Name)
+
+			n := len(events)
+			progress := events[n-5].(*model.ChatStreamToolProgressEvent)
+			So(progress.ID, ShouldEqual, call.ID)
+			So(progress.Name, ShouldEqual, call.Name)
+			So(progress.Progress, ShouldEqual, 2)
+			So(*progress.Total, ShouldEqual, 2)
+
+			result := events[n-4].(*model.ChatStreamToolResultEvent)
+			So(result.ID, ShouldEqual, call.ID)
+			So(result.Result, ShouldEqual, "stepped")
+			So(result.IsError, ShouldBeNil)
+
+			So(events[n-3].(*model.ChatStreamDeltaEvent).Text, ShouldEqual, "hello")
+			usage := events[n-2].(*model.ChatStreamUsageEvent)
+			So(usage.TotalTokens, ShouldBeGreaterThan, 0)
+			So(usage.PromptTokens+usage.CompletionTokens, ShouldEqual, usage.TotalTokens)
+			So(events[n-1].(*model.ChatStreamDoneEvent).Reason, ShouldEqual, "completed")
+		})
+
+		Convey("reconnecting with Last-Event-ID replays the same frames after it", func() {
+			n := len(frames)
+			again := chat(conv, "", user, frames[n-4].ID)
+			checkFrames(again)
+			So(again, ShouldResemble, frames[n-3:])
+		})
+
+		Convey("a paused run and its approval stream over the same buffer", func() {
+			const conv = "0d6f6a5e-2d0b-4c1e-9f3a-000000000002"
+			paused := chat(conv, "remove it", user, "")
+			events := checkFrames(paused)
+			So(frameEvents(paused), ShouldResemble, []string{
+				constant.SSEEventStartToolCall,
+				constant.SSEEventApproval,
+				constant.SSEEventUsage,
+				constant.SSEEventDone,
+			})
+			approval := events[1].(*model.ChatStreamApprovalRequiredEvent)
+			So(approval.Round, ShouldEqual, 1)
+			So(approval.ToolCalls, ShouldResemble, []*model.ChatStreamToolCall{{ID: "call_remove", Name: "remove", Arguments: `{}`}})
+			So(approval.ExpiresAt, ShouldBeGreaterThan, time.Now().UnixMilli())
+			So(events[3].(*model.ChatStreamDoneEvent).Reason, ShouldEqual, "approval_required")
+
+			body, _ := json.Marshal(map[string]any{"conversation_id": conv, "approved": true})
+			resumed := post("/api/v1/chat/approval", user, "", body)
+			events = checkFrames(resumed)
+			names := frameEvents(resumed)
+			So(names, ShouldContain, constant.SSEEventToolResult)
+			So(names[len(names)-1], ShouldEqual, constant.SSEEventDone)
+			last, _ := strconv.ParseInt(paused[len(paused)-1].ID, 10, 64)
+			first, _ := strconv.ParseInt(resumed[0].ID, 10, 64)
+			So(first, ShouldBeGreaterThan, last)
+
+			i := slices.Index(names, constant.SSEEventToolResult)
+			result := events[i].(*model.ChatStreamToolResultEvent)
+			So(result.ID, ShouldEqual, "call_remove")
+			So(result.Result, ShouldEqual, "removed")
+			So(events[len(events)-1].(*model.ChatStreamDoneEvent).Reason, ShouldEqual, "completed")
+		})
+
+		Convey("errors are sent as an error event without an id", func() {
+			errorOf := func(frames []sseFrame) *model.ChatStreamErrorEvent {
+				So(frameEvents(frames), ShouldResemble, []string{constant.SSEEventError})
+				So(frames[0].ID, ShouldBeEmpty)
+				return checkFrames(frames)[0].(*model.ChatStreamErrorEvent)
+			}
+
+			e := errorOf(chat(conv, "", user, "abc"))
+			So(e.Code, ShouldEqual, errno.ParamInvalidCode)
+			So(e.Message, ShouldContainSubstring, "Last-Event-ID")
+
+			e = errorOf(chat(conv, "", "u2", frames[0].ID))
+			So(e.Code, ShouldEqual, errno.AuthInvalidCode)
+
+			body, _ := json.Marshal(map[string]any{"conversation_id": conv, "approved": true})
+			e = errorOf(post("/api/v1/chat/approval", user, "", body))
+			So(e.Code, ShouldNotEqual, 0)
+			So(e.Message, ShouldNotBeEmpty)
+		})
+	})
+}
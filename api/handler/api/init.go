@@ -1,17 +1,31 @@
 package api
 
 import (
+	"context"
+
+	"github.com/FantasyRL/go-mcp-demo/internal/host/application"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/lifecycle"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 )
 
 var clientSet *base.ClientSet
 
-func Init() {
+// Init 创建 Host 使用的客户端，关闭时由 lc 在排空进行中的请求后依次关闭 MCP 客户端、数据库、Redis
+func Init(lc *lifecycle.Manager) {
 	clientSet = base.NewClientSet(
 		base.WithMCPClient([]string{constant.ServiceNameMCPLocal, constant.ServiceNameMCPRemote}),
 		base.WithAiProviderClient(),
 		base.WithDB(),
 		base.WithCache(),
+		base.WithToolPolicy(),
+		base.WithLifecycle(lc),
 	)
+	lc.OnClose("client set", clientSet.Close)
+	application.NewHost(lc.Context(), clientSet).WatchToolPolicies()
+}
+
+// CheckQuota 供配额中间件使用的检查函数
+func CheckQuota(ctx context.Context, userID string) error {
+	return application.NewHost(ctx, clientSet).CheckQuota(userID)
 }
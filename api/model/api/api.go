@@ -10,9 +10,10 @@ import (
 )
 
 type ChatRequest struct {
-	Message        string `thrift:"message,1" form:"message" json:"message"`
-	Image          []byte `thrift:"image,2,optional" form:"image" json:"image,omitempty"`
-	ConversationID string `thrift:"conversation_id,3" form:"conversation_id" json:"conversation_id"`
+	Message        string   `thrift:"message,1" form:"message" json:"message"`
+	Image          []byte   `thrift:"image,2,optional" form:"image" json:"image,omitempty"`
+	ConversationID string   `thrift:"conversation_id,3" form:"conversation_id" json:"conversation_id"`
+	Resources      []string `thrift:"resources,4,optional,list<string>" form:"resources" json:"resources,omitempty"`
 }
 
 func NewChatRequest() *ChatRequest {
@@ -39,16 +40,30 @@ func (p *ChatRequest) GetConversationID() (v string) {
 	return p.ConversationID
 }
 
+var ChatRequest_Resources_DEFAULT []string
+
+func (p *ChatRequest) GetResources() (v []string) {
+	if !p.IsSetResources() {
+		return ChatRequest_Resources_DEFAULT
+	}
+	return p.Resources
+}
+
 var fieldIDToName_ChatRequest = map[int16]string{
 	1: "message",
 	2: "image",
 	3: "conversation_id",
+	4: "resources",
 }
 
 func (p *ChatRequest) IsSetImage() bool {
 	return p.Image != nil
 }
 
+func (p *ChatRequest) IsSetResources() bool {
+	return p.Resources != nil
+}
+
 func (p *ChatRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -92,6 +107,14 @@ func (p *ChatRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -154,6 +177,29 @@ func (p *ChatRequest) ReadField3(iprot thrift.TProtocol) error {
 	p.ConversationID = _field
 	return nil
 }
+func (p *ChatRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Resources = _field
+	return nil
+}
 
 func (p *ChatRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -173,6 +219,10 @@ func (p *ChatRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -244,6 +294,33 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *ChatRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResources() {
+		if err = oprot.WriteFieldBegin("resources", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Resources)); err != nil {
+			return err
+		}
+		for _, v := range p.Resources {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *ChatRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -450,9 +527,10 @@ func (p *ChatResponse) String() string {
 }
 
 type ChatSSEHandlerRequest struct {
-	Message        string `thrift:"message,1" json:"message" query:"message"`
-	Image          []byte `thrift:"image,2,optional" form:"image" json:"image,omitempty"`
-	ConversationID string `thrift:"conversation_id,3" json:"conversation_id" query:"conversation_id"`
+	Message        string   `thrift:"message,1" json:"message" query:"message"`
+	Image          []byte   `thrift:"image,2,optional" form:"image" json:"image,omitempty"`
+	ConversationID string   `thrift:"conversation_id,3" json:"conversation_id" query:"conversation_id"`
+	Resources      []string `thrift:"resources,4,optional,list<string>" json:"resources,omitempty" query:"resources"`
 }
 
 func NewChatSSEHandlerRequest() *ChatSSEHandlerRequest {
@@ -479,16 +557,30 @@ func (p *ChatSSEHandlerRequest) GetConversationID() (v string) {
 	return p.ConversationID
 }
 
+var ChatSSEHandlerRequest_Resources_DEFAULT []string
+
+func (p *ChatSSEHandlerRequest) GetResources() (v []string) {
+	if !p.IsSetResources() {
+		return ChatSSEHandlerRequest_Resources_DEFAULT
+	}
+	return p.Resources
+}
+
 var fieldIDToName_ChatSSEHandlerRequest = map[int16]string{
 	1: "message",
 	2: "image",
 	3: "conversation_id",
+	4: "resources",
 }
 
 func (p *ChatSSEHandlerRequest) IsSetImage() bool {
 	return p.Image != nil
 }
 
+func (p *ChatSSEHandlerRequest) IsSetResources() bool {
+	return p.Resources != nil
+}
+
 func (p *ChatSSEHandlerRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -532,6 +624,14 @@ func (p *ChatSSEHandlerRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -594,6 +694,29 @@ func (p *ChatSSEHandlerRequest) ReadField3(iprot thrift.TProtocol) error {
 	p.ConversationID = _field
 	return nil
 }
+func (p *ChatSSEHandlerRequest) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Resources = _field
+	return nil
+}
 
 func (p *ChatSSEHandlerRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -613,6 +736,10 @@ func (p *ChatSSEHandlerRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -684,6 +811,33 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *ChatSSEHandlerRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResources() {
+		if err = oprot.WriteFieldBegin("resources", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Resources)); err != nil {
+			return err
+		}
+		for _, v := range p.Resources {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *ChatSSEHandlerRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1736,29 +1890,66 @@ func (p *ListConversationsResponse) String() string {
 
 }
 
-type TemplateRequest struct {
-	TemplateId string `thrift:"templateId,1" form:"templateId" json:"templateId"`
+type ChatApprovalRequest struct {
+	ConversationID string   `thrift:"conversation_id,1,required" form:"conversation_id,required" json:"conversation_id,required"`
+	Approved       bool     `thrift:"approved,2" form:"approved" json:"approved"`
+	ToolCallIds    []string `thrift:"tool_call_ids,3,optional,list<string>" form:"tool_call_ids" json:"tool_call_ids,omitempty"`
+	Reason         *string  `thrift:"reason,4,optional" form:"reason" json:"reason,omitempty"`
 }
 
-func NewTemplateRequest() *TemplateRequest {
-	return &TemplateRequest{}
+func NewChatApprovalRequest() *ChatApprovalRequest {
+	return &ChatApprovalRequest{}
 }
 
-func (p *TemplateRequest) InitDefault() {
+func (p *ChatApprovalRequest) InitDefault() {
 }
 
-func (p *TemplateRequest) GetTemplateId() (v string) {
-	return p.TemplateId
+func (p *ChatApprovalRequest) GetConversationID() (v string) {
+	return p.ConversationID
 }
 
-var fieldIDToName_TemplateRequest = map[int16]string{
-	1: "templateId",
+func (p *ChatApprovalRequest) GetApproved() (v bool) {
+	return p.Approved
 }
 
-func (p *TemplateRequest) Read(iprot thrift.TProtocol) (err error) {
+var ChatApprovalRequest_ToolCallIds_DEFAULT []string
+
+func (p *ChatApprovalRequest) GetToolCallIds() (v []string) {
+	if !p.IsSetToolCallIds() {
+		return ChatApprovalRequest_ToolCallIds_DEFAULT
+	}
+	return p.ToolCallIds
+}
+
+var ChatApprovalRequest_Reason_DEFAULT string
+
+func (p *ChatApprovalRequest) GetReason() (v string) {
+	if !p.IsSetReason() {
+		return ChatApprovalRequest_Reason_DEFAULT
+	}
+	return *p.Reason
+}
+
+var fieldIDToName_ChatApprovalRequest = map[int16]string{
+	1: "conversation_id",
+	2: "approved",
+	3: "tool_call_ids",
+	4: "reason",
+}
+
+func (p *ChatApprovalRequest) IsSetToolCallIds() bool {
+	return p.ToolCallIds != nil
+}
+
+func (p *ChatApprovalRequest) IsSetReason() bool {
+	return p.Reason != nil
+}
+
+func (p *ChatApprovalRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetConversationID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -1779,6 +1970,31 @@ func (p *TemplateRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetConversationID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -1795,13 +2011,17 @@ func (p *TemplateRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetConversationID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TemplateRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChatApprovalRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1809,9 +2029,11 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ChatApprovalRequest[fieldId]))
 }
 
-func (p *TemplateRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ChatApprovalRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1819,13 +2041,58 @@ func (p *TemplateRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.TemplateId = _field
+	p.ConversationID = _field
 	return nil
 }
+func (p *ChatApprovalRequest) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *TemplateRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Approved = _field
+	return nil
+}
+func (p *ChatApprovalRequest) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ToolCallIds = _field
+	return nil
+}
+func (p *ChatApprovalRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *ChatApprovalRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TemplateRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ChatApprovalRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1833,6 +2100,18 @@ func (p *TemplateRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1851,11 +2130,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TemplateRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("templateId", thrift.STRING, 1); err != nil {
+func (p *ChatApprovalRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.TemplateId); err != nil {
+	if err := oprot.WriteString(p.ConversationID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -1868,43 +2147,90 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TemplateRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ChatApprovalRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("approved", thrift.BOOL, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("TemplateRequest(%+v)", *p)
-
+	if err := oprot.WriteBool(p.Approved); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-type TemplateResponse struct {
-	User *model.User `thrift:"user,1" form:"user" json:"user"`
-}
-
-func NewTemplateResponse() *TemplateResponse {
-	return &TemplateResponse{}
+func (p *ChatApprovalRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToolCallIds() {
+		if err = oprot.WriteFieldBegin("tool_call_ids", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.ToolCallIds)); err != nil {
+			return err
+		}
+		for _, v := range p.ToolCallIds {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *TemplateResponse) InitDefault() {
+func (p *ChatApprovalRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReason() {
+		if err = oprot.WriteFieldBegin("reason", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Reason); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-var TemplateResponse_User_DEFAULT *model.User
-
-func (p *TemplateResponse) GetUser() (v *model.User) {
-	if !p.IsSetUser() {
-		return TemplateResponse_User_DEFAULT
+func (p *ChatApprovalRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.User
+	return fmt.Sprintf("ChatApprovalRequest(%+v)", *p)
+
 }
 
-var fieldIDToName_TemplateResponse = map[int16]string{
-	1: "user",
+type ChatApprovalResponse struct {
 }
 
-func (p *TemplateResponse) IsSetUser() bool {
-	return p.User != nil
+func NewChatApprovalResponse() *ChatApprovalResponse {
+	return &ChatApprovalResponse{}
 }
 
-func (p *TemplateResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ChatApprovalResponse) InitDefault() {
+}
+
+var fieldIDToName_ChatApprovalResponse = map[int16]string{}
+
+func (p *ChatApprovalResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1921,20 +2247,8 @@ func (p *TemplateResponse) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -1949,10 +2263,8 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TemplateResponse[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -1960,25 +2272,11 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TemplateResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := model.NewUser()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.User = _field
-	return nil
-}
-
-func (p *TemplateResponse) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("TemplateResponse"); err != nil {
+func (p *ChatApprovalResponse) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ChatApprovalResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1989,59 +2287,40 @@ func (p *TemplateResponse) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TemplateResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.User.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *TemplateResponse) String() string {
+func (p *ChatApprovalResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TemplateResponse(%+v)", *p)
+	return fmt.Sprintf("ChatApprovalResponse(%+v)", *p)
 
 }
 
-type SummarizeConversationRequest struct {
-	ConversationID string `thrift:"conversation_id,1" form:"conversation_id" json:"conversation_id"`
+type TemplateRequest struct {
+	TemplateId string `thrift:"templateId,1" form:"templateId" json:"templateId"`
 }
 
-func NewSummarizeConversationRequest() *SummarizeConversationRequest {
-	return &SummarizeConversationRequest{}
+func NewTemplateRequest() *TemplateRequest {
+	return &TemplateRequest{}
 }
 
-func (p *SummarizeConversationRequest) InitDefault() {
+func (p *TemplateRequest) InitDefault() {
 }
 
-func (p *SummarizeConversationRequest) GetConversationID() (v string) {
-	return p.ConversationID
+func (p *TemplateRequest) GetTemplateId() (v string) {
+	return p.TemplateId
 }
 
-var fieldIDToName_SummarizeConversationRequest = map[int16]string{
-	1: "conversation_id",
+var fieldIDToName_TemplateRequest = map[int16]string{
+	1: "templateId",
 }
 
-func (p *SummarizeConversationRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TemplateRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2087,7 +2366,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummarizeConversationRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TemplateRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2097,7 +2376,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SummarizeConversationRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TemplateRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2105,13 +2384,13 @@ func (p *SummarizeConversationRequest) ReadField1(iprot thrift.TProtocol) error
 	} else {
 		_field = v
 	}
-	p.ConversationID = _field
+	p.TemplateId = _field
 	return nil
 }
 
-func (p *SummarizeConversationRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TemplateRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SummarizeConversationRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TemplateRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2137,11 +2416,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SummarizeConversationRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
+func (p *TemplateRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("templateId", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ConversationID); err != nil {
+	if err := oprot.WriteString(p.TemplateId); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2154,58 +2433,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SummarizeConversationRequest) String() string {
+func (p *TemplateRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SummarizeConversationRequest(%+v)", *p)
-
-}
-
-type SummarizeConversationResponse struct {
-	SumID         string            `thrift:"sum_id,1" form:"sum_id" json:"sum_id"`
-	Summary       string            `thrift:"summary,2" form:"summary" json:"summary"`
-	Tags          []string          `thrift:"tags,3,default,list<string>" form:"tags" json:"tags"`
-	ToolCallsJSON string            `thrift:"tool_calls_json,4" form:"tool_calls_json" json:"tool_calls_json"`
-	Notes         map[string]string `thrift:"notes,5" form:"notes" json:"notes"`
-}
+	return fmt.Sprintf("TemplateRequest(%+v)", *p)
 
-func NewSummarizeConversationResponse() *SummarizeConversationResponse {
-	return &SummarizeConversationResponse{}
 }
 
-func (p *SummarizeConversationResponse) InitDefault() {
+type TemplateResponse struct {
+	User *model.User `thrift:"user,1" form:"user" json:"user"`
 }
 
-func (p *SummarizeConversationResponse) GetSumID() (v string) {
-	return p.SumID
+func NewTemplateResponse() *TemplateResponse {
+	return &TemplateResponse{}
 }
 
-func (p *SummarizeConversationResponse) GetSummary() (v string) {
-	return p.Summary
+func (p *TemplateResponse) InitDefault() {
 }
 
-func (p *SummarizeConversationResponse) GetTags() (v []string) {
-	return p.Tags
-}
+var TemplateResponse_User_DEFAULT *model.User
 
-func (p *SummarizeConversationResponse) GetToolCallsJSON() (v string) {
-	return p.ToolCallsJSON
+func (p *TemplateResponse) GetUser() (v *model.User) {
+	if !p.IsSetUser() {
+		return TemplateResponse_User_DEFAULT
+	}
+	return p.User
 }
 
-func (p *SummarizeConversationResponse) GetNotes() (v map[string]string) {
-	return p.Notes
+var fieldIDToName_TemplateResponse = map[int16]string{
+	1: "user",
 }
 
-var fieldIDToName_SummarizeConversationResponse = map[int16]string{
-	1: "sum_id",
-	2: "summary",
-	3: "tags",
-	4: "tool_calls_json",
-	5: "notes",
+func (p *TemplateResponse) IsSetUser() bool {
+	return p.User != nil
 }
 
-func (p *SummarizeConversationResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *TemplateResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2225,45 +2489,13 @@ func (p *SummarizeConversationResponse) Read(iprot thrift.TProtocol) (err error)
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2283,7 +2515,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummarizeConversationResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TemplateResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2293,95 +2525,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SummarizeConversationResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TemplateResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewUser()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.SumID = _field
+	p.User = _field
 	return nil
 }
-func (p *SummarizeConversationResponse) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Summary = _field
-	return nil
-}
-func (p *SummarizeConversationResponse) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Tags = _field
-	return nil
-}
-func (p *SummarizeConversationResponse) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ToolCallsJSON = _field
-	return nil
-}
-func (p *SummarizeConversationResponse) ReadField5(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.Notes = _field
-	return nil
-}
-
-func (p *SummarizeConversationResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TemplateResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SummarizeConversationResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TemplateResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2389,22 +2544,6 @@ func (p *SummarizeConversationResponse) Write(oprot thrift.TProtocol) (err error
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2423,11 +2562,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SummarizeConversationResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("sum_id", thrift.STRING, 1); err != nil {
+func (p *TemplateResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.SumID); err != nil {
+	if err := p.User.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2440,127 +2579,34 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SummarizeConversationResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("summary", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Summary); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *SummarizeConversationResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
-		return err
-	}
-	for _, v := range p.Tags {
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *SummarizeConversationResponse) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ToolCallsJSON); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *SummarizeConversationResponse) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("notes", thrift.MAP, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
-		return err
-	}
-	for k, v := range p.Notes {
-		if err := oprot.WriteString(k); err != nil {
-			return err
-		}
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteMapEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *SummarizeConversationResponse) String() string {
+func (p *TemplateResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SummarizeConversationResponse(%+v)", *p)
-
-}
+	return fmt.Sprintf("TemplateResponse(%+v)", *p)
 
-type GetLoginDataRequest struct {
-	StuID    string `thrift:"stu_id,1" form:"stu_id" json:"stu_id"`
-	Password string `thrift:"password,2" form:"password" json:"password"`
 }
 
-func NewGetLoginDataRequest() *GetLoginDataRequest {
-	return &GetLoginDataRequest{}
+type SummarizeConversationRequest struct {
+	ConversationID string `thrift:"conversation_id,1" form:"conversation_id" json:"conversation_id"`
 }
 
-func (p *GetLoginDataRequest) InitDefault() {
+func NewSummarizeConversationRequest() *SummarizeConversationRequest {
+	return &SummarizeConversationRequest{}
 }
 
-func (p *GetLoginDataRequest) GetStuID() (v string) {
-	return p.StuID
+func (p *SummarizeConversationRequest) InitDefault() {
 }
 
-func (p *GetLoginDataRequest) GetPassword() (v string) {
-	return p.Password
+func (p *SummarizeConversationRequest) GetConversationID() (v string) {
+	return p.ConversationID
 }
 
-var fieldIDToName_GetLoginDataRequest = map[int16]string{
-	1: "stu_id",
-	2: "password",
+var fieldIDToName_SummarizeConversationRequest = map[int16]string{
+	1: "conversation_id",
 }
 
-func (p *GetLoginDataRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *SummarizeConversationRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2587,14 +2633,6 @@ func (p *GetLoginDataRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2614,7 +2652,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLoginDataRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummarizeConversationRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2624,18 +2662,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetLoginDataRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.StuID = _field
-	return nil
-}
-func (p *GetLoginDataRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *SummarizeConversationRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2643,13 +2670,13 @@ func (p *GetLoginDataRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Password = _field
+	p.ConversationID = _field
 	return nil
 }
 
-func (p *GetLoginDataRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *SummarizeConversationRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLoginDataRequest"); err != nil {
+	if err = oprot.WriteStructBegin("SummarizeConversationRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2657,10 +2684,6 @@ func (p *GetLoginDataRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2679,11 +2702,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetLoginDataRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("stu_id", thrift.STRING, 1); err != nil {
+func (p *SummarizeConversationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.StuID); err != nil {
+	if err := oprot.WriteString(p.ConversationID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2696,63 +2719,58 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetLoginDataRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("password", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Password); err != nil {
-		return err
+func (p *SummarizeConversationRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return fmt.Sprintf("SummarizeConversationRequest(%+v)", *p)
+
 }
 
-func (p *GetLoginDataRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("GetLoginDataRequest(%+v)", *p)
+type SummarizeConversationResponse struct {
+	SumID         string            `thrift:"sum_id,1" form:"sum_id" json:"sum_id"`
+	Summary       string            `thrift:"summary,2" form:"summary" json:"summary"`
+	Tags          []string          `thrift:"tags,3,default,list<string>" form:"tags" json:"tags"`
+	ToolCallsJSON string            `thrift:"tool_calls_json,4" form:"tool_calls_json" json:"tool_calls_json"`
+	Notes         map[string]string `thrift:"notes,5" form:"notes" json:"notes"`
+}
 
+func NewSummarizeConversationResponse() *SummarizeConversationResponse {
+	return &SummarizeConversationResponse{}
 }
 
-type GetLoginDataResponse struct {
-	Identifier  string `thrift:"identifier,1" form:"identifier" json:"identifier"`
-	Cookie      string `thrift:"cookie,2" form:"cookie" json:"cookie"`
-	AccessToken string `thrift:"access_token,3" form:"access_token" json:"access_token"`
+func (p *SummarizeConversationResponse) InitDefault() {
 }
 
-func NewGetLoginDataResponse() *GetLoginDataResponse {
-	return &GetLoginDataResponse{}
+func (p *SummarizeConversationResponse) GetSumID() (v string) {
+	return p.SumID
 }
 
-func (p *GetLoginDataResponse) InitDefault() {
+func (p *SummarizeConversationResponse) GetSummary() (v string) {
+	return p.Summary
 }
 
-func (p *GetLoginDataResponse) GetIdentifier() (v string) {
-	return p.Identifier
+func (p *SummarizeConversationResponse) GetTags() (v []string) {
+	return p.Tags
 }
 
-func (p *GetLoginDataResponse) GetCookie() (v string) {
-	return p.Cookie
+func (p *SummarizeConversationResponse) GetToolCallsJSON() (v string) {
+	return p.ToolCallsJSON
 }
 
-func (p *GetLoginDataResponse) GetAccessToken() (v string) {
-	return p.AccessToken
+func (p *SummarizeConversationResponse) GetNotes() (v map[string]string) {
+	return p.Notes
 }
 
-var fieldIDToName_GetLoginDataResponse = map[int16]string{
-	1: "identifier",
-	2: "cookie",
-	3: "access_token",
+var fieldIDToName_SummarizeConversationResponse = map[int16]string{
+	1: "sum_id",
+	2: "summary",
+	3: "tags",
+	4: "tool_calls_json",
+	5: "notes",
 }
 
-func (p *GetLoginDataResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *SummarizeConversationResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2788,13 +2806,29 @@ func (p *GetLoginDataResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2814,7 +2848,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLoginDataResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummarizeConversationResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2824,7 +2858,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetLoginDataResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *SummarizeConversationResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2832,10 +2866,10 @@ func (p *GetLoginDataResponse) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Identifier = _field
+	p.SumID = _field
 	return nil
 }
-func (p *GetLoginDataResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *SummarizeConversationResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2843,10 +2877,33 @@ func (p *GetLoginDataResponse) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Cookie = _field
+	p.Summary = _field
 	return nil
 }
-func (p *GetLoginDataResponse) ReadField3(iprot thrift.TProtocol) error {
+func (p *SummarizeConversationResponse) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Tags = _field
+	return nil
+}
+func (p *SummarizeConversationResponse) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2854,13 +2911,42 @@ func (p *GetLoginDataResponse) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.AccessToken = _field
+	p.ToolCallsJSON = _field
+	return nil
+}
+func (p *SummarizeConversationResponse) ReadField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Notes = _field
 	return nil
 }
 
-func (p *GetLoginDataResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *SummarizeConversationResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLoginDataResponse"); err != nil {
+	if err = oprot.WriteStructBegin("SummarizeConversationResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2876,6 +2962,14 @@ func (p *GetLoginDataResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2894,11 +2988,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetLoginDataResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("identifier", thrift.STRING, 1); err != nil {
+func (p *SummarizeConversationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("sum_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Identifier); err != nil {
+	if err := oprot.WriteString(p.SumID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2911,11 +3005,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetLoginDataResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("cookie", thrift.STRING, 2); err != nil {
+func (p *SummarizeConversationResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("summary", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Cookie); err != nil {
+	if err := oprot.WriteString(p.Summary); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2928,11 +3022,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetLoginDataResponse) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 3); err != nil {
+func (p *SummarizeConversationResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 3); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.AccessToken); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+		return err
+	}
+	for _, v := range p.Tags {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2945,27 +3047,85 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetLoginDataResponse) String() string {
+func (p *SummarizeConversationResponse) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ToolCallsJSON); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SummarizeConversationResponse) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("notes", thrift.MAP, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
+		return err
+	}
+	for k, v := range p.Notes {
+		if err := oprot.WriteString(k); err != nil {
+			return err
+		}
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SummarizeConversationResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetLoginDataResponse(%+v)", *p)
+	return fmt.Sprintf("SummarizeConversationResponse(%+v)", *p)
 
 }
 
-type GetUserInfoRequest struct {
+type GetLoginDataRequest struct {
+	StuID    string `thrift:"stu_id,1" form:"stu_id" json:"stu_id"`
+	Password string `thrift:"password,2" form:"password" json:"password"`
 }
 
-func NewGetUserInfoRequest() *GetUserInfoRequest {
-	return &GetUserInfoRequest{}
+func NewGetLoginDataRequest() *GetLoginDataRequest {
+	return &GetLoginDataRequest{}
 }
 
-func (p *GetUserInfoRequest) InitDefault() {
+func (p *GetLoginDataRequest) InitDefault() {
 }
 
-var fieldIDToName_GetUserInfoRequest = map[int16]string{}
+func (p *GetLoginDataRequest) GetStuID() (v string) {
+	return p.StuID
+}
 
-func (p *GetUserInfoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetLoginDataRequest) GetPassword() (v string) {
+	return p.Password
+}
+
+var fieldIDToName_GetLoginDataRequest = map[int16]string{
+	1: "stu_id",
+	2: "password",
+}
+
+func (p *GetLoginDataRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2982,8 +3142,28 @@ func (p *GetUserInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -2998,8 +3178,10 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLoginDataRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -3007,11 +3189,43 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUserInfoRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("GetUserInfoRequest"); err != nil {
+func (p *GetLoginDataRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StuID = _field
+	return nil
+}
+func (p *GetLoginDataRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Password = _field
+	return nil
+}
+
+func (p *GetLoginDataRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetLoginDataRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3022,46 +3236,88 @@ func (p *GetUserInfoRequest) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUserInfoRequest) String() string {
+func (p *GetLoginDataRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stu_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.StuID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetLoginDataRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("password", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Password); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetLoginDataRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserInfoRequest(%+v)", *p)
+	return fmt.Sprintf("GetLoginDataRequest(%+v)", *p)
 
 }
 
-type GetUserInfoResponse struct {
-	UserID   string `thrift:"user_id,1" form:"user_id" json:"user_id"`
-	Username string `thrift:"username,2" form:"username" json:"username"`
+type GetLoginDataResponse struct {
+	Identifier  string `thrift:"identifier,1" form:"identifier" json:"identifier"`
+	Cookie      string `thrift:"cookie,2" form:"cookie" json:"cookie"`
+	AccessToken string `thrift:"access_token,3" form:"access_token" json:"access_token"`
 }
 
-func NewGetUserInfoResponse() *GetUserInfoResponse {
-	return &GetUserInfoResponse{}
+func NewGetLoginDataResponse() *GetLoginDataResponse {
+	return &GetLoginDataResponse{}
 }
 
-func (p *GetUserInfoResponse) InitDefault() {
+func (p *GetLoginDataResponse) InitDefault() {
 }
 
-func (p *GetUserInfoResponse) GetUserID() (v string) {
-	return p.UserID
+func (p *GetLoginDataResponse) GetIdentifier() (v string) {
+	return p.Identifier
 }
 
-func (p *GetUserInfoResponse) GetUsername() (v string) {
-	return p.Username
+func (p *GetLoginDataResponse) GetCookie() (v string) {
+	return p.Cookie
 }
 
-var fieldIDToName_GetUserInfoResponse = map[int16]string{
-	1: "user_id",
-	2: "username",
+func (p *GetLoginDataResponse) GetAccessToken() (v string) {
+	return p.AccessToken
 }
 
-func (p *GetUserInfoResponse) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_GetLoginDataResponse = map[int16]string{
+	1: "identifier",
+	2: "cookie",
+	3: "access_token",
+}
+
+func (p *GetLoginDataResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3096,6 +3352,14 @@ func (p *GetUserInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3115,7 +3379,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserInfoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLoginDataResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3125,7 +3389,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUserInfoResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetLoginDataResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3133,10 +3397,10 @@ func (p *GetUserInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.UserID = _field
+	p.Identifier = _field
 	return nil
 }
-func (p *GetUserInfoResponse) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetLoginDataResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3144,13 +3408,24 @@ func (p *GetUserInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Username = _field
+	p.Cookie = _field
 	return nil
 }
+func (p *GetLoginDataResponse) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *GetUserInfoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AccessToken = _field
+	return nil
+}
+
+func (p *GetLoginDataResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInfoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetLoginDataResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3162,6 +3437,10 @@ func (p *GetUserInfoResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3180,11 +3459,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUserInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+func (p *GetLoginDataResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("identifier", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.UserID); err != nil {
+	if err := oprot.WriteString(p.Identifier); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3197,11 +3476,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetUserInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("username", thrift.STRING, 2); err != nil {
+func (p *GetLoginDataResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("cookie", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Username); err != nil {
+	if err := oprot.WriteString(p.Cookie); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3214,103 +3493,140 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetUserInfoResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetLoginDataResponse) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("access_token", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("GetUserInfoResponse(%+v)", *p)
-
+	if err := oprot.WriteString(p.AccessToken); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-type CreateTodoRequest struct {
-	Title     string  `thrift:"title,1" form:"title" json:"title"`
-	Content   string  `thrift:"content,2" form:"content" json:"content"`
-	StartTime int64   `thrift:"start_time,3" form:"start_time" json:"start_time"`
-	EndTime   int64   `thrift:"end_time,4" form:"end_time" json:"end_time"`
-	IsAllDay  *int16  `thrift:"is_all_day,5,optional" form:"is_all_day" json:"is_all_day,omitempty"`
-	Priority  int16   `thrift:"priority,6" form:"priority" json:"priority"`
-	RemindAt  *int64  `thrift:"remind_at,7,optional" form:"remind_at" json:"remind_at,omitempty"`
-	Category  *string `thrift:"category,8,optional" form:"category" json:"category,omitempty"`
-}
+func (p *GetLoginDataResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetLoginDataResponse(%+v)", *p)
 
-func NewCreateTodoRequest() *CreateTodoRequest {
-	return &CreateTodoRequest{}
 }
 
-func (p *CreateTodoRequest) InitDefault() {
+type GetUserInfoRequest struct {
 }
 
-func (p *CreateTodoRequest) GetTitle() (v string) {
-	return p.Title
+func NewGetUserInfoRequest() *GetUserInfoRequest {
+	return &GetUserInfoRequest{}
 }
 
-func (p *CreateTodoRequest) GetContent() (v string) {
-	return p.Content
+func (p *GetUserInfoRequest) InitDefault() {
 }
 
-func (p *CreateTodoRequest) GetStartTime() (v int64) {
-	return p.StartTime
-}
+var fieldIDToName_GetUserInfoRequest = map[int16]string{}
 
-func (p *CreateTodoRequest) GetEndTime() (v int64) {
-	return p.EndTime
-}
+func (p *GetUserInfoRequest) Read(iprot thrift.TProtocol) (err error) {
 
-var CreateTodoRequest_IsAllDay_DEFAULT int16
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *CreateTodoRequest) GetIsAllDay() (v int16) {
-	if !p.IsSetIsAllDay() {
-		return CreateTodoRequest_IsAllDay_DEFAULT
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return *p.IsAllDay
-}
-
-func (p *CreateTodoRequest) GetPriority() (v int16) {
-	return p.Priority
-}
 
-var CreateTodoRequest_RemindAt_DEFAULT int64
-
-func (p *CreateTodoRequest) GetRemindAt() (v int64) {
-	if !p.IsSetRemindAt() {
-		return CreateTodoRequest_RemindAt_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return *p.RemindAt
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-var CreateTodoRequest_Category_DEFAULT string
+func (p *GetUserInfoRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("GetUserInfoRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
 
-func (p *CreateTodoRequest) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return CreateTodoRequest_Category_DEFAULT
+func (p *GetUserInfoRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Category
+	return fmt.Sprintf("GetUserInfoRequest(%+v)", *p)
+
 }
 
-var fieldIDToName_CreateTodoRequest = map[int16]string{
-	1: "title",
-	2: "content",
-	3: "start_time",
-	4: "end_time",
-	5: "is_all_day",
-	6: "priority",
-	7: "remind_at",
-	8: "category",
+type GetUserInfoResponse struct {
+	UserID   string `thrift:"user_id,1" form:"user_id" json:"user_id"`
+	Username string `thrift:"username,2" form:"username" json:"username"`
 }
 
-func (p *CreateTodoRequest) IsSetIsAllDay() bool {
-	return p.IsAllDay != nil
+func NewGetUserInfoResponse() *GetUserInfoResponse {
+	return &GetUserInfoResponse{}
 }
 
-func (p *CreateTodoRequest) IsSetRemindAt() bool {
-	return p.RemindAt != nil
+func (p *GetUserInfoResponse) InitDefault() {
 }
 
-func (p *CreateTodoRequest) IsSetCategory() bool {
-	return p.Category != nil
+func (p *GetUserInfoResponse) GetUserID() (v string) {
+	return p.UserID
 }
 
-func (p *CreateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetUserInfoResponse) GetUsername() (v string) {
+	return p.Username
+}
+
+var fieldIDToName_GetUserInfoResponse = map[int16]string{
+	1: "user_id",
+	2: "username",
+}
+
+func (p *GetUserInfoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3345,54 +3661,6 @@ func (p *CreateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3412,7 +3680,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateTodoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserInfoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3422,7 +3690,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetUserInfoResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3430,10 +3698,10 @@ func (p *CreateTodoRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Title = _field
+	p.UserID = _field
 	return nil
 }
-func (p *CreateTodoRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetUserInfoResponse) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3441,79 +3709,13 @@ func (p *CreateTodoRequest) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Content = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.StartTime = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.EndTime = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field *int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.IsAllDay = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Priority = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.RemindAt = _field
-	return nil
-}
-func (p *CreateTodoRequest) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Category = _field
+	p.Username = _field
 	return nil
 }
 
-func (p *CreateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetUserInfoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateTodoRequest"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserInfoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3525,30 +3727,6 @@ func (p *CreateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3567,11 +3745,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 1); err != nil {
+func (p *GetUserInfoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Title); err != nil {
+	if err := oprot.WriteString(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3584,11 +3762,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content", thrift.STRING, 2); err != nil {
+func (p *GetUserInfoResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("username", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Content); err != nil {
+	if err := oprot.WriteString(p.Username); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3601,142 +3779,126 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.StartTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *GetUserInfoResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return fmt.Sprintf("GetUserInfoResponse(%+v)", *p)
+
 }
 
-func (p *CreateTodoRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EndTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+type GetUserUsageRequest struct {
 }
 
-func (p *CreateTodoRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsAllDay() {
-		if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI16(*p.IsAllDay); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+func NewGetUserUsageRequest() *GetUserUsageRequest {
+	return &GetUserUsageRequest{}
 }
 
-func (p *CreateTodoRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("priority", thrift.I16, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI16(p.Priority); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+func (p *GetUserUsageRequest) InitDefault() {
 }
 
-func (p *CreateTodoRequest) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRemindAt() {
-		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 7); err != nil {
-			goto WriteFieldBeginError
+var fieldIDToName_GetUserUsageRequest = map[int16]string{}
+
+func (p *GetUserUsageRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.RemindAt); err != nil {
-			return err
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
 		}
 	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Category); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetUserUsageRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("GetUserUsageRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateTodoRequest) String() string {
+func (p *GetUserUsageRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateTodoRequest(%+v)", *p)
+	return fmt.Sprintf("GetUserUsageRequest(%+v)", *p)
 
 }
 
-type CreateTodoResponse struct {
-	ID string `thrift:"id,1" form:"id" json:"id"`
+type GetUserUsageResponse struct {
+	Usage *model.UserTokenUsage `thrift:"usage,1" form:"usage" json:"usage"`
 }
 
-func NewCreateTodoResponse() *CreateTodoResponse {
-	return &CreateTodoResponse{}
+func NewGetUserUsageResponse() *GetUserUsageResponse {
+	return &GetUserUsageResponse{}
 }
 
-func (p *CreateTodoResponse) InitDefault() {
+func (p *GetUserUsageResponse) InitDefault() {
 }
 
-func (p *CreateTodoResponse) GetID() (v string) {
-	return p.ID
+var GetUserUsageResponse_Usage_DEFAULT *model.UserTokenUsage
+
+func (p *GetUserUsageResponse) GetUsage() (v *model.UserTokenUsage) {
+	if !p.IsSetUsage() {
+		return GetUserUsageResponse_Usage_DEFAULT
+	}
+	return p.Usage
 }
 
-var fieldIDToName_CreateTodoResponse = map[int16]string{
-	1: "id",
+var fieldIDToName_GetUserUsageResponse = map[int16]string{
+	1: "usage",
 }
 
-func (p *CreateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetUserUsageResponse) IsSetUsage() bool {
+	return p.Usage != nil
+}
+
+func (p *GetUserUsageResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3756,7 +3918,7 @@ func (p *CreateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3782,7 +3944,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateTodoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserUsageResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3792,21 +3954,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CreateTodoResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *GetUserUsageResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewUserTokenUsage()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ID = _field
+	p.Usage = _field
 	return nil
 }
 
-func (p *CreateTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetUserUsageResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreateTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserUsageResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3832,11 +3991,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CreateTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *GetUserUsageResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("usage", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := p.Usage.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3849,34 +4008,27 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CreateTodoResponse) String() string {
+func (p *GetUserUsageResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateTodoResponse(%+v)", *p)
-
-}
+	return fmt.Sprintf("GetUserUsageResponse(%+v)", *p)
 
-type GetTodoRequest struct {
-	ID string `thrift:"id,1" json:"id" query:"id"`
 }
 
-func NewGetTodoRequest() *GetTodoRequest {
-	return &GetTodoRequest{}
+type ListMCPPromptsRequest struct {
 }
 
-func (p *GetTodoRequest) InitDefault() {
+func NewListMCPPromptsRequest() *ListMCPPromptsRequest {
+	return &ListMCPPromptsRequest{}
 }
 
-func (p *GetTodoRequest) GetID() (v string) {
-	return p.ID
+func (p *ListMCPPromptsRequest) InitDefault() {
 }
 
-var fieldIDToName_GetTodoRequest = map[int16]string{
-	1: "id",
-}
+var fieldIDToName_ListMCPPromptsRequest = map[int16]string{}
 
-func (p *GetTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListMCPPromptsRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3893,20 +4045,8 @@ func (p *GetTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -3921,10 +4061,8 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTodoRequest[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -3932,28 +4070,11 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetTodoRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ID = _field
-	return nil
-}
-
-func (p *GetTodoRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTodoRequest"); err != nil {
+func (p *ListMCPPromptsRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListMCPPromptsRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3964,143 +4085,40 @@ func (p *GetTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ListMCPPromptsRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *GetTodoRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("GetTodoRequest(%+v)", *p)
-
-}
-
-type TodoItem struct {
-	ID        string  `thrift:"id,1" form:"id" json:"id"`
-	Title     string  `thrift:"title,2" form:"title" json:"title"`
-	Content   string  `thrift:"content,3" form:"content" json:"content"`
-	StartTime int64   `thrift:"start_time,4" form:"start_time" json:"start_time"`
-	EndTime   int64   `thrift:"end_time,5" form:"end_time" json:"end_time"`
-	IsAllDay  int16   `thrift:"is_all_day,6" form:"is_all_day" json:"is_all_day"`
-	Status    int16   `thrift:"status,7" form:"status" json:"status"`
-	Priority  int16   `thrift:"priority,8" form:"priority" json:"priority"`
-	RemindAt  *int64  `thrift:"remind_at,9,optional" form:"remind_at" json:"remind_at,omitempty"`
-	Category  *string `thrift:"category,10,optional" form:"category" json:"category,omitempty"`
-	CreatedAt int64   `thrift:"created_at,11" form:"created_at" json:"created_at"`
-	UpdatedAt int64   `thrift:"updated_at,12" form:"updated_at" json:"updated_at"`
-}
-
-func NewTodoItem() *TodoItem {
-	return &TodoItem{}
-}
-
-func (p *TodoItem) InitDefault() {
-}
-
-func (p *TodoItem) GetID() (v string) {
-	return p.ID
-}
-
-func (p *TodoItem) GetTitle() (v string) {
-	return p.Title
-}
-
-func (p *TodoItem) GetContent() (v string) {
-	return p.Content
-}
-
-func (p *TodoItem) GetStartTime() (v int64) {
-	return p.StartTime
-}
-
-func (p *TodoItem) GetEndTime() (v int64) {
-	return p.EndTime
-}
-
-func (p *TodoItem) GetIsAllDay() (v int16) {
-	return p.IsAllDay
-}
-
-func (p *TodoItem) GetStatus() (v int16) {
-	return p.Status
-}
-
-func (p *TodoItem) GetPriority() (v int16) {
-	return p.Priority
-}
+	return fmt.Sprintf("ListMCPPromptsRequest(%+v)", *p)
 
-var TodoItem_RemindAt_DEFAULT int64
-
-func (p *TodoItem) GetRemindAt() (v int64) {
-	if !p.IsSetRemindAt() {
-		return TodoItem_RemindAt_DEFAULT
-	}
-	return *p.RemindAt
-}
-
-var TodoItem_Category_DEFAULT string
-
-func (p *TodoItem) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return TodoItem_Category_DEFAULT
-	}
-	return *p.Category
 }
 
-func (p *TodoItem) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+type ListMCPPromptsResponse struct {
+	Prompts []*model.MCPPrompt `thrift:"prompts,1,default,list<model.MCPPrompt>" form:"prompts" json:"prompts"`
 }
 
-func (p *TodoItem) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+func NewListMCPPromptsResponse() *ListMCPPromptsResponse {
+	return &ListMCPPromptsResponse{}
 }
 
-var fieldIDToName_TodoItem = map[int16]string{
-	1:  "id",
-	2:  "title",
-	3:  "content",
-	4:  "start_time",
-	5:  "end_time",
-	6:  "is_all_day",
-	7:  "status",
-	8:  "priority",
-	9:  "remind_at",
-	10: "category",
-	11: "created_at",
-	12: "updated_at",
+func (p *ListMCPPromptsResponse) InitDefault() {
 }
 
-func (p *TodoItem) IsSetRemindAt() bool {
-	return p.RemindAt != nil
+func (p *ListMCPPromptsResponse) GetPrompts() (v []*model.MCPPrompt) {
+	return p.Prompts
 }
 
-func (p *TodoItem) IsSetCategory() bool {
-	return p.Category != nil
+var fieldIDToName_ListMCPPromptsResponse = map[int16]string{
+	1: "prompts",
 }
 
-func (p *TodoItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListMCPPromptsResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4120,101 +4138,13 @@ func (p *TodoItem) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField12(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4234,7 +4164,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TodoItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListMCPPromptsResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4244,142 +4174,276 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TodoItem) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *ListMCPPromptsResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ID = _field
-	return nil
-}
-func (p *TodoItem) ReadField2(iprot thrift.TProtocol) error {
+	_field := make([]*model.MCPPrompt, 0, size)
+	values := make([]model.MCPPrompt, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Title = _field
+	p.Prompts = _field
 	return nil
 }
-func (p *TodoItem) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *ListMCPPromptsResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListMCPPromptsResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.Content = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *TodoItem) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *ListMCPPromptsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("prompts", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Prompts)); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.StartTime = _field
-	return nil
-}
-func (p *TodoItem) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	for _, v := range p.Prompts {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.EndTime = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *TodoItem) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *ListMCPPromptsResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.IsAllDay = _field
-	return nil
+	return fmt.Sprintf("ListMCPPromptsResponse(%+v)", *p)
+
 }
-func (p *TodoItem) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = v
+type ListMCPResourcesRequest struct {
+}
+
+func NewListMCPResourcesRequest() *ListMCPResourcesRequest {
+	return &ListMCPResourcesRequest{}
+}
+
+func (p *ListMCPResourcesRequest) InitDefault() {
+}
+
+var fieldIDToName_ListMCPResourcesRequest = map[int16]string{}
+
+func (p *ListMCPResourcesRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	p.Status = _field
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
 	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *TodoItem) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *ListMCPResourcesRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListMCPResourcesRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.Priority = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *TodoItem) ReadField9(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *ListMCPResourcesRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.RemindAt = _field
-	return nil
+	return fmt.Sprintf("ListMCPResourcesRequest(%+v)", *p)
+
 }
-func (p *TodoItem) ReadField10(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+type ListMCPResourcesResponse struct {
+	Resources []*model.MCPResource `thrift:"resources,1,default,list<model.MCPResource>" form:"resources" json:"resources"`
+}
+
+func NewListMCPResourcesResponse() *ListMCPResourcesResponse {
+	return &ListMCPResourcesResponse{}
+}
+
+func (p *ListMCPResourcesResponse) InitDefault() {
+}
+
+func (p *ListMCPResourcesResponse) GetResources() (v []*model.MCPResource) {
+	return p.Resources
+}
+
+var fieldIDToName_ListMCPResourcesResponse = map[int16]string{
+	1: "resources",
+}
+
+func (p *ListMCPResourcesResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	p.Category = _field
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
 	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListMCPResourcesResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *TodoItem) ReadField11(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *ListMCPResourcesResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.CreatedAt = _field
-	return nil
-}
-func (p *TodoItem) ReadField12(iprot thrift.TProtocol) error {
+	_field := make([]*model.MCPResource, 0, size)
+	values := make([]model.MCPResource, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.UpdatedAt = _field
+	p.Resources = _field
 	return nil
 }
 
-func (p *TodoItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListMCPResourcesResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TodoItem"); err != nil {
+	if err = oprot.WriteStructBegin("ListMCPResourcesResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4387,50 +4451,6 @@ func (p *TodoItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4449,11 +4469,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TodoItem) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *ListMCPResourcesResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("resources", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Resources)); err != nil {
+		return err
+	}
+	for _, v := range p.Resources {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4466,240 +4494,109 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TodoItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Title); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *ListMCPResourcesResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return fmt.Sprintf("ListMCPResourcesResponse(%+v)", *p)
+
 }
 
-func (p *TodoItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Content); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+type CreateTodoRequest struct {
+	Title     string  `thrift:"title,1" form:"title" json:"title"`
+	Content   string  `thrift:"content,2" form:"content" json:"content"`
+	StartTime int64   `thrift:"start_time,3" form:"start_time" json:"start_time"`
+	EndTime   int64   `thrift:"end_time,4" form:"end_time" json:"end_time"`
+	IsAllDay  *int16  `thrift:"is_all_day,5,optional" form:"is_all_day" json:"is_all_day,omitempty"`
+	Priority  int16   `thrift:"priority,6" form:"priority" json:"priority"`
+	RemindAt  *int64  `thrift:"remind_at,7,optional" form:"remind_at" json:"remind_at,omitempty"`
+	Category  *string `thrift:"category,8,optional" form:"category" json:"category,omitempty"`
 }
 
-func (p *TodoItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.StartTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+func NewCreateTodoRequest() *CreateTodoRequest {
+	return &CreateTodoRequest{}
 }
 
-func (p *TodoItem) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.EndTime); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+func (p *CreateTodoRequest) InitDefault() {
 }
 
-func (p *TodoItem) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI16(p.IsAllDay); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+func (p *CreateTodoRequest) GetTitle() (v string) {
+	return p.Title
 }
 
-func (p *TodoItem) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.I16, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI16(p.Status); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+func (p *CreateTodoRequest) GetContent() (v string) {
+	return p.Content
 }
 
-func (p *TodoItem) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("priority", thrift.I16, 8); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI16(p.Priority); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+func (p *CreateTodoRequest) GetStartTime() (v int64) {
+	return p.StartTime
 }
 
-func (p *TodoItem) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRemindAt() {
-		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 9); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.RemindAt); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+func (p *CreateTodoRequest) GetEndTime() (v int64) {
+	return p.EndTime
 }
 
-func (p *TodoItem) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 10); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Category); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+var CreateTodoRequest_IsAllDay_DEFAULT int16
+
+func (p *CreateTodoRequest) GetIsAllDay() (v int16) {
+	if !p.IsSetIsAllDay() {
+		return CreateTodoRequest_IsAllDay_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+	return *p.IsAllDay
 }
 
-func (p *TodoItem) writeField11(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 11); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+func (p *CreateTodoRequest) GetPriority() (v int16) {
+	return p.Priority
 }
 
-func (p *TodoItem) writeField12(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 12); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+var CreateTodoRequest_RemindAt_DEFAULT int64
+
+func (p *CreateTodoRequest) GetRemindAt() (v int64) {
+	if !p.IsSetRemindAt() {
+		return CreateTodoRequest_RemindAt_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+	return *p.RemindAt
 }
 
-func (p *TodoItem) String() string {
-	if p == nil {
-		return "<nil>"
+var CreateTodoRequest_Category_DEFAULT string
+
+func (p *CreateTodoRequest) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return CreateTodoRequest_Category_DEFAULT
 	}
-	return fmt.Sprintf("TodoItem(%+v)", *p)
+	return *p.Category
+}
 
+var fieldIDToName_CreateTodoRequest = map[int16]string{
+	1: "title",
+	2: "content",
+	3: "start_time",
+	4: "end_time",
+	5: "is_all_day",
+	6: "priority",
+	7: "remind_at",
+	8: "category",
 }
 
-type GetTodoResponse struct {
-	Todo *TodoItem `thrift:"todo,1" form:"todo" json:"todo"`
+func (p *CreateTodoRequest) IsSetIsAllDay() bool {
+	return p.IsAllDay != nil
 }
 
-func NewGetTodoResponse() *GetTodoResponse {
-	return &GetTodoResponse{}
+func (p *CreateTodoRequest) IsSetRemindAt() bool {
+	return p.RemindAt != nil
 }
 
-func (p *GetTodoResponse) InitDefault() {
+func (p *CreateTodoRequest) IsSetCategory() bool {
+	return p.Category != nil
 }
 
-var GetTodoResponse_Todo_DEFAULT *TodoItem
+func (p *CreateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *GetTodoResponse) GetTodo() (v *TodoItem) {
-	if !p.IsSetTodo() {
-		return GetTodoResponse_Todo_DEFAULT
-	}
-	return p.Todo
-}
-
-var fieldIDToName_GetTodoResponse = map[int16]string{
-	1: "todo",
-}
-
-func (p *GetTodoResponse) IsSetTodo() bool {
-	return p.Todo != nil
-}
-
-func (p *GetTodoResponse) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
 	for {
@@ -4713,13 +4610,69 @@ func (p *GetTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4739,7 +4692,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTodoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateTodoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4749,18 +4702,98 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetTodoResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewTodoItem()
-	if err := _field.Read(iprot); err != nil {
+func (p *CreateTodoRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Todo = _field
+	p.Title = _field
 	return nil
 }
+func (p *CreateTodoRequest) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *GetTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Content = _field
+	return nil
+}
+func (p *CreateTodoRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *CreateTodoRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *CreateTodoRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsAllDay = _field
+	return nil
+}
+func (p *CreateTodoRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Priority = _field
+	return nil
+}
+func (p *CreateTodoRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RemindAt = _field
+	return nil
+}
+func (p *CreateTodoRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Category = _field
+	return nil
+}
+
+func (p *CreateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CreateTodoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4768,6 +4801,34 @@ func (p *GetTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4786,11 +4847,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("todo", thrift.STRUCT, 1); err != nil {
+func (p *CreateTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Todo.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.Title); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4803,27 +4864,159 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetTodoResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *CreateTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("GetTodoResponse(%+v)", *p)
-
+	if err := oprot.WriteString(p.Content); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-type ListTodoRequest struct {
+func (p *CreateTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StartTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func NewListTodoRequest() *ListTodoRequest {
-	return &ListTodoRequest{}
+func (p *CreateTodoRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.EndTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *ListTodoRequest) InitDefault() {
+func (p *CreateTodoRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsAllDay() {
+		if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI16(*p.IsAllDay); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-var fieldIDToName_ListTodoRequest = map[int16]string{}
+func (p *CreateTodoRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("priority", thrift.I16, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI16(p.Priority); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
 
-func (p *ListTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *CreateTodoRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemindAt() {
+		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RemindAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *CreateTodoRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCategory() {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Category); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *CreateTodoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateTodoRequest(%+v)", *p)
+
+}
+
+type CreateTodoResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
+}
+
+func NewCreateTodoResponse() *CreateTodoResponse {
+	return &CreateTodoResponse{}
+}
+
+func (p *CreateTodoResponse) InitDefault() {
+}
+
+func (p *CreateTodoResponse) GetID() (v string) {
+	return p.ID
+}
+
+var fieldIDToName_CreateTodoResponse = map[int16]string{
+	1: "id",
+}
+
+func (p *CreateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4840,8 +5033,20 @@ func (p *ListTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -4856,8 +5061,10 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateTodoResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -4865,11 +5072,28 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ListTodoRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("ListTodoRequest"); err != nil {
+func (p *CreateTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+
+func (p *CreateTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateTodoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4880,40 +5104,59 @@ func (p *ListTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListTodoRequest) String() string {
+func (p *CreateTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CreateTodoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListTodoRequest(%+v)", *p)
+	return fmt.Sprintf("CreateTodoResponse(%+v)", *p)
 
 }
 
-type ListTodoResponse struct {
-	Todos []*TodoItem `thrift:"todos,1,default,list<TodoItem>" form:"todos" json:"todos"`
+type GetTodoRequest struct {
+	ID string `thrift:"id,1" json:"id" query:"id"`
 }
 
-func NewListTodoResponse() *ListTodoResponse {
-	return &ListTodoResponse{}
+func NewGetTodoRequest() *GetTodoRequest {
+	return &GetTodoRequest{}
 }
 
-func (p *ListTodoResponse) InitDefault() {
+func (p *GetTodoRequest) InitDefault() {
 }
 
-func (p *ListTodoResponse) GetTodos() (v []*TodoItem) {
-	return p.Todos
+func (p *GetTodoRequest) GetID() (v string) {
+	return p.ID
 }
 
-var fieldIDToName_ListTodoResponse = map[int16]string{
-	1: "todos",
+var fieldIDToName_GetTodoRequest = map[int16]string{
+	1: "id",
 }
 
-func (p *ListTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4933,7 +5176,7 @@ func (p *ListTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4959,7 +5202,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListTodoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTodoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4969,33 +5212,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ListTodoResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*TodoItem, 0, size)
-	values := make([]TodoItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *GetTodoRequest) ReadField1(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Todos = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *ListTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetTodoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5021,19 +5252,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("todos", thrift.LIST, 1); err != nil {
+func (p *GetTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Todos)); err != nil {
-		return err
-	}
-	for _, v := range p.Todos {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5046,73 +5269,118 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ListTodoResponse) String() string {
+func (p *GetTodoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ListTodoResponse(%+v)", *p)
+	return fmt.Sprintf("GetTodoRequest(%+v)", *p)
 
 }
 
-type SearchTodoRequest struct {
-	Status   *int16  `thrift:"status,1,optional" json:"status,omitempty" query:"status"`
-	Priority *int16  `thrift:"priority,2,optional" json:"priority,omitempty" query:"priority"`
-	Category *string `thrift:"category,3,optional" json:"category,omitempty" query:"category"`
+type TodoItem struct {
+	ID        string  `thrift:"id,1" form:"id" json:"id"`
+	Title     string  `thrift:"title,2" form:"title" json:"title"`
+	Content   string  `thrift:"content,3" form:"content" json:"content"`
+	StartTime int64   `thrift:"start_time,4" form:"start_time" json:"start_time"`
+	EndTime   int64   `thrift:"end_time,5" form:"end_time" json:"end_time"`
+	IsAllDay  int16   `thrift:"is_all_day,6" form:"is_all_day" json:"is_all_day"`
+	Status    int16   `thrift:"status,7" form:"status" json:"status"`
+	Priority  int16   `thrift:"priority,8" form:"priority" json:"priority"`
+	RemindAt  *int64  `thrift:"remind_at,9,optional" form:"remind_at" json:"remind_at,omitempty"`
+	Category  *string `thrift:"category,10,optional" form:"category" json:"category,omitempty"`
+	CreatedAt int64   `thrift:"created_at,11" form:"created_at" json:"created_at"`
+	UpdatedAt int64   `thrift:"updated_at,12" form:"updated_at" json:"updated_at"`
 }
 
-func NewSearchTodoRequest() *SearchTodoRequest {
-	return &SearchTodoRequest{}
+func NewTodoItem() *TodoItem {
+	return &TodoItem{}
 }
 
-func (p *SearchTodoRequest) InitDefault() {
+func (p *TodoItem) InitDefault() {
 }
 
-var SearchTodoRequest_Status_DEFAULT int16
+func (p *TodoItem) GetID() (v string) {
+	return p.ID
+}
 
-func (p *SearchTodoRequest) GetStatus() (v int16) {
-	if !p.IsSetStatus() {
-		return SearchTodoRequest_Status_DEFAULT
-	}
-	return *p.Status
+func (p *TodoItem) GetTitle() (v string) {
+	return p.Title
 }
 
-var SearchTodoRequest_Priority_DEFAULT int16
+func (p *TodoItem) GetContent() (v string) {
+	return p.Content
+}
 
-func (p *SearchTodoRequest) GetPriority() (v int16) {
-	if !p.IsSetPriority() {
-		return SearchTodoRequest_Priority_DEFAULT
+func (p *TodoItem) GetStartTime() (v int64) {
+	return p.StartTime
+}
+
+func (p *TodoItem) GetEndTime() (v int64) {
+	return p.EndTime
+}
+
+func (p *TodoItem) GetIsAllDay() (v int16) {
+	return p.IsAllDay
+}
+
+func (p *TodoItem) GetStatus() (v int16) {
+	return p.Status
+}
+
+func (p *TodoItem) GetPriority() (v int16) {
+	return p.Priority
+}
+
+var TodoItem_RemindAt_DEFAULT int64
+
+func (p *TodoItem) GetRemindAt() (v int64) {
+	if !p.IsSetRemindAt() {
+		return TodoItem_RemindAt_DEFAULT
 	}
-	return *p.Priority
+	return *p.RemindAt
 }
 
-var SearchTodoRequest_Category_DEFAULT string
+var TodoItem_Category_DEFAULT string
 
-func (p *SearchTodoRequest) GetCategory() (v string) {
+func (p *TodoItem) GetCategory() (v string) {
 	if !p.IsSetCategory() {
-		return SearchTodoRequest_Category_DEFAULT
+		return TodoItem_Category_DEFAULT
 	}
 	return *p.Category
 }
 
-var fieldIDToName_SearchTodoRequest = map[int16]string{
-	1: "status",
-	2: "priority",
-	3: "category",
+func (p *TodoItem) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
 
-func (p *SearchTodoRequest) IsSetStatus() bool {
-	return p.Status != nil
+func (p *TodoItem) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
 }
 
-func (p *SearchTodoRequest) IsSetPriority() bool {
-	return p.Priority != nil
+var fieldIDToName_TodoItem = map[int16]string{
+	1:  "id",
+	2:  "title",
+	3:  "content",
+	4:  "start_time",
+	5:  "end_time",
+	6:  "is_all_day",
+	7:  "status",
+	8:  "priority",
+	9:  "remind_at",
+	10: "category",
+	11: "created_at",
+	12: "updated_at",
 }
 
-func (p *SearchTodoRequest) IsSetCategory() bool {
+func (p *TodoItem) IsSetRemindAt() bool {
+	return p.RemindAt != nil
+}
+
+func (p *TodoItem) IsSetCategory() bool {
 	return p.Category != nil
 }
 
-func (p *SearchTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TodoItem) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5132,7 +5400,7 @@ func (p *SearchTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I16 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5140,7 +5408,7 @@ func (p *SearchTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I16 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5155,6 +5423,78 @@ func (p *SearchTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5174,7 +5514,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchTodoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TodoItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5184,243 +5524,142 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SearchTodoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TodoItem) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int16
-	if v, err := iprot.ReadI16(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Status = _field
+	p.ID = _field
 	return nil
 }
-func (p *SearchTodoRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *TodoItem) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int16
-	if v, err := iprot.ReadI16(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Priority = _field
+	p.Title = _field
 	return nil
 }
-func (p *SearchTodoRequest) ReadField3(iprot thrift.TProtocol) error {
+func (p *TodoItem) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
+	var _field string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Category = _field
+	p.Content = _field
 	return nil
 }
+func (p *TodoItem) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *SearchTodoRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchTodoRequest"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.StartTime = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
+func (p *TodoItem) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *SearchTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.I16, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI16(*p.Status); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.EndTime = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *TodoItem) ReadField6(iprot thrift.TProtocol) error {
 
-func (p *SearchTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPriority() {
-		if err = oprot.WriteFieldBegin("priority", thrift.I16, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI16(*p.Priority); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	var _field int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.IsAllDay = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
+func (p *TodoItem) ReadField7(iprot thrift.TProtocol) error {
 
-func (p *SearchTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Category); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	var _field int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.Status = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
+func (p *TodoItem) ReadField8(iprot thrift.TProtocol) error {
 
-func (p *SearchTodoRequest) String() string {
-	if p == nil {
-		return "<nil>"
+	var _field int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return fmt.Sprintf("SearchTodoRequest(%+v)", *p)
-
-}
-
-type SearchTodoResponse struct {
-	Todos []*TodoItem `thrift:"todos,1,default,list<TodoItem>" form:"todos" json:"todos"`
-}
-
-func NewSearchTodoResponse() *SearchTodoResponse {
-	return &SearchTodoResponse{}
-}
-
-func (p *SearchTodoResponse) InitDefault() {
-}
-
-func (p *SearchTodoResponse) GetTodos() (v []*TodoItem) {
-	return p.Todos
-}
-
-var fieldIDToName_SearchTodoResponse = map[int16]string{
-	1: "todos",
+	p.Priority = _field
+	return nil
 }
+func (p *TodoItem) ReadField9(iprot thrift.TProtocol) error {
 
-func (p *SearchTodoResponse) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.RemindAt = _field
+	return nil
+}
+func (p *TodoItem) ReadField10(iprot thrift.TProtocol) error {
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-
+	p.Category = _field
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchTodoResponse[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
+func (p *TodoItem) ReadField11(iprot thrift.TProtocol) error {
 
-func (p *SearchTodoResponse) ReadField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	_field := make([]*TodoItem, 0, size)
-	values := make([]TodoItem, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *TodoItem) ReadField12(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Todos = _field
+	p.UpdatedAt = _field
 	return nil
 }
 
-func (p *SearchTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TodoItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SearchTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TodoItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5428,6 +5667,50 @@ func (p *SearchTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5446,19 +5729,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SearchTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("todos", thrift.LIST, 1); err != nil {
+func (p *TodoItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Todos)); err != nil {
-		return err
-	}
-	for _, v := range p.Todos {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5471,169 +5746,234 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SearchTodoResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *TodoItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("SearchTodoResponse(%+v)", *p)
-
+	if err := oprot.WriteString(p.Title); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-type UpdateTodoRequest struct {
-	ID        string  `thrift:"id,1" form:"id" json:"id"`
-	Title     *string `thrift:"title,2,optional" form:"title" json:"title,omitempty"`
-	Content   *string `thrift:"content,3,optional" form:"content" json:"content,omitempty"`
-	StartTime *int64  `thrift:"start_time,4,optional" form:"start_time" json:"start_time,omitempty"`
-	EndTime   *int64  `thrift:"end_time,5,optional" form:"end_time" json:"end_time,omitempty"`
-	IsAllDay  *int16  `thrift:"is_all_day,6,optional" form:"is_all_day" json:"is_all_day,omitempty"`
-	Status    *int16  `thrift:"status,7,optional" form:"status" json:"status,omitempty"`
-	Priority  *int16  `thrift:"priority,8,optional" form:"priority" json:"priority,omitempty"`
-	RemindAt  *int64  `thrift:"remind_at,9,optional" form:"remind_at" json:"remind_at,omitempty"`
-	Category  *string `thrift:"category,10,optional" form:"category" json:"category,omitempty"`
-}
-
-func NewUpdateTodoRequest() *UpdateTodoRequest {
-	return &UpdateTodoRequest{}
-}
-
-func (p *UpdateTodoRequest) InitDefault() {
+func (p *TodoItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Content); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) GetID() (v string) {
-	return p.ID
+func (p *TodoItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("start_time", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.StartTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-var UpdateTodoRequest_Title_DEFAULT string
-
-func (p *UpdateTodoRequest) GetTitle() (v string) {
-	if !p.IsSetTitle() {
-		return UpdateTodoRequest_Title_DEFAULT
+func (p *TodoItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("end_time", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.Title
+	if err := oprot.WriteI64(p.EndTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-var UpdateTodoRequest_Content_DEFAULT string
-
-func (p *UpdateTodoRequest) GetContent() (v string) {
-	if !p.IsSetContent() {
-		return UpdateTodoRequest_Content_DEFAULT
+func (p *TodoItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 6); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.Content
+	if err := oprot.WriteI16(p.IsAllDay); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-var UpdateTodoRequest_StartTime_DEFAULT int64
-
-func (p *UpdateTodoRequest) GetStartTime() (v int64) {
-	if !p.IsSetStartTime() {
-		return UpdateTodoRequest_StartTime_DEFAULT
+func (p *TodoItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.I16, 7); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.StartTime
+	if err := oprot.WriteI16(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-var UpdateTodoRequest_EndTime_DEFAULT int64
-
-func (p *UpdateTodoRequest) GetEndTime() (v int64) {
-	if !p.IsSetEndTime() {
-		return UpdateTodoRequest_EndTime_DEFAULT
+func (p *TodoItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("priority", thrift.I16, 8); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.EndTime
+	if err := oprot.WriteI16(p.Priority); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-var UpdateTodoRequest_IsAllDay_DEFAULT int16
-
-func (p *UpdateTodoRequest) GetIsAllDay() (v int16) {
-	if !p.IsSetIsAllDay() {
-		return UpdateTodoRequest_IsAllDay_DEFAULT
+func (p *TodoItem) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemindAt() {
+		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RemindAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return *p.IsAllDay
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-var UpdateTodoRequest_Status_DEFAULT int16
-
-func (p *UpdateTodoRequest) GetStatus() (v int16) {
-	if !p.IsSetStatus() {
-		return UpdateTodoRequest_Status_DEFAULT
+func (p *TodoItem) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCategory() {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Category); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return *p.Status
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-var UpdateTodoRequest_Priority_DEFAULT int16
-
-func (p *UpdateTodoRequest) GetPriority() (v int16) {
-	if !p.IsSetPriority() {
-		return UpdateTodoRequest_Priority_DEFAULT
+func (p *TodoItem) writeField11(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 11); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.Priority
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
-var UpdateTodoRequest_RemindAt_DEFAULT int64
-
-func (p *UpdateTodoRequest) GetRemindAt() (v int64) {
-	if !p.IsSetRemindAt() {
-		return UpdateTodoRequest_RemindAt_DEFAULT
+func (p *TodoItem) writeField12(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 12); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.RemindAt
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
 }
 
-var UpdateTodoRequest_Category_DEFAULT string
-
-func (p *UpdateTodoRequest) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return UpdateTodoRequest_Category_DEFAULT
+func (p *TodoItem) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Category
-}
+	return fmt.Sprintf("TodoItem(%+v)", *p)
 
-var fieldIDToName_UpdateTodoRequest = map[int16]string{
-	1:  "id",
-	2:  "title",
-	3:  "content",
-	4:  "start_time",
-	5:  "end_time",
-	6:  "is_all_day",
-	7:  "status",
-	8:  "priority",
-	9:  "remind_at",
-	10: "category",
 }
 
-func (p *UpdateTodoRequest) IsSetTitle() bool {
-	return p.Title != nil
+type GetTodoResponse struct {
+	Todo *TodoItem `thrift:"todo,1" form:"todo" json:"todo"`
 }
 
-func (p *UpdateTodoRequest) IsSetContent() bool {
-	return p.Content != nil
+func NewGetTodoResponse() *GetTodoResponse {
+	return &GetTodoResponse{}
 }
 
-func (p *UpdateTodoRequest) IsSetStartTime() bool {
-	return p.StartTime != nil
+func (p *GetTodoResponse) InitDefault() {
 }
 
-func (p *UpdateTodoRequest) IsSetEndTime() bool {
-	return p.EndTime != nil
+var GetTodoResponse_Todo_DEFAULT *TodoItem
+
+func (p *GetTodoResponse) GetTodo() (v *TodoItem) {
+	if !p.IsSetTodo() {
+		return GetTodoResponse_Todo_DEFAULT
+	}
+	return p.Todo
 }
 
-func (p *UpdateTodoRequest) IsSetIsAllDay() bool {
-	return p.IsAllDay != nil
+var fieldIDToName_GetTodoResponse = map[int16]string{
+	1: "todo",
 }
 
-func (p *UpdateTodoRequest) IsSetStatus() bool {
-	return p.Status != nil
-}
-
-func (p *UpdateTodoRequest) IsSetPriority() bool {
-	return p.Priority != nil
-}
-
-func (p *UpdateTodoRequest) IsSetRemindAt() bool {
-	return p.RemindAt != nil
-}
-
-func (p *UpdateTodoRequest) IsSetCategory() bool {
-	return p.Category != nil
+func (p *GetTodoResponse) IsSetTodo() bool {
+	return p.Todo != nil
 }
 
-func (p *UpdateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5653,85 +5993,13 @@ func (p *UpdateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I16 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5751,7 +6019,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateTodoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetTodoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5761,120 +6029,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ID = _field
-	return nil
-}
-func (p *UpdateTodoRequest) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Title = _field
-	return nil
-}
-func (p *UpdateTodoRequest) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Content = _field
-	return nil
-}
-func (p *UpdateTodoRequest) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.StartTime = _field
-	return nil
-}
-func (p *UpdateTodoRequest) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.EndTime = _field
-	return nil
-}
-func (p *UpdateTodoRequest) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.IsAllDay = _field
-	return nil
-}
-func (p *UpdateTodoRequest) ReadField7(iprot thrift.TProtocol) error {
-
-	var _field *int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Status = _field
-	return nil
-}
-func (p *UpdateTodoRequest) ReadField8(iprot thrift.TProtocol) error {
-
-	var _field *int16
-	if v, err := iprot.ReadI16(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.Priority = _field
-	return nil
-}
-func (p *UpdateTodoRequest) ReadField9(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.RemindAt = _field
-	return nil
-}
-func (p *UpdateTodoRequest) ReadField10(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *GetTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTodoItem()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Category = _field
+	p.Todo = _field
 	return nil
 }
 
-func (p *UpdateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateTodoRequest"); err != nil {
+	if err = oprot.WriteStructBegin("GetTodoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5882,42 +6048,6 @@ func (p *UpdateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5936,11 +6066,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *GetTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("todo", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := p.Todo.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5953,205 +6083,117 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTitle() {
-		if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Title); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetTodoResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
+	return fmt.Sprintf("GetTodoResponse(%+v)", *p)
 
-func (p *UpdateTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetContent() {
-		if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Content); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStartTime() {
-		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.StartTime); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+type ListTodoRequest struct {
 }
 
-func (p *UpdateTodoRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEndTime() {
-		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.EndTime); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+func NewListTodoRequest() *ListTodoRequest {
+	return &ListTodoRequest{}
 }
 
-func (p *UpdateTodoRequest) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsAllDay() {
-		if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI16(*p.IsAllDay); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+func (p *ListTodoRequest) InitDefault() {
 }
 
-func (p *UpdateTodoRequest) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.I16, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI16(*p.Status); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
+var fieldIDToName_ListTodoRequest = map[int16]string{}
 
-func (p *UpdateTodoRequest) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPriority() {
-		if err = oprot.WriteFieldBegin("priority", thrift.I16, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI16(*p.Priority); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ListTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
 
-func (p *UpdateTodoRequest) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRemindAt() {
-		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 9); err != nil {
-			goto WriteFieldBeginError
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.RemindAt); err != nil {
-			return err
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
 		}
 	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 10); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Category); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ListTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListTodoRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateTodoRequest) String() string {
+func (p *ListTodoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateTodoRequest(%+v)", *p)
+	return fmt.Sprintf("ListTodoRequest(%+v)", *p)
 
 }
 
-type UpdateTodoResponse struct {
-	ID string `thrift:"id,1" form:"id" json:"id"`
+type ListTodoResponse struct {
+	Todos []*TodoItem `thrift:"todos,1,default,list<TodoItem>" form:"todos" json:"todos"`
 }
 
-func NewUpdateTodoResponse() *UpdateTodoResponse {
-	return &UpdateTodoResponse{}
+func NewListTodoResponse() *ListTodoResponse {
+	return &ListTodoResponse{}
 }
 
-func (p *UpdateTodoResponse) InitDefault() {
+func (p *ListTodoResponse) InitDefault() {
 }
 
-func (p *UpdateTodoResponse) GetID() (v string) {
-	return p.ID
+func (p *ListTodoResponse) GetTodos() (v []*TodoItem) {
+	return p.Todos
 }
 
-var fieldIDToName_UpdateTodoResponse = map[int16]string{
-	1: "id",
+var fieldIDToName_ListTodoResponse = map[int16]string{
+	1: "todos",
 }
 
-func (p *UpdateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6171,7 +6213,7 @@ func (p *UpdateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6197,7 +6239,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateTodoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListTodoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6207,21 +6249,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TodoItem, 0, size)
+	values := make([]TodoItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ID = _field
+	p.Todos = _field
 	return nil
 }
 
-func (p *UpdateTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("ListTodoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6247,11 +6301,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *ListTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("todos", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Todos)); err != nil {
+		return err
+	}
+	for _, v := range p.Todos {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6264,34 +6326,73 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateTodoResponse) String() string {
+func (p *ListTodoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateTodoResponse(%+v)", *p)
+	return fmt.Sprintf("ListTodoResponse(%+v)", *p)
 
 }
 
-type DeleteTodoRequest struct {
-	ID string `thrift:"id,1" json:"id" query:"id"`
+type SearchTodoRequest struct {
+	Status   *int16  `thrift:"status,1,optional" json:"status,omitempty" query:"status"`
+	Priority *int16  `thrift:"priority,2,optional" json:"priority,omitempty" query:"priority"`
+	Category *string `thrift:"category,3,optional" json:"category,omitempty" query:"category"`
 }
 
-func NewDeleteTodoRequest() *DeleteTodoRequest {
-	return &DeleteTodoRequest{}
+func NewSearchTodoRequest() *SearchTodoRequest {
+	return &SearchTodoRequest{}
 }
 
-func (p *DeleteTodoRequest) InitDefault() {
+func (p *SearchTodoRequest) InitDefault() {
 }
 
-func (p *DeleteTodoRequest) GetID() (v string) {
-	return p.ID
+var SearchTodoRequest_Status_DEFAULT int16
+
+func (p *SearchTodoRequest) GetStatus() (v int16) {
+	if !p.IsSetStatus() {
+		return SearchTodoRequest_Status_DEFAULT
+	}
+	return *p.Status
 }
 
-var fieldIDToName_DeleteTodoRequest = map[int16]string{
-	1: "id",
+var SearchTodoRequest_Priority_DEFAULT int16
+
+func (p *SearchTodoRequest) GetPriority() (v int16) {
+	if !p.IsSetPriority() {
+		return SearchTodoRequest_Priority_DEFAULT
+	}
+	return *p.Priority
 }
 
-func (p *DeleteTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+var SearchTodoRequest_Category_DEFAULT string
+
+func (p *SearchTodoRequest) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return SearchTodoRequest_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var fieldIDToName_SearchTodoRequest = map[int16]string{
+	1: "status",
+	2: "priority",
+	3: "category",
+}
+
+func (p *SearchTodoRequest) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *SearchTodoRequest) IsSetPriority() bool {
+	return p.Priority != nil
+}
+
+func (p *SearchTodoRequest) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *SearchTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6311,13 +6412,29 @@ func (p *DeleteTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I16 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6337,7 +6454,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteTodoRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchTodoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6347,21 +6464,43 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteTodoRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *SearchTodoRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
+	var _field *int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *SearchTodoRequest) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Priority = _field
+	return nil
+}
+func (p *SearchTodoRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.ID = _field
+	p.Category = _field
 	return nil
 }
 
-func (p *DeleteTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *SearchTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteTodoRequest"); err != nil {
+	if err = oprot.WriteStructBegin("SearchTodoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6369,6 +6508,14 @@ func (p *DeleteTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6387,15 +6534,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *SearchTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.I16, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI16(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -6404,34 +6553,72 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteTodoRequest) String() string {
+func (p *SearchTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPriority() {
+		if err = oprot.WriteFieldBegin("priority", thrift.I16, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI16(*p.Priority); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SearchTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCategory() {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Category); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SearchTodoRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteTodoRequest(%+v)", *p)
+	return fmt.Sprintf("SearchTodoRequest(%+v)", *p)
 
 }
 
-type DeleteTodoResponse struct {
-	ID string `thrift:"id,1" form:"id" json:"id"`
+type SearchTodoResponse struct {
+	Todos []*TodoItem `thrift:"todos,1,default,list<TodoItem>" form:"todos" json:"todos"`
 }
 
-func NewDeleteTodoResponse() *DeleteTodoResponse {
-	return &DeleteTodoResponse{}
+func NewSearchTodoResponse() *SearchTodoResponse {
+	return &SearchTodoResponse{}
 }
 
-func (p *DeleteTodoResponse) InitDefault() {
+func (p *SearchTodoResponse) InitDefault() {
 }
 
-func (p *DeleteTodoResponse) GetID() (v string) {
-	return p.ID
+func (p *SearchTodoResponse) GetTodos() (v []*TodoItem) {
+	return p.Todos
 }
 
-var fieldIDToName_DeleteTodoResponse = map[int16]string{
-	1: "id",
+var fieldIDToName_SearchTodoResponse = map[int16]string{
+	1: "todos",
 }
 
-func (p *DeleteTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *SearchTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6451,7 +6638,7 @@ func (p *DeleteTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6477,7 +6664,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteTodoResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchTodoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6487,21 +6674,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *SearchTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*TodoItem, 0, size)
+	values := make([]TodoItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ID = _field
+	p.Todos = _field
 	return nil
 }
 
-func (p *DeleteTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *SearchTodoResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteTodoResponse"); err != nil {
+	if err = oprot.WriteStructBegin("SearchTodoResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6527,11 +6726,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
+func (p *SearchTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("todos", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Todos)); err != nil {
+		return err
+	}
+	for _, v := range p.Todos {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6544,35 +6751,169 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteTodoResponse) String() string {
+func (p *SearchTodoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteTodoResponse(%+v)", *p)
+	return fmt.Sprintf("SearchTodoResponse(%+v)", *p)
 
 }
 
-// ==================== 知识库(Summarize)相关接口 ====================
-type GetSummaryRequest struct {
-	ID string `thrift:"id,1" json:"id" query:"id"`
+type UpdateTodoRequest struct {
+	ID        string  `thrift:"id,1" form:"id" json:"id"`
+	Title     *string `thrift:"title,2,optional" form:"title" json:"title,omitempty"`
+	Content   *string `thrift:"content,3,optional" form:"content" json:"content,omitempty"`
+	StartTime *int64  `thrift:"start_time,4,optional" form:"start_time" json:"start_time,omitempty"`
+	EndTime   *int64  `thrift:"end_time,5,optional" form:"end_time" json:"end_time,omitempty"`
+	IsAllDay  *int16  `thrift:"is_all_day,6,optional" form:"is_all_day" json:"is_all_day,omitempty"`
+	Status    *int16  `thrift:"status,7,optional" form:"status" json:"status,omitempty"`
+	Priority  *int16  `thrift:"priority,8,optional" form:"priority" json:"priority,omitempty"`
+	RemindAt  *int64  `thrift:"remind_at,9,optional" form:"remind_at" json:"remind_at,omitempty"`
+	Category  *string `thrift:"category,10,optional" form:"category" json:"category,omitempty"`
 }
 
-func NewGetSummaryRequest() *GetSummaryRequest {
-	return &GetSummaryRequest{}
+func NewUpdateTodoRequest() *UpdateTodoRequest {
+	return &UpdateTodoRequest{}
 }
 
-func (p *GetSummaryRequest) InitDefault() {
+func (p *UpdateTodoRequest) InitDefault() {
 }
 
-func (p *GetSummaryRequest) GetID() (v string) {
+func (p *UpdateTodoRequest) GetID() (v string) {
 	return p.ID
 }
 
-var fieldIDToName_GetSummaryRequest = map[int16]string{
-	1: "id",
+var UpdateTodoRequest_Title_DEFAULT string
+
+func (p *UpdateTodoRequest) GetTitle() (v string) {
+	if !p.IsSetTitle() {
+		return UpdateTodoRequest_Title_DEFAULT
+	}
+	return *p.Title
 }
 
-func (p *GetSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
+var UpdateTodoRequest_Content_DEFAULT string
+
+func (p *UpdateTodoRequest) GetContent() (v string) {
+	if !p.IsSetContent() {
+		return UpdateTodoRequest_Content_DEFAULT
+	}
+	return *p.Content
+}
+
+var UpdateTodoRequest_StartTime_DEFAULT int64
+
+func (p *UpdateTodoRequest) GetStartTime() (v int64) {
+	if !p.IsSetStartTime() {
+		return UpdateTodoRequest_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var UpdateTodoRequest_EndTime_DEFAULT int64
+
+func (p *UpdateTodoRequest) GetEndTime() (v int64) {
+	if !p.IsSetEndTime() {
+		return UpdateTodoRequest_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var UpdateTodoRequest_IsAllDay_DEFAULT int16
+
+func (p *UpdateTodoRequest) GetIsAllDay() (v int16) {
+	if !p.IsSetIsAllDay() {
+		return UpdateTodoRequest_IsAllDay_DEFAULT
+	}
+	return *p.IsAllDay
+}
+
+var UpdateTodoRequest_Status_DEFAULT int16
+
+func (p *UpdateTodoRequest) GetStatus() (v int16) {
+	if !p.IsSetStatus() {
+		return UpdateTodoRequest_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var UpdateTodoRequest_Priority_DEFAULT int16
+
+func (p *UpdateTodoRequest) GetPriority() (v int16) {
+	if !p.IsSetPriority() {
+		return UpdateTodoRequest_Priority_DEFAULT
+	}
+	return *p.Priority
+}
+
+var UpdateTodoRequest_RemindAt_DEFAULT int64
+
+func (p *UpdateTodoRequest) GetRemindAt() (v int64) {
+	if !p.IsSetRemindAt() {
+		return UpdateTodoRequest_RemindAt_DEFAULT
+	}
+	return *p.RemindAt
+}
+
+var UpdateTodoRequest_Category_DEFAULT string
+
+func (p *UpdateTodoRequest) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return UpdateTodoRequest_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var fieldIDToName_UpdateTodoRequest = map[int16]string{
+	1:  "id",
+	2:  "title",
+	3:  "content",
+	4:  "start_time",
+	5:  "end_time",
+	6:  "is_all_day",
+	7:  "status",
+	8:  "priority",
+	9:  "remind_at",
+	10: "category",
+}
+
+func (p *UpdateTodoRequest) IsSetTitle() bool {
+	return p.Title != nil
+}
+
+func (p *UpdateTodoRequest) IsSetContent() bool {
+	return p.Content != nil
+}
+
+func (p *UpdateTodoRequest) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *UpdateTodoRequest) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *UpdateTodoRequest) IsSetIsAllDay() bool {
+	return p.IsAllDay != nil
+}
+
+func (p *UpdateTodoRequest) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *UpdateTodoRequest) IsSetPriority() bool {
+	return p.Priority != nil
+}
+
+func (p *UpdateTodoRequest) IsSetRemindAt() bool {
+	return p.RemindAt != nil
+}
+
+func (p *UpdateTodoRequest) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *UpdateTodoRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6599,6 +6940,78 @@ func (p *GetSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I16 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6618,7 +7031,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSummaryRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateTodoRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6628,7 +7041,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateTodoRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6639,15 +7052,150 @@ func (p *GetSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
+func (p *UpdateTodoRequest) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *GetSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetSummaryRequest"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Title = _field
+	return nil
+}
+func (p *UpdateTodoRequest) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Content = _field
+	return nil
+}
+func (p *UpdateTodoRequest) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *UpdateTodoRequest) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *UpdateTodoRequest) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsAllDay = _field
+	return nil
+}
+func (p *UpdateTodoRequest) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+func (p *UpdateTodoRequest) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int16
+	if v, err := iprot.ReadI16(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Priority = _field
+	return nil
+}
+func (p *UpdateTodoRequest) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RemindAt = _field
+	return nil
+}
+func (p *UpdateTodoRequest) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Category = _field
+	return nil
+}
+
+func (p *UpdateTodoRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateTodoRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
 			goto WriteFieldError
 		}
 	}
@@ -6668,7 +7216,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UpdateTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6685,76 +7233,205 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetSummaryRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UpdateTodoRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTitle() {
+		if err = oprot.WriteFieldBegin("title", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Title); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("GetSummaryRequest(%+v)", *p)
-
-}
-
-type SummaryItem struct {
-	ID             string            `thrift:"id,1" form:"id" json:"id"`
-	ConversationID string            `thrift:"conversation_id,2" form:"conversation_id" json:"conversation_id"`
-	SummaryText    string            `thrift:"summary_text,3" form:"summary_text" json:"summary_text"`
-	Tags           []string          `thrift:"tags,4,default,list<string>" form:"tags" json:"tags"`
-	ToolCallsJSON  string            `thrift:"tool_calls_json,5" form:"tool_calls_json" json:"tool_calls_json"`
-	Notes          map[string]string `thrift:"notes,6" form:"notes" json:"notes"`
-	CreatedAt      int64             `thrift:"created_at,7" form:"created_at" json:"created_at"`
-	UpdatedAt      int64             `thrift:"updated_at,8" form:"updated_at" json:"updated_at"`
-}
-
-func NewSummaryItem() *SummaryItem {
-	return &SummaryItem{}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *SummaryItem) InitDefault() {
+func (p *UpdateTodoRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetContent() {
+		if err = oprot.WriteFieldBegin("content", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Content); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *SummaryItem) GetID() (v string) {
-	return p.ID
+func (p *UpdateTodoRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartTime() {
+		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StartTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *SummaryItem) GetConversationID() (v string) {
-	return p.ConversationID
+func (p *UpdateTodoRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndTime() {
+		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EndTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *SummaryItem) GetSummaryText() (v string) {
-	return p.SummaryText
+func (p *UpdateTodoRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsAllDay() {
+		if err = oprot.WriteFieldBegin("is_all_day", thrift.I16, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI16(*p.IsAllDay); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *SummaryItem) GetTags() (v []string) {
-	return p.Tags
+func (p *UpdateTodoRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.I16, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI16(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *SummaryItem) GetToolCallsJSON() (v string) {
-	return p.ToolCallsJSON
+func (p *UpdateTodoRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPriority() {
+		if err = oprot.WriteFieldBegin("priority", thrift.I16, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI16(*p.Priority); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *SummaryItem) GetNotes() (v map[string]string) {
-	return p.Notes
+func (p *UpdateTodoRequest) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemindAt() {
+		if err = oprot.WriteFieldBegin("remind_at", thrift.I64, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RemindAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
-func (p *SummaryItem) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+func (p *UpdateTodoRequest) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCategory() {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Category); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
 
-func (p *SummaryItem) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+func (p *UpdateTodoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateTodoRequest(%+v)", *p)
+
 }
 
-var fieldIDToName_SummaryItem = map[int16]string{
+type UpdateTodoResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
+}
+
+func NewUpdateTodoResponse() *UpdateTodoResponse {
+	return &UpdateTodoResponse{}
+}
+
+func (p *UpdateTodoResponse) InitDefault() {
+}
+
+func (p *UpdateTodoResponse) GetID() (v string) {
+	return p.ID
+}
+
+var fieldIDToName_UpdateTodoResponse = map[int16]string{
 	1: "id",
-	2: "conversation_id",
-	3: "summary_text",
-	4: "tags",
-	5: "tool_calls_json",
-	6: "notes",
-	7: "created_at",
-	8: "updated_at",
 }
 
-func (p *SummaryItem) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateTodoResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6781,62 +7458,6 @@ func (p *SummaryItem) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6856,7 +7477,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummaryItem[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateTodoResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6866,7 +7487,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SummaryItem) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateTodoResponse) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6877,117 +7498,150 @@ func (p *SummaryItem) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *SummaryItem) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *UpdateTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateTodoResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.ConversationID = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *SummaryItem) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *UpdateTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.SummaryText = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *SummaryItem) ReadField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
+
+func (p *UpdateTodoResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
+	return fmt.Sprintf("UpdateTodoResponse(%+v)", *p)
 
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
+}
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Tags = _field
-	return nil
+type DeleteTodoRequest struct {
+	ID string `thrift:"id,1" json:"id" query:"id"`
 }
-func (p *SummaryItem) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ToolCallsJSON = _field
-	return nil
+func NewDeleteTodoRequest() *DeleteTodoRequest {
+	return &DeleteTodoRequest{}
 }
-func (p *SummaryItem) ReadField6(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
+
+func (p *DeleteTodoRequest) InitDefault() {
+}
+
+func (p *DeleteTodoRequest) GetID() (v string) {
+	return p.ID
+}
+
+var fieldIDToName_DeleteTodoRequest = map[int16]string{
+	1: "id",
+}
+
+func (p *DeleteTodoRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
 
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
 		}
 
-		_field[_key] = _val
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	p.Notes = _field
-	return nil
-}
-func (p *SummaryItem) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.CreatedAt = _field
 	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteTodoRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *SummaryItem) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *DeleteTodoRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.UpdatedAt = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *SummaryItem) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteTodoRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SummaryItem"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteTodoRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6995,34 +7649,6 @@ func (p *SummaryItem) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7041,7 +7667,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SummaryItem) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *DeleteTodoRequest) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7058,132 +7684,134 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SummaryItem) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
+func (p *DeleteTodoRequest) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err := oprot.WriteString(p.ConversationID); err != nil {
-		return err
+	return fmt.Sprintf("DeleteTodoRequest(%+v)", *p)
+
+}
+
+type DeleteTodoResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
+}
+
+func NewDeleteTodoResponse() *DeleteTodoResponse {
+	return &DeleteTodoResponse{}
+}
+
+func (p *DeleteTodoResponse) InitDefault() {
+}
+
+func (p *DeleteTodoResponse) GetID() (v string) {
+	return p.ID
+}
+
+var fieldIDToName_DeleteTodoResponse = map[int16]string{
+	1: "id",
+}
+
+func (p *DeleteTodoResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
+
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteTodoResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SummaryItem) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("summary_text", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.SummaryText); err != nil {
+func (p *DeleteTodoResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
+	p.ID = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *SummaryItem) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
-		return err
+func (p *DeleteTodoResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteTodoResponse"); err != nil {
+		goto WriteStructBeginError
 	}
-	for _, v := range p.Tags {
-		if err := oprot.WriteString(v); err != nil {
-			return err
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *SummaryItem) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ToolCallsJSON); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *SummaryItem) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("notes", thrift.MAP, 6); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
-		return err
-	}
-	for k, v := range p.Notes {
-		if err := oprot.WriteString(k); err != nil {
-			return err
-		}
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteMapEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *SummaryItem) writeField7(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 7); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CreatedAt); err != nil {
-		return err
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SummaryItem) writeField8(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 8); err != nil {
+func (p *DeleteTodoResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7191,48 +7819,40 @@ func (p *SummaryItem) writeField8(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SummaryItem) String() string {
+func (p *DeleteTodoResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SummaryItem(%+v)", *p)
-
-}
+	return fmt.Sprintf("DeleteTodoResponse(%+v)", *p)
 
-type GetSummaryResponse struct {
-	Summary *SummaryItem `thrift:"summary,1" form:"summary" json:"summary"`
 }
 
-func NewGetSummaryResponse() *GetSummaryResponse {
-	return &GetSummaryResponse{}
+// ==================== 知识库(Summarize)相关接口 ====================
+type GetSummaryRequest struct {
+	ID string `thrift:"id,1" json:"id" query:"id"`
 }
 
-func (p *GetSummaryResponse) InitDefault() {
+func NewGetSummaryRequest() *GetSummaryRequest {
+	return &GetSummaryRequest{}
 }
 
-var GetSummaryResponse_Summary_DEFAULT *SummaryItem
-
-func (p *GetSummaryResponse) GetSummary() (v *SummaryItem) {
-	if !p.IsSetSummary() {
-		return GetSummaryResponse_Summary_DEFAULT
-	}
-	return p.Summary
+func (p *GetSummaryRequest) InitDefault() {
 }
 
-var fieldIDToName_GetSummaryResponse = map[int16]string{
-	1: "summary",
+func (p *GetSummaryRequest) GetID() (v string) {
+	return p.ID
 }
 
-func (p *GetSummaryResponse) IsSetSummary() bool {
-	return p.Summary != nil
+var fieldIDToName_GetSummaryRequest = map[int16]string{
+	1: "id",
 }
 
-func (p *GetSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7252,7 +7872,7 @@ func (p *GetSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -7278,7 +7898,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSummaryResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSummaryRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7288,18 +7908,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSummaryItem()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Summary = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *GetSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetSummaryResponse"); err != nil {
+	if err = oprot.WriteStructBegin("GetSummaryRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7325,11 +7948,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("summary", thrift.STRUCT, 1); err != nil {
+func (p *GetSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Summary.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7342,117 +7965,76 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetSummaryResponse) String() string {
+func (p *GetSummaryRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetSummaryResponse(%+v)", *p)
+	return fmt.Sprintf("GetSummaryRequest(%+v)", *p)
 
 }
 
-type ListSummaryRequest struct {
+type SummaryItem struct {
+	ID             string            `thrift:"id,1" form:"id" json:"id"`
+	ConversationID string            `thrift:"conversation_id,2" form:"conversation_id" json:"conversation_id"`
+	SummaryText    string            `thrift:"summary_text,3" form:"summary_text" json:"summary_text"`
+	Tags           []string          `thrift:"tags,4,default,list<string>" form:"tags" json:"tags"`
+	ToolCallsJSON  string            `thrift:"tool_calls_json,5" form:"tool_calls_json" json:"tool_calls_json"`
+	Notes          map[string]string `thrift:"notes,6" form:"notes" json:"notes"`
+	CreatedAt      int64             `thrift:"created_at,7" form:"created_at" json:"created_at"`
+	UpdatedAt      int64             `thrift:"updated_at,8" form:"updated_at" json:"updated_at"`
 }
 
-func NewListSummaryRequest() *ListSummaryRequest {
-	return &ListSummaryRequest{}
+func NewSummaryItem() *SummaryItem {
+	return &SummaryItem{}
 }
 
-func (p *ListSummaryRequest) InitDefault() {
+func (p *SummaryItem) InitDefault() {
 }
 
-var fieldIDToName_ListSummaryRequest = map[int16]string{}
+func (p *SummaryItem) GetID() (v string) {
+	return p.ID
+}
 
-func (p *ListSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *ListSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("ListSummaryRequest"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *ListSummaryRequest) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ListSummaryRequest(%+v)", *p)
-
-}
-
-type ListSummaryResponse struct {
-	Summaries []*SummaryItem `thrift:"summaries,1,default,list<SummaryItem>" form:"summaries" json:"summaries"`
-}
-
-func NewListSummaryResponse() *ListSummaryResponse {
-	return &ListSummaryResponse{}
-}
-
-func (p *ListSummaryResponse) InitDefault() {
-}
-
-func (p *ListSummaryResponse) GetSummaries() (v []*SummaryItem) {
-	return p.Summaries
-}
-
-var fieldIDToName_ListSummaryResponse = map[int16]string{
-	1: "summaries",
-}
-
-func (p *ListSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *SummaryItem) GetConversationID() (v string) {
+	return p.ConversationID
+}
+
+func (p *SummaryItem) GetSummaryText() (v string) {
+	return p.SummaryText
+}
+
+func (p *SummaryItem) GetTags() (v []string) {
+	return p.Tags
+}
+
+func (p *SummaryItem) GetToolCallsJSON() (v string) {
+	return p.ToolCallsJSON
+}
+
+func (p *SummaryItem) GetNotes() (v map[string]string) {
+	return p.Notes
+}
+
+func (p *SummaryItem) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *SummaryItem) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+var fieldIDToName_SummaryItem = map[int16]string{
+	1: "id",
+	2: "conversation_id",
+	3: "summary_text",
+	4: "tags",
+	5: "tool_calls_json",
+	6: "notes",
+	7: "created_at",
+	8: "updated_at",
+}
+
+func (p *SummaryItem) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7472,13 +8054,69 @@ func (p *ListSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7498,7 +8136,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSummaryResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SummaryItem[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7508,19 +8146,52 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ListSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *SummaryItem) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *SummaryItem) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ConversationID = _field
+	return nil
+}
+func (p *SummaryItem) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SummaryText = _field
+	return nil
+}
+func (p *SummaryItem) ReadField4(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*SummaryItem, 0, size)
-	values := make([]SummaryItem, size)
+	_field := make([]string, 0, size)
 	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
 
-		if err := _elem.Read(iprot); err != nil {
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
 			return err
+		} else {
+			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -7528,13 +8199,75 @@ func (p *ListSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Summaries = _field
+	p.Tags = _field
 	return nil
 }
+func (p *SummaryItem) ReadField5(iprot thrift.TProtocol) error {
 
-func (p *ListSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ToolCallsJSON = _field
+	return nil
+}
+func (p *SummaryItem) ReadField6(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
+
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return err
+	}
+	p.Notes = _field
+	return nil
+}
+func (p *SummaryItem) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+func (p *SummaryItem) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UpdatedAt = _field
+	return nil
+}
+
+func (p *SummaryItem) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListSummaryResponse"); err != nil {
+	if err = oprot.WriteStructBegin("SummaryItem"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7542,6 +8275,34 @@ func (p *ListSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7560,19 +8321,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ListSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("summaries", thrift.LIST, 1); err != nil {
+func (p *SummaryItem) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Summaries)); err != nil {
-		return err
-	}
-	for _, v := range p.Summaries {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7585,94 +8338,181 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ListSummaryResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *SummaryItem) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return fmt.Sprintf("ListSummaryResponse(%+v)", *p)
-
+	if err := oprot.WriteString(p.ConversationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-type UpdateSummaryRequest struct {
-	ID            string            `thrift:"id,1" form:"id" json:"id"`
-	SummaryText   *string           `thrift:"summary_text,2,optional" form:"summary_text" json:"summary_text,omitempty"`
-	Tags          []string          `thrift:"tags,3,optional,list<string>" form:"tags" json:"tags,omitempty"`
-	ToolCallsJSON *string           `thrift:"tool_calls_json,4,optional" form:"tool_calls_json" json:"tool_calls_json,omitempty"`
-	Notes         map[string]string `thrift:"notes,5,optional" form:"notes" json:"notes,omitempty"`
+func (p *SummaryItem) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("summary_text", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SummaryText); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func NewUpdateSummaryRequest() *UpdateSummaryRequest {
-	return &UpdateSummaryRequest{}
+func (p *SummaryItem) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tags", thrift.LIST, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+		return err
+	}
+	for _, v := range p.Tags {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) InitDefault() {
+func (p *SummaryItem) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ToolCallsJSON); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) GetID() (v string) {
-	return p.ID
+func (p *SummaryItem) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("notes", thrift.MAP, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
+		return err
+	}
+	for k, v := range p.Notes {
+		if err := oprot.WriteString(k); err != nil {
+			return err
+		}
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteMapEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-var UpdateSummaryRequest_SummaryText_DEFAULT string
-
-func (p *UpdateSummaryRequest) GetSummaryText() (v string) {
-	if !p.IsSetSummaryText() {
-		return UpdateSummaryRequest_SummaryText_DEFAULT
+func (p *SummaryItem) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("created_at", thrift.I64, 7); err != nil {
+		goto WriteFieldBeginError
 	}
-	return *p.SummaryText
+	if err := oprot.WriteI64(p.CreatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-var UpdateSummaryRequest_Tags_DEFAULT []string
-
-func (p *UpdateSummaryRequest) GetTags() (v []string) {
-	if !p.IsSetTags() {
-		return UpdateSummaryRequest_Tags_DEFAULT
+func (p *SummaryItem) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("updated_at", thrift.I64, 8); err != nil {
+		goto WriteFieldBeginError
 	}
-	return p.Tags
+	if err := oprot.WriteI64(p.UpdatedAt); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-var UpdateSummaryRequest_ToolCallsJSON_DEFAULT string
-
-func (p *UpdateSummaryRequest) GetToolCallsJSON() (v string) {
-	if !p.IsSetToolCallsJSON() {
-		return UpdateSummaryRequest_ToolCallsJSON_DEFAULT
+func (p *SummaryItem) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.ToolCallsJSON
-}
+	return fmt.Sprintf("SummaryItem(%+v)", *p)
 
-var UpdateSummaryRequest_Notes_DEFAULT map[string]string
+}
 
-func (p *UpdateSummaryRequest) GetNotes() (v map[string]string) {
-	if !p.IsSetNotes() {
-		return UpdateSummaryRequest_Notes_DEFAULT
-	}
-	return p.Notes
+type GetSummaryResponse struct {
+	Summary *SummaryItem `thrift:"summary,1" form:"summary" json:"summary"`
 }
 
-var fieldIDToName_UpdateSummaryRequest = map[int16]string{
-	1: "id",
-	2: "summary_text",
-	3: "tags",
-	4: "tool_calls_json",
-	5: "notes",
+func NewGetSummaryResponse() *GetSummaryResponse {
+	return &GetSummaryResponse{}
 }
 
-func (p *UpdateSummaryRequest) IsSetSummaryText() bool {
-	return p.SummaryText != nil
+func (p *GetSummaryResponse) InitDefault() {
 }
 
-func (p *UpdateSummaryRequest) IsSetTags() bool {
-	return p.Tags != nil
+var GetSummaryResponse_Summary_DEFAULT *SummaryItem
+
+func (p *GetSummaryResponse) GetSummary() (v *SummaryItem) {
+	if !p.IsSetSummary() {
+		return GetSummaryResponse_Summary_DEFAULT
+	}
+	return p.Summary
 }
 
-func (p *UpdateSummaryRequest) IsSetToolCallsJSON() bool {
-	return p.ToolCallsJSON != nil
+var fieldIDToName_GetSummaryResponse = map[int16]string{
+	1: "summary",
 }
 
-func (p *UpdateSummaryRequest) IsSetNotes() bool {
-	return p.Notes != nil
+func (p *GetSummaryResponse) IsSetSummary() bool {
+	return p.Summary != nil
 }
 
-func (p *UpdateSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7692,45 +8532,13 @@ func (p *UpdateSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.MAP {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7750,7 +8558,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateSummaryRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetSummaryResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7760,95 +8568,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *GetSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSummaryItem()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ID = _field
+	p.Summary = _field
 	return nil
 }
-func (p *UpdateSummaryRequest) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.SummaryText = _field
-	return nil
-}
-func (p *UpdateSummaryRequest) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Tags = _field
-	return nil
-}
-func (p *UpdateSummaryRequest) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ToolCallsJSON = _field
-	return nil
-}
-func (p *UpdateSummaryRequest) ReadField5(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return err
-	}
-	_field := make(map[string]string, size)
-	for i := 0; i < size; i++ {
-		var _key string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_key = v
-		}
-
-		var _val string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_val = v
-		}
-
-		_field[_key] = _val
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return err
-	}
-	p.Notes = _field
-	return nil
-}
-
-func (p *UpdateSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateSummaryRequest"); err != nil {
+	if err = oprot.WriteStructBegin("GetSummaryResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7856,22 +8587,6 @@ func (p *UpdateSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7890,11 +8605,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *GetSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("summary", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := p.Summary.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -7907,129 +8622,27 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateSummaryRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSummaryText() {
-		if err = oprot.WriteFieldBegin("summary_text", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.SummaryText); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *UpdateSummaryRequest) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTags() {
-		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
-			return err
-		}
-		for _, v := range p.Tags {
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *UpdateSummaryRequest) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetToolCallsJSON() {
-		if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.ToolCallsJSON); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *UpdateSummaryRequest) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNotes() {
-		if err = oprot.WriteFieldBegin("notes", thrift.MAP, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
-			return err
-		}
-		for k, v := range p.Notes {
-			if err := oprot.WriteString(k); err != nil {
-				return err
-			}
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *UpdateSummaryRequest) String() string {
+func (p *GetSummaryResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateSummaryRequest(%+v)", *p)
-
-}
+	return fmt.Sprintf("GetSummaryResponse(%+v)", *p)
 
-type UpdateSummaryResponse struct {
-	ID string `thrift:"id,1" form:"id" json:"id"`
 }
 
-func NewUpdateSummaryResponse() *UpdateSummaryResponse {
-	return &UpdateSummaryResponse{}
+type ListSummaryRequest struct {
 }
 
-func (p *UpdateSummaryResponse) InitDefault() {
+func NewListSummaryRequest() *ListSummaryRequest {
+	return &ListSummaryRequest{}
 }
 
-func (p *UpdateSummaryResponse) GetID() (v string) {
-	return p.ID
+func (p *ListSummaryRequest) InitDefault() {
 }
 
-var fieldIDToName_UpdateSummaryResponse = map[int16]string{
-	1: "id",
-}
+var fieldIDToName_ListSummaryRequest = map[int16]string{}
 
-func (p *UpdateSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8046,20 +8659,8 @@ func (p *UpdateSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -8074,10 +8675,8 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateSummaryResponse[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -8085,28 +8684,11 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ID = _field
-	return nil
-}
-
-func (p *UpdateSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateSummaryResponse"); err != nil {
+func (p *ListSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListSummaryRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8117,59 +8699,40 @@ func (p *UpdateSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *UpdateSummaryResponse) String() string {
+func (p *ListSummaryRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateSummaryResponse(%+v)", *p)
+	return fmt.Sprintf("ListSummaryRequest(%+v)", *p)
 
 }
 
-type DeleteSummaryRequest struct {
-	ID string `thrift:"id,1" json:"id" query:"id"`
+type ListSummaryResponse struct {
+	Summaries []*SummaryItem `thrift:"summaries,1,default,list<SummaryItem>" form:"summaries" json:"summaries"`
 }
 
-func NewDeleteSummaryRequest() *DeleteSummaryRequest {
-	return &DeleteSummaryRequest{}
+func NewListSummaryResponse() *ListSummaryResponse {
+	return &ListSummaryResponse{}
 }
 
-func (p *DeleteSummaryRequest) InitDefault() {
+func (p *ListSummaryResponse) InitDefault() {
 }
 
-func (p *DeleteSummaryRequest) GetID() (v string) {
-	return p.ID
+func (p *ListSummaryResponse) GetSummaries() (v []*SummaryItem) {
+	return p.Summaries
 }
 
-var fieldIDToName_DeleteSummaryRequest = map[int16]string{
-	1: "id",
+var fieldIDToName_ListSummaryResponse = map[int16]string{
+	1: "summaries",
 }
 
-func (p *DeleteSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8189,7 +8752,7 @@ func (p *DeleteSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -8215,7 +8778,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteSummaryRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSummaryResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8225,21 +8788,33 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SummaryItem, 0, size)
+	values := make([]SummaryItem, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ID = _field
+	p.Summaries = _field
 	return nil
 }
 
-func (p *DeleteSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteSummaryRequest"); err != nil {
+	if err = oprot.WriteStructBegin("ListSummaryResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8265,11 +8840,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+func (p *ListSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("summaries", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ID); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Summaries)); err != nil {
+		return err
+	}
+	for _, v := range p.Summaries {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8282,34 +8865,94 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteSummaryRequest) String() string {
+func (p *ListSummaryResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteSummaryRequest(%+v)", *p)
+	return fmt.Sprintf("ListSummaryResponse(%+v)", *p)
 
 }
 
-type DeleteSummaryResponse struct {
-	ID string `thrift:"id,1" form:"id" json:"id"`
+type UpdateSummaryRequest struct {
+	ID            string            `thrift:"id,1" form:"id" json:"id"`
+	SummaryText   *string           `thrift:"summary_text,2,optional" form:"summary_text" json:"summary_text,omitempty"`
+	Tags          []string          `thrift:"tags,3,optional,list<string>" form:"tags" json:"tags,omitempty"`
+	ToolCallsJSON *string           `thrift:"tool_calls_json,4,optional" form:"tool_calls_json" json:"tool_calls_json,omitempty"`
+	Notes         map[string]string `thrift:"notes,5,optional" form:"notes" json:"notes,omitempty"`
 }
 
-func NewDeleteSummaryResponse() *DeleteSummaryResponse {
-	return &DeleteSummaryResponse{}
+func NewUpdateSummaryRequest() *UpdateSummaryRequest {
+	return &UpdateSummaryRequest{}
 }
 
-func (p *DeleteSummaryResponse) InitDefault() {
+func (p *UpdateSummaryRequest) InitDefault() {
 }
 
-func (p *DeleteSummaryResponse) GetID() (v string) {
+func (p *UpdateSummaryRequest) GetID() (v string) {
 	return p.ID
 }
 
-var fieldIDToName_DeleteSummaryResponse = map[int16]string{
+var UpdateSummaryRequest_SummaryText_DEFAULT string
+
+func (p *UpdateSummaryRequest) GetSummaryText() (v string) {
+	if !p.IsSetSummaryText() {
+		return UpdateSummaryRequest_SummaryText_DEFAULT
+	}
+	return *p.SummaryText
+}
+
+var UpdateSummaryRequest_Tags_DEFAULT []string
+
+func (p *UpdateSummaryRequest) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return UpdateSummaryRequest_Tags_DEFAULT
+	}
+	return p.Tags
+}
+
+var UpdateSummaryRequest_ToolCallsJSON_DEFAULT string
+
+func (p *UpdateSummaryRequest) GetToolCallsJSON() (v string) {
+	if !p.IsSetToolCallsJSON() {
+		return UpdateSummaryRequest_ToolCallsJSON_DEFAULT
+	}
+	return *p.ToolCallsJSON
+}
+
+var UpdateSummaryRequest_Notes_DEFAULT map[string]string
+
+func (p *UpdateSummaryRequest) GetNotes() (v map[string]string) {
+	if !p.IsSetNotes() {
+		return UpdateSummaryRequest_Notes_DEFAULT
+	}
+	return p.Notes
+}
+
+var fieldIDToName_UpdateSummaryRequest = map[int16]string{
 	1: "id",
+	2: "summary_text",
+	3: "tags",
+	4: "tool_calls_json",
+	5: "notes",
 }
 
-func (p *DeleteSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateSummaryRequest) IsSetSummaryText() bool {
+	return p.SummaryText != nil
+}
+
+func (p *UpdateSummaryRequest) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *UpdateSummaryRequest) IsSetToolCallsJSON() bool {
+	return p.ToolCallsJSON != nil
+}
+
+func (p *UpdateSummaryRequest) IsSetNotes() bool {
+	return p.Notes != nil
+}
+
+func (p *UpdateSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8336,6 +8979,38 @@ func (p *DeleteSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.MAP {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -8355,7 +9030,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteSummaryResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateSummaryRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8365,7 +9040,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -8376,192 +9051,84 @@ func (p *DeleteSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
+func (p *UpdateSummaryRequest) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *DeleteSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteSummaryResponse"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.SummaryText = _field
 	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-
-func (p *DeleteSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ID); err != nil {
+func (p *UpdateSummaryRequest) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *DeleteSummaryResponse) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("DeleteSummaryResponse(%+v)", *p)
-
-}
-
-type CourseListRequest struct {
-	Term      string `thrift:"term,1,required" json:"term,required" query:"term,required"`
-	IsRefresh *bool  `thrift:"is_refresh,2,optional" json:"is_refresh,omitempty" query:"is_refresh"`
-}
-
-func NewCourseListRequest() *CourseListRequest {
-	return &CourseListRequest{}
-}
-
-func (p *CourseListRequest) InitDefault() {
-}
-
-func (p *CourseListRequest) GetTerm() (v string) {
-	return p.Term
-}
-
-var CourseListRequest_IsRefresh_DEFAULT bool
-
-func (p *CourseListRequest) GetIsRefresh() (v bool) {
-	if !p.IsSetIsRefresh() {
-		return CourseListRequest_IsRefresh_DEFAULT
-	}
-	return *p.IsRefresh
-}
-
-var fieldIDToName_CourseListRequest = map[int16]string{
-	1: "term",
-	2: "is_refresh",
-}
-
-func (p *CourseListRequest) IsSetIsRefresh() bool {
-	return p.IsRefresh != nil
-}
-
-func (p *CourseListRequest) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-	var issetTerm bool = false
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
 		}
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetTerm = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+		_field = append(_field, _elem)
 	}
-
-	if !issetTerm {
-		fieldId = 1
-		goto RequiredFieldNotSetError
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
 	}
+	p.Tags = _field
 	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseListRequest[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseListRequest[fieldId]))
 }
+func (p *UpdateSummaryRequest) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *CourseListRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
+	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.Term = _field
+	p.ToolCallsJSON = _field
 	return nil
 }
-func (p *CourseListRequest) ReadField2(iprot thrift.TProtocol) error {
+func (p *UpdateSummaryRequest) ReadField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	_field := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		var _key string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_key = v
+		}
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+		var _val string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_val = v
+		}
+
+		_field[_key] = _val
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.IsRefresh = _field
+	p.Notes = _field
 	return nil
 }
 
-func (p *CourseListRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CourseListRequest"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateSummaryRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8573,6 +9140,18 @@ func (p *CourseListRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8591,11 +9170,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseListRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("term", thrift.STRING, 1); err != nil {
+func (p *UpdateSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Term); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8608,12 +9187,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseListRequest) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsRefresh() {
-		if err = oprot.WriteFieldBegin("is_refresh", thrift.BOOL, 2); err != nil {
+func (p *UpdateSummaryRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSummaryText() {
+		if err = oprot.WriteFieldBegin("summary_text", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.IsRefresh); err != nil {
+		if err := oprot.WriteString(*p.SummaryText); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -8627,54 +9206,113 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *CourseListRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UpdateSummaryRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTags() {
+		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+			return err
+		}
+		for _, v := range p.Tags {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("CourseListRequest(%+v)", *p)
-
-}
-
-type CourseListResponse struct {
-	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
-	Data []*model.Course `thrift:"data,2,required,list<model.Course>" form:"data,required" json:"data,required"`
-}
-
-func NewCourseListResponse() *CourseListResponse {
-	return &CourseListResponse{}
-}
-
-func (p *CourseListResponse) InitDefault() {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-var CourseListResponse_Base_DEFAULT *model.BaseResp
-
-func (p *CourseListResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return CourseListResponse_Base_DEFAULT
+func (p *UpdateSummaryRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToolCallsJSON() {
+		if err = oprot.WriteFieldBegin("tool_calls_json", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ToolCallsJSON); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return p.Base
-}
-
-func (p *CourseListResponse) GetData() (v []*model.Course) {
-	return p.Data
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-var fieldIDToName_CourseListResponse = map[int16]string{
-	1: "base",
-	2: "data",
+func (p *UpdateSummaryRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNotes() {
+		if err = oprot.WriteFieldBegin("notes", thrift.MAP, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Notes)); err != nil {
+			return err
+		}
+		for k, v := range p.Notes {
+			if err := oprot.WriteString(k); err != nil {
+				return err
+			}
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *CourseListResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *UpdateSummaryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateSummaryRequest(%+v)", *p)
+
 }
 
-func (p *CourseListResponse) Read(iprot thrift.TProtocol) (err error) {
+type UpdateSummaryResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
+}
+
+func NewUpdateSummaryResponse() *UpdateSummaryResponse {
+	return &UpdateSummaryResponse{}
+}
+
+func (p *UpdateSummaryResponse) InitDefault() {
+}
+
+func (p *UpdateSummaryResponse) GetID() (v string) {
+	return p.ID
+}
+
+var fieldIDToName_UpdateSummaryResponse = map[int16]string{
+	1: "id",
+}
+
+func (p *UpdateSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetBase bool = false
-	var issetData bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -8691,20 +9329,10 @@ func (p *CourseListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetBase = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetData = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -8721,22 +9349,13 @@ func (p *CourseListResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetBase {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetData {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateSummaryResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8744,45 +9363,23 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseListResponse[fieldId]))
-}
-
-func (p *CourseListResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := model.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Base = _field
-	return nil
 }
-func (p *CourseListResponse) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*model.Course, 0, size)
-	values := make([]model.Course, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
 
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *UpdateSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Data = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *CourseListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CourseListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateSummaryResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8790,10 +9387,6 @@ func (p *CourseListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8812,11 +9405,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseListResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 1); err != nil {
+func (p *UpdateSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Base.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -8829,52 +9422,34 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseListResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("data", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Data)); err != nil {
-		return err
-	}
-	for _, v := range p.Data {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *CourseListResponse) String() string {
+func (p *UpdateSummaryResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseListResponse(%+v)", *p)
+	return fmt.Sprintf("UpdateSummaryResponse(%+v)", *p)
 
 }
 
-type CourseTermListRequest struct {
+type DeleteSummaryRequest struct {
+	ID string `thrift:"id,1" json:"id" query:"id"`
 }
 
-func NewCourseTermListRequest() *CourseTermListRequest {
-	return &CourseTermListRequest{}
+func NewDeleteSummaryRequest() *DeleteSummaryRequest {
+	return &DeleteSummaryRequest{}
 }
 
-func (p *CourseTermListRequest) InitDefault() {
+func (p *DeleteSummaryRequest) InitDefault() {
 }
 
-var fieldIDToName_CourseTermListRequest = map[int16]string{}
+func (p *DeleteSummaryRequest) GetID() (v string) {
+	return p.ID
+}
 
-func (p *CourseTermListRequest) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_DeleteSummaryRequest = map[int16]string{
+	1: "id",
+}
+
+func (p *DeleteSummaryRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8891,8 +9466,20 @@ func (p *CourseTermListRequest) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -8907,8 +9494,10 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteSummaryRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -8916,11 +9505,28 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseTermListRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("CourseTermListRequest"); err != nil {
+func (p *DeleteSummaryRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+
+func (p *DeleteSummaryRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteSummaryRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -8931,60 +9537,62 @@ func (p *CourseTermListRequest) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseTermListRequest) String() string {
+func (p *DeleteSummaryRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteSummaryRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseTermListRequest(%+v)", *p)
+	return fmt.Sprintf("DeleteSummaryRequest(%+v)", *p)
 
 }
 
-type CourseTermListResponse struct {
-	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
-	Data []string        `thrift:"data,2,required,list<string>" form:"data,required" json:"data,required"`
+type DeleteSummaryResponse struct {
+	ID string `thrift:"id,1" form:"id" json:"id"`
 }
 
-func NewCourseTermListResponse() *CourseTermListResponse {
-	return &CourseTermListResponse{}
+func NewDeleteSummaryResponse() *DeleteSummaryResponse {
+	return &DeleteSummaryResponse{}
 }
 
-func (p *CourseTermListResponse) InitDefault() {
-}
-
-var CourseTermListResponse_Base_DEFAULT *model.BaseResp
-
-func (p *CourseTermListResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return CourseTermListResponse_Base_DEFAULT
-	}
-	return p.Base
-}
-
-func (p *CourseTermListResponse) GetData() (v []string) {
-	return p.Data
+func (p *DeleteSummaryResponse) InitDefault() {
 }
 
-var fieldIDToName_CourseTermListResponse = map[int16]string{
-	1: "base",
-	2: "data",
+func (p *DeleteSummaryResponse) GetID() (v string) {
+	return p.ID
 }
 
-func (p *CourseTermListResponse) IsSetBase() bool {
-	return p.Base != nil
+var fieldIDToName_DeleteSummaryResponse = map[int16]string{
+	1: "id",
 }
 
-func (p *CourseTermListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *DeleteSummaryResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetBase bool = false
-	var issetData bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9001,20 +9609,10 @@ func (p *CourseTermListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetBase = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetData = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9031,22 +9629,13 @@ func (p *CourseTermListResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetBase {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetData {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseTermListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteSummaryResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9054,45 +9643,23 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseTermListResponse[fieldId]))
-}
-
-func (p *CourseTermListResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := model.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Base = _field
-	return nil
 }
-func (p *CourseTermListResponse) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
 
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
+func (p *DeleteSummaryResponse) ReadField1(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Data = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *CourseTermListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *DeleteSummaryResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CourseTermListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteSummaryResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9100,10 +9667,6 @@ func (p *CourseTermListResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9122,11 +9685,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseTermListResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 1); err != nil {
+func (p *DeleteSummaryResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Base.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9139,62 +9702,53 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseTermListResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("data", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.Data)); err != nil {
-		return err
-	}
-	for _, v := range p.Data {
-		if err := oprot.WriteString(v); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *CourseTermListResponse) String() string {
+func (p *DeleteSummaryResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseTermListResponse(%+v)", *p)
+	return fmt.Sprintf("DeleteSummaryResponse(%+v)", *p)
 
 }
 
-type UpdateUserSettingRequest struct {
-	SettingJSON string `thrift:"setting_json,1" form:"setting_json" json:"setting_json"`
+type CourseListRequest struct {
+	Term      string `thrift:"term,1,required" json:"term,required" query:"term,required"`
+	IsRefresh *bool  `thrift:"is_refresh,2,optional" json:"is_refresh,omitempty" query:"is_refresh"`
 }
 
-func NewUpdateUserSettingRequest() *UpdateUserSettingRequest {
-	return &UpdateUserSettingRequest{}
+func NewCourseListRequest() *CourseListRequest {
+	return &CourseListRequest{}
 }
 
-func (p *UpdateUserSettingRequest) InitDefault() {
+func (p *CourseListRequest) InitDefault() {
 }
 
-func (p *UpdateUserSettingRequest) GetSettingJSON() (v string) {
-	return p.SettingJSON
+func (p *CourseListRequest) GetTerm() (v string) {
+	return p.Term
 }
 
-var fieldIDToName_UpdateUserSettingRequest = map[int16]string{
-	1: "setting_json",
+var CourseListRequest_IsRefresh_DEFAULT bool
+
+func (p *CourseListRequest) GetIsRefresh() (v bool) {
+	if !p.IsSetIsRefresh() {
+		return CourseListRequest_IsRefresh_DEFAULT
+	}
+	return *p.IsRefresh
 }
 
-func (p *UpdateUserSettingRequest) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_CourseListRequest = map[int16]string{
+	1: "term",
+	2: "is_refresh",
+}
+
+func (p *CourseListRequest) IsSetIsRefresh() bool {
+	return p.IsRefresh != nil
+}
+
+func (p *CourseListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetTerm bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9215,6 +9769,15 @@ func (p *UpdateUserSettingRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetTerm = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9231,13 +9794,17 @@ func (p *UpdateUserSettingRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetTerm {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserSettingRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseListRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9245,9 +9812,11 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseListRequest[fieldId]))
 }
 
-func (p *UpdateUserSettingRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *CourseListRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -9255,13 +9824,24 @@ func (p *UpdateUserSettingRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SettingJSON = _field
+	p.Term = _field
 	return nil
 }
+func (p *CourseListRequest) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *UpdateUserSettingRequest) Write(oprot thrift.TProtocol) (err error) {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsRefresh = _field
+	return nil
+}
+
+func (p *CourseListRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserSettingRequest"); err != nil {
+	if err = oprot.WriteStructBegin("CourseListRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9269,6 +9849,10 @@ func (p *UpdateUserSettingRequest) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9287,11 +9871,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateUserSettingRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("setting_json", thrift.STRING, 1); err != nil {
+func (p *CourseListRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("term", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.SettingJSON); err != nil {
+	if err := oprot.WriteString(p.Term); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9304,37 +9888,73 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateUserSettingRequest) String() string {
+func (p *CourseListRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsRefresh() {
+		if err = oprot.WriteFieldBegin("is_refresh", thrift.BOOL, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsRefresh); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CourseListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateUserSettingRequest(%+v)", *p)
+	return fmt.Sprintf("CourseListRequest(%+v)", *p)
 
 }
 
-type UpdateUserSettingResponse struct {
-	UserID string `thrift:"user_id,1" form:"user_id" json:"user_id"`
+type CourseListResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
+	Data []*model.Course `thrift:"data,2,required,list<model.Course>" form:"data,required" json:"data,required"`
 }
 
-func NewUpdateUserSettingResponse() *UpdateUserSettingResponse {
-	return &UpdateUserSettingResponse{}
+func NewCourseListResponse() *CourseListResponse {
+	return &CourseListResponse{}
 }
 
-func (p *UpdateUserSettingResponse) InitDefault() {
+func (p *CourseListResponse) InitDefault() {
 }
 
-func (p *UpdateUserSettingResponse) GetUserID() (v string) {
-	return p.UserID
-}
+var CourseListResponse_Base_DEFAULT *model.BaseResp
 
-var fieldIDToName_UpdateUserSettingResponse = map[int16]string{
-	1: "user_id",
+func (p *CourseListResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CourseListResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-func (p *UpdateUserSettingResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseListResponse) GetData() (v []*model.Course) {
+	return p.Data
+}
+
+var fieldIDToName_CourseListResponse = map[int16]string{
+	1: "base",
+	2: "data",
+}
+
+func (p *CourseListResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CourseListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetBase bool = false
+	var issetData bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9351,10 +9971,20 @@ func (p *UpdateUserSettingResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetBase = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetData = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9371,13 +10001,22 @@ func (p *UpdateUserSettingResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetData {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserSettingResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseListResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9385,23 +10024,45 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseListResponse[fieldId]))
 }
 
-func (p *UpdateUserSettingResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *CourseListResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+func (p *CourseListResponse) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*model.Course, 0, size)
+	values := make([]model.Course, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.UserID = _field
+	p.Data = _field
 	return nil
 }
 
-func (p *UpdateUserSettingResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserSettingResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CourseListResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9409,6 +10070,10 @@ func (p *UpdateUserSettingResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -9427,11 +10092,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateUserSettingResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
+func (p *CourseListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.UserID); err != nil {
+	if err := p.Base.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9444,27 +10109,52 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdateUserSettingResponse) String() string {
+func (p *CourseListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Data)); err != nil {
+		return err
+	}
+	for _, v := range p.Data {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CourseListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateUserSettingResponse(%+v)", *p)
+	return fmt.Sprintf("CourseListResponse(%+v)", *p)
 
 }
 
-type TermListRequest struct {
+type CourseTermListRequest struct {
 }
 
-func NewTermListRequest() *TermListRequest {
-	return &TermListRequest{}
+func NewCourseTermListRequest() *CourseTermListRequest {
+	return &CourseTermListRequest{}
 }
 
-func (p *TermListRequest) InitDefault() {
+func (p *CourseTermListRequest) InitDefault() {
 }
 
-var fieldIDToName_TermListRequest = map[int16]string{}
+var fieldIDToName_CourseTermListRequest = map[int16]string{}
 
-func (p *TermListRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseTermListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9506,8 +10196,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *TermListRequest) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("TermListRequest"); err != nil {
+func (p *CourseTermListRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("CourseTermListRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9527,63 +10217,54 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TermListRequest) String() string {
+func (p *CourseTermListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TermListRequest(%+v)", *p)
+	return fmt.Sprintf("CourseTermListRequest(%+v)", *p)
 
 }
 
-type TermListResponse struct {
-	Base      *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
-	TermLists *model.TermList `thrift:"term_lists,2,required" form:"term_lists,required" json:"term_lists,required"`
+type CourseTermListResponse struct {
+	Base *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
+	Data []string        `thrift:"data,2,required,list<string>" form:"data,required" json:"data,required"`
 }
 
-func NewTermListResponse() *TermListResponse {
-	return &TermListResponse{}
+func NewCourseTermListResponse() *CourseTermListResponse {
+	return &CourseTermListResponse{}
 }
 
-func (p *TermListResponse) InitDefault() {
+func (p *CourseTermListResponse) InitDefault() {
 }
 
-var TermListResponse_Base_DEFAULT *model.BaseResp
+var CourseTermListResponse_Base_DEFAULT *model.BaseResp
 
-func (p *TermListResponse) GetBase() (v *model.BaseResp) {
+func (p *CourseTermListResponse) GetBase() (v *model.BaseResp) {
 	if !p.IsSetBase() {
-		return TermListResponse_Base_DEFAULT
+		return CourseTermListResponse_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var TermListResponse_TermLists_DEFAULT *model.TermList
-
-func (p *TermListResponse) GetTermLists() (v *model.TermList) {
-	if !p.IsSetTermLists() {
-		return TermListResponse_TermLists_DEFAULT
-	}
-	return p.TermLists
+func (p *CourseTermListResponse) GetData() (v []string) {
+	return p.Data
 }
 
-var fieldIDToName_TermListResponse = map[int16]string{
+var fieldIDToName_CourseTermListResponse = map[int16]string{
 	1: "base",
-	2: "term_lists",
+	2: "data",
 }
 
-func (p *TermListResponse) IsSetBase() bool {
+func (p *CourseTermListResponse) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *TermListResponse) IsSetTermLists() bool {
-	return p.TermLists != nil
-}
-
-func (p *TermListResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseTermListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
 	var issetBase bool = false
-	var issetTermLists bool = false
+	var issetData bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9609,11 +10290,11 @@ func (p *TermListResponse) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetTermLists = true
+				issetData = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9635,7 +10316,7 @@ func (p *TermListResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetTermLists {
+	if !issetData {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
@@ -9645,7 +10326,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TermListResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseTermListResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9654,10 +10335,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TermListResponse[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CourseTermListResponse[fieldId]))
 }
 
-func (p *TermListResponse) ReadField1(iprot thrift.TProtocol) error {
+func (p *CourseTermListResponse) ReadField1(iprot thrift.TProtocol) error {
 	_field := model.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -9665,18 +10346,33 @@ func (p *TermListResponse) ReadField1(iprot thrift.TProtocol) error {
 	p.Base = _field
 	return nil
 }
-func (p *TermListResponse) ReadField2(iprot thrift.TProtocol) error {
-	_field := model.NewTermList()
-	if err := _field.Read(iprot); err != nil {
+func (p *CourseTermListResponse) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.TermLists = _field
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Data = _field
 	return nil
 }
 
-func (p *TermListResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseTermListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TermListResponse"); err != nil {
+	if err = oprot.WriteStructBegin("CourseTermListResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9706,7 +10402,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TermListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CourseTermListResponse) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9723,11 +10419,19 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TermListResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("term_lists", thrift.STRUCT, 2); err != nil {
+func (p *CourseTermListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("data", thrift.LIST, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.TermLists.Write(oprot); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Data)); err != nil {
+		return err
+	}
+	for _, v := range p.Data {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9740,39 +10444,37 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *TermListResponse) String() string {
+func (p *CourseTermListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TermListResponse(%+v)", *p)
+	return fmt.Sprintf("CourseTermListResponse(%+v)", *p)
 
 }
 
-// 学期信息
-type TermRequest struct {
-	Term string `thrift:"term,1,required" json:"term,required" query:"term,required"`
+type UpdateUserSettingRequest struct {
+	SettingJSON string `thrift:"setting_json,1" form:"setting_json" json:"setting_json"`
 }
 
-func NewTermRequest() *TermRequest {
-	return &TermRequest{}
+func NewUpdateUserSettingRequest() *UpdateUserSettingRequest {
+	return &UpdateUserSettingRequest{}
 }
 
-func (p *TermRequest) InitDefault() {
+func (p *UpdateUserSettingRequest) InitDefault() {
 }
 
-func (p *TermRequest) GetTerm() (v string) {
-	return p.Term
+func (p *UpdateUserSettingRequest) GetSettingJSON() (v string) {
+	return p.SettingJSON
 }
 
-var fieldIDToName_TermRequest = map[int16]string{
-	1: "term",
+var fieldIDToName_UpdateUserSettingRequest = map[int16]string{
+	1: "setting_json",
 }
 
-func (p *TermRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateUserSettingRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetTerm bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9793,7 +10495,6 @@ func (p *TermRequest) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetTerm = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9810,17 +10511,13 @@ func (p *TermRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetTerm {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TermRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserSettingRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9828,11 +10525,9 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TermRequest[fieldId]))
 }
 
-func (p *TermRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *UpdateUserSettingRequest) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -9840,13 +10535,13 @@ func (p *TermRequest) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.Term = _field
+	p.SettingJSON = _field
 	return nil
 }
 
-func (p *TermRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateUserSettingRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TermRequest"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateUserSettingRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9872,11 +10567,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TermRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("term", thrift.STRING, 1); err != nil {
+func (p *UpdateUserSettingRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("setting_json", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Term); err != nil {
+	if err := oprot.WriteString(p.SettingJSON); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -9889,63 +10584,37 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TermRequest) String() string {
+func (p *UpdateUserSettingRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TermRequest(%+v)", *p)
-
-}
-
-type TermResponse struct {
-	Base     *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
-	TermInfo *model.TermInfo `thrift:"term_info,2,required" form:"term_info,required" json:"term_info,required"`
-}
-
-func NewTermResponse() *TermResponse {
-	return &TermResponse{}
-}
+	return fmt.Sprintf("UpdateUserSettingRequest(%+v)", *p)
 
-func (p *TermResponse) InitDefault() {
 }
 
-var TermResponse_Base_DEFAULT *model.BaseResp
-
-func (p *TermResponse) GetBase() (v *model.BaseResp) {
-	if !p.IsSetBase() {
-		return TermResponse_Base_DEFAULT
-	}
-	return p.Base
+type UpdateUserSettingResponse struct {
+	UserID string `thrift:"user_id,1" form:"user_id" json:"user_id"`
 }
 
-var TermResponse_TermInfo_DEFAULT *model.TermInfo
-
-func (p *TermResponse) GetTermInfo() (v *model.TermInfo) {
-	if !p.IsSetTermInfo() {
-		return TermResponse_TermInfo_DEFAULT
-	}
-	return p.TermInfo
+func NewUpdateUserSettingResponse() *UpdateUserSettingResponse {
+	return &UpdateUserSettingResponse{}
 }
 
-var fieldIDToName_TermResponse = map[int16]string{
-	1: "base",
-	2: "term_info",
+func (p *UpdateUserSettingResponse) InitDefault() {
 }
 
-func (p *TermResponse) IsSetBase() bool {
-	return p.Base != nil
+func (p *UpdateUserSettingResponse) GetUserID() (v string) {
+	return p.UserID
 }
 
-func (p *TermResponse) IsSetTermInfo() bool {
-	return p.TermInfo != nil
+var fieldIDToName_UpdateUserSettingResponse = map[int16]string{
+	1: "user_id",
 }
 
-func (p *TermResponse) Read(iprot thrift.TProtocol) (err error) {
+func (p *UpdateUserSettingResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetBase bool = false
-	var issetTermInfo bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -9962,20 +10631,10 @@ func (p *TermResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetBase = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetTermInfo = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -9992,22 +10651,13 @@ func (p *TermResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetBase {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetTermInfo {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TermResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserSettingResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10015,30 +10665,23 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TermResponse[fieldId]))
 }
 
-func (p *TermResponse) ReadField1(iprot thrift.TProtocol) error {
-	_field := model.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Base = _field
-	return nil
-}
-func (p *TermResponse) ReadField2(iprot thrift.TProtocol) error {
-	_field := model.NewTermInfo()
-	if err := _field.Read(iprot); err != nil {
+func (p *UpdateUserSettingResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.TermInfo = _field
+	p.UserID = _field
 	return nil
 }
 
-func (p *TermResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *UpdateUserSettingResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("TermResponse"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateUserSettingResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10046,10 +10689,6 @@ func (p *TermResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10068,11 +10707,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *TermResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 1); err != nil {
+func (p *UpdateUserSettingResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Base.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10085,51 +10724,27 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *TermResponse) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("term_info", thrift.STRUCT, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.TermInfo.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *TermResponse) String() string {
+func (p *UpdateUserSettingResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TermResponse(%+v)", *p)
-
-}
+	return fmt.Sprintf("UpdateUserSettingResponse(%+v)", *p)
 
-type DeleteConversationRequest struct {
-	ConversationID string `thrift:"conversation_id,1" form:"conversation_id" json:"conversation_id"`
 }
 
-func NewDeleteConversationRequest() *DeleteConversationRequest {
-	return &DeleteConversationRequest{}
+type TermListRequest struct {
 }
 
-func (p *DeleteConversationRequest) InitDefault() {
+func NewTermListRequest() *TermListRequest {
+	return &TermListRequest{}
 }
 
-func (p *DeleteConversationRequest) GetConversationID() (v string) {
-	return p.ConversationID
+func (p *TermListRequest) InitDefault() {
 }
 
-var fieldIDToName_DeleteConversationRequest = map[int16]string{
-	1: "conversation_id",
-}
+var fieldIDToName_TermListRequest = map[int16]string{}
 
-func (p *DeleteConversationRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TermListRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10146,20 +10761,8 @@ func (p *DeleteConversationRequest) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -10174,10 +10777,8 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteConversationRequest[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -10185,28 +10786,11 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteConversationRequest) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ConversationID = _field
-	return nil
-}
-
-func (p *DeleteConversationRequest) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteConversationRequest"); err != nil {
+func (p *TermListRequest) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("TermListRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10217,62 +10801,69 @@ func (p *DeleteConversationRequest) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteConversationRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ConversationID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *DeleteConversationRequest) String() string {
+func (p *TermListRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteConversationRequest(%+v)", *p)
+	return fmt.Sprintf("TermListRequest(%+v)", *p)
 
 }
 
-type DeleteConversationResponse struct {
-	ConversationID string `thrift:"conversation_id,1" form:"conversation_id" json:"conversation_id"`
+type TermListResponse struct {
+	Base      *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
+	TermLists *model.TermList `thrift:"term_lists,2,required" form:"term_lists,required" json:"term_lists,required"`
 }
 
-func NewDeleteConversationResponse() *DeleteConversationResponse {
-	return &DeleteConversationResponse{}
+func NewTermListResponse() *TermListResponse {
+	return &TermListResponse{}
 }
 
-func (p *DeleteConversationResponse) InitDefault() {
+func (p *TermListResponse) InitDefault() {
 }
 
-func (p *DeleteConversationResponse) GetConversationID() (v string) {
-	return p.ConversationID
-}
+var TermListResponse_Base_DEFAULT *model.BaseResp
 
-var fieldIDToName_DeleteConversationResponse = map[int16]string{
-	1: "conversation_id",
+func (p *TermListResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return TermListResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-func (p *DeleteConversationResponse) Read(iprot thrift.TProtocol) (err error) {
+var TermListResponse_TermLists_DEFAULT *model.TermList
+
+func (p *TermListResponse) GetTermLists() (v *model.TermList) {
+	if !p.IsSetTermLists() {
+		return TermListResponse_TermLists_DEFAULT
+	}
+	return p.TermLists
+}
+
+var fieldIDToName_TermListResponse = map[int16]string{
+	1: "base",
+	2: "term_lists",
+}
+
+func (p *TermListResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *TermListResponse) IsSetTermLists() bool {
+	return p.TermLists != nil
+}
+
+func (p *TermListResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetBase bool = false
+	var issetTermLists bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -10289,10 +10880,20 @@ func (p *DeleteConversationResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetBase = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTermLists = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -10309,13 +10910,22 @@ func (p *DeleteConversationResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTermLists {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteConversationResponse[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TermListResponse[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10323,23 +10933,30 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TermListResponse[fieldId]))
 }
 
-func (p *DeleteConversationResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TermListResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.ConversationID = _field
+	p.Base = _field
+	return nil
+}
+func (p *TermListResponse) ReadField2(iprot thrift.TProtocol) error {
+	_field := model.NewTermList()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TermLists = _field
 	return nil
 }
 
-func (p *DeleteConversationResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TermListResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteConversationResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TermListResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10347,6 +10964,10 @@ func (p *DeleteConversationResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10365,11 +10986,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteConversationResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
+func (p *TermListResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.ConversationID); err != nil {
+	if err := p.Base.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10382,46 +11003,56 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteConversationResponse) String() string {
+func (p *TermListResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("term_lists", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.TermLists.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TermListResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteConversationResponse(%+v)", *p)
-
-}
+	return fmt.Sprintf("TermListResponse(%+v)", *p)
 
-type DailyScheduleRequest struct {
-	IsRefresh *bool `thrift:"is_refresh,1,optional" json:"is_refresh,omitempty" query:"is_refresh"`
 }
 
-func NewDailyScheduleRequest() *DailyScheduleRequest {
-	return &DailyScheduleRequest{}
+// 学期信息
+type TermRequest struct {
+	Term string `thrift:"term,1,required" json:"term,required" query:"term,required"`
 }
 
-func (p *DailyScheduleRequest) InitDefault() {
+func NewTermRequest() *TermRequest {
+	return &TermRequest{}
 }
 
-var DailyScheduleRequest_IsRefresh_DEFAULT bool
-
-func (p *DailyScheduleRequest) GetIsRefresh() (v bool) {
-	if !p.IsSetIsRefresh() {
-		return DailyScheduleRequest_IsRefresh_DEFAULT
-	}
-	return *p.IsRefresh
+func (p *TermRequest) InitDefault() {
 }
 
-var fieldIDToName_DailyScheduleRequest = map[int16]string{
-	1: "is_refresh",
+func (p *TermRequest) GetTerm() (v string) {
+	return p.Term
 }
 
-func (p *DailyScheduleRequest) IsSetIsRefresh() bool {
-	return p.IsRefresh != nil
+var fieldIDToName_TermRequest = map[int16]string{
+	1: "term",
 }
 
-func (p *DailyScheduleRequest) Read(iprot thrift.TProtocol) (err error) {
+func (p *TermRequest) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetTerm bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -10438,10 +11069,11 @@ func (p *DailyScheduleRequest) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.BOOL {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetTerm = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -10458,13 +11090,17 @@ func (p *DailyScheduleRequest) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetTerm {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DailyScheduleRequest[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TermRequest[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10472,23 +11108,25 @@ ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TermRequest[fieldId]))
 }
 
-func (p *DailyScheduleRequest) ReadField1(iprot thrift.TProtocol) error {
+func (p *TermRequest) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.IsRefresh = _field
+	p.Term = _field
 	return nil
 }
 
-func (p *DailyScheduleRequest) Write(oprot thrift.TProtocol) (err error) {
+func (p *TermRequest) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DailyScheduleRequest"); err != nil {
+	if err = oprot.WriteStructBegin("TermRequest"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10514,17 +11152,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DailyScheduleRequest) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsRefresh() {
-		if err = oprot.WriteFieldBegin("is_refresh", thrift.BOOL, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBool(*p.IsRefresh); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *TermRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("term", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Term); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -10533,37 +11169,63 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DailyScheduleRequest) String() string {
+func (p *TermRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DailyScheduleRequest(%+v)", *p)
+	return fmt.Sprintf("TermRequest(%+v)", *p)
 
 }
 
-type DailyScheduleResponse struct {
-	Schedule string `thrift:"schedule,1" form:"schedule" json:"schedule"`
+type TermResponse struct {
+	Base     *model.BaseResp `thrift:"base,1,required" form:"base,required" json:"base,required"`
+	TermInfo *model.TermInfo `thrift:"term_info,2,required" form:"term_info,required" json:"term_info,required"`
 }
 
-func NewDailyScheduleResponse() *DailyScheduleResponse {
-	return &DailyScheduleResponse{}
+func NewTermResponse() *TermResponse {
+	return &TermResponse{}
 }
 
-func (p *DailyScheduleResponse) InitDefault() {
+func (p *TermResponse) InitDefault() {
 }
 
-func (p *DailyScheduleResponse) GetSchedule() (v string) {
-	return p.Schedule
+var TermResponse_Base_DEFAULT *model.BaseResp
+
+func (p *TermResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return TermResponse_Base_DEFAULT
+	}
+	return p.Base
 }
 
-var fieldIDToName_DailyScheduleResponse = map[int16]string{
-	1: "schedule",
+var TermResponse_TermInfo_DEFAULT *model.TermInfo
+
+func (p *TermResponse) GetTermInfo() (v *model.TermInfo) {
+	if !p.IsSetTermInfo() {
+		return TermResponse_TermInfo_DEFAULT
+	}
+	return p.TermInfo
 }
 
-func (p *DailyScheduleResponse) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_TermResponse = map[int16]string{
+	1: "base",
+	2: "term_info",
+}
+
+func (p *TermResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *TermResponse) IsSetTermInfo() bool {
+	return p.TermInfo != nil
+}
+
+func (p *TermResponse) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetBase bool = false
+	var issetTermInfo bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -10580,10 +11242,20 @@ func (p *DailyScheduleResponse) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
+				issetBase = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTermInfo = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -10600,37 +11272,53 @@ func (p *DailyScheduleResponse) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DailyScheduleResponse[fieldId]), err)
-SkipFieldError:
+	if !issetBase {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTermInfo {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TermResponse[fieldId]), err)
+SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TermResponse[fieldId]))
 }
 
-func (p *DailyScheduleResponse) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *TermResponse) ReadField1(iprot thrift.TProtocol) error {
+	_field := model.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.Schedule = _field
+	p.Base = _field
+	return nil
+}
+func (p *TermResponse) ReadField2(iprot thrift.TProtocol) error {
+	_field := model.NewTermInfo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TermInfo = _field
 	return nil
 }
 
-func (p *DailyScheduleResponse) Write(oprot thrift.TProtocol) (err error) {
+func (p *TermResponse) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DailyScheduleResponse"); err != nil {
+	if err = oprot.WriteStructBegin("TermResponse"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10638,6 +11326,10 @@ func (p *DailyScheduleResponse) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -10656,11 +11348,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DailyScheduleResponse) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("schedule", thrift.STRING, 1); err != nil {
+func (p *TermResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Schedule); err != nil {
+	if err := p.Base.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -10673,397 +11365,2330 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DailyScheduleResponse) String() string {
+func (p *TermResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("term_info", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.TermInfo.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TermResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DailyScheduleResponse(%+v)", *p)
+	return fmt.Sprintf("TermResponse(%+v)", *p)
 
 }
 
-type ApiService interface {
-	// 非流式对话
-	Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error)
-	// 流式对话
-	ChatSSE(ctx context.Context, req *ChatSSEHandlerRequest) (r *ChatSSEHandlerResponse, err error)
-	// 示例接口 idl写好后运行make hertz-gen-api生成脚手架
-	Template(ctx context.Context, req *TemplateRequest) (r *TemplateResponse, err error)
-	// 获取会话历史
-	GetConversationHistory(ctx context.Context, req *GetConversationHistoryRequest) (r *GetConversationHistoryResponse, err error)
-	// 删除会话
-	DeleteConversation(ctx context.Context, req *DeleteConversationRequest) (r *DeleteConversationResponse, err error)
-	// 获取对话列表
-	ListConversations(ctx context.Context, req *ListConversationsRequest) (r *ListConversationsResponse, err error)
-	// 会话总结
-	SummarizeConversation(ctx context.Context, req *SummarizeConversationRequest) (r *SummarizeConversationResponse, err error)
-	// 获取jwch登录数据
-	GetLoginData(ctx context.Context, req *GetLoginDataRequest) (r *GetLoginDataResponse, err error)
-	// 获取用户信息
-	GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (r *GetUserInfoResponse, err error)
-	// 更新用户设置
-	UpdateUserSetting(ctx context.Context, req *UpdateUserSettingRequest) (r *UpdateUserSettingResponse, err error)
-	// 待办事项管理
-	// 创建待办事项
-	CreateTodo(ctx context.Context, req *CreateTodoRequest) (r *CreateTodoResponse, err error)
-	// 获取待办事项详情
-	GetTodo(ctx context.Context, req *GetTodoRequest) (r *GetTodoResponse, err error)
-	// 获取所有待办事项列表
-	ListTodo(ctx context.Context, req *ListTodoRequest) (r *ListTodoResponse, err error)
-	// 搜索待办事项
-	SearchTodo(ctx context.Context, req *SearchTodoRequest) (r *SearchTodoResponse, err error)
-	// 更新待办事项
-	UpdateTodo(ctx context.Context, req *UpdateTodoRequest) (r *UpdateTodoResponse, err error)
-	// 删除待办事项
-	DeleteTodo(ctx context.Context, req *DeleteTodoRequest) (r *DeleteTodoResponse, err error)
-	// 知识库(摘要)管理
-	// 获取摘要详情
-	GetSummary(ctx context.Context, req *GetSummaryRequest) (r *GetSummaryResponse, err error)
-	// 获取所有摘要列表
-	ListSummary(ctx context.Context, req *ListSummaryRequest) (r *ListSummaryResponse, err error)
-	// 更新摘要
-	UpdateSummary(ctx context.Context, req *UpdateSummaryRequest) (r *UpdateSummaryResponse, err error)
-	// 删除摘要
-	DeleteSummary(ctx context.Context, req *DeleteSummaryRequest) (r *DeleteSummaryResponse, err error)
-	// 课程相关接口
-	// 获取课表
-	GetCourseList(ctx context.Context, req *CourseListRequest) (r *CourseListResponse, err error)
-	// 获取学期
-	GetTermList(ctx context.Context, req *CourseTermListRequest) (r *CourseTermListResponse, err error)
-	// 校历信息：学期列表
-	GetTermsList(ctx context.Context, req *TermListRequest) (r *TermListResponse, err error)
-	// 校历信息：学期详情
-	GetTerm(ctx context.Context, req *TermRequest) (r *TermResponse, err error)
-	// 每日日程
-	DailySchedule(ctx context.Context, req *DailyScheduleRequest) (r *DailyScheduleResponse, err error)
+type DeleteConversationRequest struct {
+	ConversationID string `thrift:"conversation_id,1" form:"conversation_id" json:"conversation_id"`
 }
 
-type ApiServiceClient struct {
-	c thrift.TClient
+func NewDeleteConversationRequest() *DeleteConversationRequest {
+	return &DeleteConversationRequest{}
 }
 
-func NewApiServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ApiServiceClient {
-	return &ApiServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *DeleteConversationRequest) InitDefault() {
 }
 
-func NewApiServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ApiServiceClient {
-	return &ApiServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *DeleteConversationRequest) GetConversationID() (v string) {
+	return p.ConversationID
 }
 
-func NewApiServiceClient(c thrift.TClient) *ApiServiceClient {
-	return &ApiServiceClient{
-		c: c,
-	}
+var fieldIDToName_DeleteConversationRequest = map[int16]string{
+	1: "conversation_id",
 }
 
-func (p *ApiServiceClient) Client_() thrift.TClient {
-	return p.c
-}
+func (p *DeleteConversationRequest) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *ApiServiceClient) Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error) {
-	var _args ApiServiceChatArgs
-	_args.Req = req
-	var _result ApiServiceChatResult
-	if err = p.Client_().Call(ctx, "Chat", &_args, &_result); err != nil {
-		return
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) ChatSSE(ctx context.Context, req *ChatSSEHandlerRequest) (r *ChatSSEHandlerResponse, err error) {
-	var _args ApiServiceChatSSEArgs
-	_args.Req = req
-	var _result ApiServiceChatSSEResult
-	if err = p.Client_().Call(ctx, "ChatSSE", &_args, &_result); err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) Template(ctx context.Context, req *TemplateRequest) (r *TemplateResponse, err error) {
-	var _args ApiServiceTemplateArgs
-	_args.Req = req
-	var _result ApiServiceTemplateResult
-	if err = p.Client_().Call(ctx, "Template", &_args, &_result); err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return _result.GetSuccess(), nil
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteConversationRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *ApiServiceClient) GetConversationHistory(ctx context.Context, req *GetConversationHistoryRequest) (r *GetConversationHistoryResponse, err error) {
-	var _args ApiServiceGetConversationHistoryArgs
-	_args.Req = req
-	var _result ApiServiceGetConversationHistoryResult
-	if err = p.Client_().Call(ctx, "GetConversationHistory", &_args, &_result); err != nil {
-		return
+
+func (p *DeleteConversationRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.ConversationID = _field
+	return nil
 }
-func (p *ApiServiceClient) DeleteConversation(ctx context.Context, req *DeleteConversationRequest) (r *DeleteConversationResponse, err error) {
-	var _args ApiServiceDeleteConversationArgs
-	_args.Req = req
-	var _result ApiServiceDeleteConversationResult
-	if err = p.Client_().Call(ctx, "DeleteConversation", &_args, &_result); err != nil {
-		return
+
+func (p *DeleteConversationRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteConversationRequest"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) ListConversations(ctx context.Context, req *ListConversationsRequest) (r *ListConversationsResponse, err error) {
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteConversationRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ConversationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteConversationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteConversationRequest(%+v)", *p)
+
+}
+
+type DeleteConversationResponse struct {
+	ConversationID string `thrift:"conversation_id,1" form:"conversation_id" json:"conversation_id"`
+}
+
+func NewDeleteConversationResponse() *DeleteConversationResponse {
+	return &DeleteConversationResponse{}
+}
+
+func (p *DeleteConversationResponse) InitDefault() {
+}
+
+func (p *DeleteConversationResponse) GetConversationID() (v string) {
+	return p.ConversationID
+}
+
+var fieldIDToName_DeleteConversationResponse = map[int16]string{
+	1: "conversation_id",
+}
+
+func (p *DeleteConversationResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteConversationResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeleteConversationResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ConversationID = _field
+	return nil
+}
+
+func (p *DeleteConversationResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteConversationResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteConversationResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("conversation_id", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ConversationID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteConversationResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteConversationResponse(%+v)", *p)
+
+}
+
+type DailyScheduleRequest struct {
+	IsRefresh *bool `thrift:"is_refresh,1,optional" json:"is_refresh,omitempty" query:"is_refresh"`
+}
+
+func NewDailyScheduleRequest() *DailyScheduleRequest {
+	return &DailyScheduleRequest{}
+}
+
+func (p *DailyScheduleRequest) InitDefault() {
+}
+
+var DailyScheduleRequest_IsRefresh_DEFAULT bool
+
+func (p *DailyScheduleRequest) GetIsRefresh() (v bool) {
+	if !p.IsSetIsRefresh() {
+		return DailyScheduleRequest_IsRefresh_DEFAULT
+	}
+	return *p.IsRefresh
+}
+
+var fieldIDToName_DailyScheduleRequest = map[int16]string{
+	1: "is_refresh",
+}
+
+func (p *DailyScheduleRequest) IsSetIsRefresh() bool {
+	return p.IsRefresh != nil
+}
+
+func (p *DailyScheduleRequest) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DailyScheduleRequest[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DailyScheduleRequest) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsRefresh = _field
+	return nil
+}
+
+func (p *DailyScheduleRequest) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DailyScheduleRequest"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DailyScheduleRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsRefresh() {
+		if err = oprot.WriteFieldBegin("is_refresh", thrift.BOOL, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsRefresh); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DailyScheduleRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DailyScheduleRequest(%+v)", *p)
+
+}
+
+type DailyScheduleResponse struct {
+	Schedule string `thrift:"schedule,1" form:"schedule" json:"schedule"`
+}
+
+func NewDailyScheduleResponse() *DailyScheduleResponse {
+	return &DailyScheduleResponse{}
+}
+
+func (p *DailyScheduleResponse) InitDefault() {
+}
+
+func (p *DailyScheduleResponse) GetSchedule() (v string) {
+	return p.Schedule
+}
+
+var fieldIDToName_DailyScheduleResponse = map[int16]string{
+	1: "schedule",
+}
+
+func (p *DailyScheduleResponse) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DailyScheduleResponse[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DailyScheduleResponse) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Schedule = _field
+	return nil
+}
+
+func (p *DailyScheduleResponse) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DailyScheduleResponse"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DailyScheduleResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("schedule", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Schedule); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DailyScheduleResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DailyScheduleResponse(%+v)", *p)
+
+}
+
+type ApiService interface {
+	// 非流式对话
+	Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error)
+	// 流式对话，携带 Last-Event-ID 请求头可在断线后续传
+	ChatSSE(ctx context.Context, req *ChatSSEHandlerRequest) (r *ChatSSEHandlerResponse, err error)
+	// 批准或拒绝待确认的工具调用，响应为恢复后的流式对话
+	ChatApproval(ctx context.Context, req *ChatApprovalRequest) (r *ChatApprovalResponse, err error)
+	// 示例接口 idl写好后运行make hertz-gen-api生成脚手架
+	Template(ctx context.Context, req *TemplateRequest) (r *TemplateResponse, err error)
+	// 获取会话历史
+	GetConversationHistory(ctx context.Context, req *GetConversationHistoryRequest) (r *GetConversationHistoryResponse, err error)
+	// 删除会话
+	DeleteConversation(ctx context.Context, req *DeleteConversationRequest) (r *DeleteConversationResponse, err error)
+	// 获取对话列表
+	ListConversations(ctx context.Context, req *ListConversationsRequest) (r *ListConversationsResponse, err error)
+	// 会话总结
+	SummarizeConversation(ctx context.Context, req *SummarizeConversationRequest) (r *SummarizeConversationResponse, err error)
+	// 获取jwch登录数据
+	GetLoginData(ctx context.Context, req *GetLoginDataRequest) (r *GetLoginDataResponse, err error)
+	// 获取用户信息
+	GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (r *GetUserInfoResponse, err error)
+	// 更新用户设置
+	UpdateUserSetting(ctx context.Context, req *UpdateUserSettingRequest) (r *UpdateUserSettingResponse, err error)
+	// 获取 token 用量与配额
+	GetUserUsage(ctx context.Context, req *GetUserUsageRequest) (r *GetUserUsageResponse, err error)
+	// MCP 提示词与资源
+	// 获取提示词模板列表，对话中以 /name 调用
+	ListMCPPrompts(ctx context.Context, req *ListMCPPromptsRequest) (r *ListMCPPromptsResponse, err error)
+	// 获取可附加到对话的资源列表
+	ListMCPResources(ctx context.Context, req *ListMCPResourcesRequest) (r *ListMCPResourcesResponse, err error)
+	// 待办事项管理
+	// 创建待办事项
+	CreateTodo(ctx context.Context, req *CreateTodoRequest) (r *CreateTodoResponse, err error)
+	// 获取待办事项详情
+	GetTodo(ctx context.Context, req *GetTodoRequest) (r *GetTodoResponse, err error)
+	// 获取所有待办事项列表
+	ListTodo(ctx context.Context, req *ListTodoRequest) (r *ListTodoResponse, err error)
+	// 搜索待办事项
+	SearchTodo(ctx context.Context, req *SearchTodoRequest) (r *SearchTodoResponse, err error)
+	// 更新待办事项
+	UpdateTodo(ctx context.Context, req *UpdateTodoRequest) (r *UpdateTodoResponse, err error)
+	// 删除待办事项
+	DeleteTodo(ctx context.Context, req *DeleteTodoRequest) (r *DeleteTodoResponse, err error)
+	// 知识库(摘要)管理
+	// 获取摘要详情
+	GetSummary(ctx context.Context, req *GetSummaryRequest) (r *GetSummaryResponse, err error)
+	// 获取所有摘要列表
+	ListSummary(ctx context.Context, req *ListSummaryRequest) (r *ListSummaryResponse, err error)
+	// 更新摘要
+	UpdateSummary(ctx context.Context, req *UpdateSummaryRequest) (r *UpdateSummaryResponse, err error)
+	// 删除摘要
+	DeleteSummary(ctx context.Context, req *DeleteSummaryRequest) (r *DeleteSummaryResponse, err error)
+	// 课程相关接口
+	// 获取课表
+	GetCourseList(ctx context.Context, req *CourseListRequest) (r *CourseListResponse, err error)
+	// 获取学期
+	GetTermList(ctx context.Context, req *CourseTermListRequest) (r *CourseTermListResponse, err error)
+	// 校历信息：学期列表
+	GetTermsList(ctx context.Context, req *TermListRequest) (r *TermListResponse, err error)
+	// 校历信息：学期详情
+	GetTerm(ctx context.Context, req *TermRequest) (r *TermResponse, err error)
+	// 每日日程
+	DailySchedule(ctx context.Context, req *DailyScheduleRequest) (r *DailyScheduleResponse, err error)
+}
+
+type ApiServiceClient struct {
+	c thrift.TClient
+}
+
+func NewApiServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ApiServiceClient {
+	return &ApiServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewApiServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ApiServiceClient {
+	return &ApiServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewApiServiceClient(c thrift.TClient) *ApiServiceClient {
+	return &ApiServiceClient{
+		c: c,
+	}
+}
+
+func (p *ApiServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ApiServiceClient) Chat(ctx context.Context, req *ChatRequest) (r *ChatResponse, err error) {
+	var _args ApiServiceChatArgs
+	_args.Req = req
+	var _result ApiServiceChatResult
+	if err = p.Client_().Call(ctx, "Chat", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ChatSSE(ctx context.Context, req *ChatSSEHandlerRequest) (r *ChatSSEHandlerResponse, err error) {
+	var _args ApiServiceChatSSEArgs
+	_args.Req = req
+	var _result ApiServiceChatSSEResult
+	if err = p.Client_().Call(ctx, "ChatSSE", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ChatApproval(ctx context.Context, req *ChatApprovalRequest) (r *ChatApprovalResponse, err error) {
+	var _args ApiServiceChatApprovalArgs
+	_args.Req = req
+	var _result ApiServiceChatApprovalResult
+	if err = p.Client_().Call(ctx, "ChatApproval", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) Template(ctx context.Context, req *TemplateRequest) (r *TemplateResponse, err error) {
+	var _args ApiServiceTemplateArgs
+	_args.Req = req
+	var _result ApiServiceTemplateResult
+	if err = p.Client_().Call(ctx, "Template", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetConversationHistory(ctx context.Context, req *GetConversationHistoryRequest) (r *GetConversationHistoryResponse, err error) {
+	var _args ApiServiceGetConversationHistoryArgs
+	_args.Req = req
+	var _result ApiServiceGetConversationHistoryResult
+	if err = p.Client_().Call(ctx, "GetConversationHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) DeleteConversation(ctx context.Context, req *DeleteConversationRequest) (r *DeleteConversationResponse, err error) {
+	var _args ApiServiceDeleteConversationArgs
+	_args.Req = req
+	var _result ApiServiceDeleteConversationResult
+	if err = p.Client_().Call(ctx, "DeleteConversation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ListConversations(ctx context.Context, req *ListConversationsRequest) (r *ListConversationsResponse, err error) {
 	var _args ApiServiceListConversationsArgs
 	_args.Req = req
-	var _result ApiServiceListConversationsResult
-	if err = p.Client_().Call(ctx, "ListConversations", &_args, &_result); err != nil {
+	var _result ApiServiceListConversationsResult
+	if err = p.Client_().Call(ctx, "ListConversations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) SummarizeConversation(ctx context.Context, req *SummarizeConversationRequest) (r *SummarizeConversationResponse, err error) {
+	var _args ApiServiceSummarizeConversationArgs
+	_args.Req = req
+	var _result ApiServiceSummarizeConversationResult
+	if err = p.Client_().Call(ctx, "SummarizeConversation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetLoginData(ctx context.Context, req *GetLoginDataRequest) (r *GetLoginDataResponse, err error) {
+	var _args ApiServiceGetLoginDataArgs
+	_args.Req = req
+	var _result ApiServiceGetLoginDataResult
+	if err = p.Client_().Call(ctx, "GetLoginData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (r *GetUserInfoResponse, err error) {
+	var _args ApiServiceGetUserInfoArgs
+	_args.Req = req
+	var _result ApiServiceGetUserInfoResult
+	if err = p.Client_().Call(ctx, "GetUserInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UpdateUserSetting(ctx context.Context, req *UpdateUserSettingRequest) (r *UpdateUserSettingResponse, err error) {
+	var _args ApiServiceUpdateUserSettingArgs
+	_args.Req = req
+	var _result ApiServiceUpdateUserSettingResult
+	if err = p.Client_().Call(ctx, "UpdateUserSetting", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetUserUsage(ctx context.Context, req *GetUserUsageRequest) (r *GetUserUsageResponse, err error) {
+	var _args ApiServiceGetUserUsageArgs
+	_args.Req = req
+	var _result ApiServiceGetUserUsageResult
+	if err = p.Client_().Call(ctx, "GetUserUsage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ListMCPPrompts(ctx context.Context, req *ListMCPPromptsRequest) (r *ListMCPPromptsResponse, err error) {
+	var _args ApiServiceListMCPPromptsArgs
+	_args.Req = req
+	var _result ApiServiceListMCPPromptsResult
+	if err = p.Client_().Call(ctx, "ListMCPPrompts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ListMCPResources(ctx context.Context, req *ListMCPResourcesRequest) (r *ListMCPResourcesResponse, err error) {
+	var _args ApiServiceListMCPResourcesArgs
+	_args.Req = req
+	var _result ApiServiceListMCPResourcesResult
+	if err = p.Client_().Call(ctx, "ListMCPResources", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) CreateTodo(ctx context.Context, req *CreateTodoRequest) (r *CreateTodoResponse, err error) {
+	var _args ApiServiceCreateTodoArgs
+	_args.Req = req
+	var _result ApiServiceCreateTodoResult
+	if err = p.Client_().Call(ctx, "CreateTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetTodo(ctx context.Context, req *GetTodoRequest) (r *GetTodoResponse, err error) {
+	var _args ApiServiceGetTodoArgs
+	_args.Req = req
+	var _result ApiServiceGetTodoResult
+	if err = p.Client_().Call(ctx, "GetTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ListTodo(ctx context.Context, req *ListTodoRequest) (r *ListTodoResponse, err error) {
+	var _args ApiServiceListTodoArgs
+	_args.Req = req
+	var _result ApiServiceListTodoResult
+	if err = p.Client_().Call(ctx, "ListTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) SearchTodo(ctx context.Context, req *SearchTodoRequest) (r *SearchTodoResponse, err error) {
+	var _args ApiServiceSearchTodoArgs
+	_args.Req = req
+	var _result ApiServiceSearchTodoResult
+	if err = p.Client_().Call(ctx, "SearchTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UpdateTodo(ctx context.Context, req *UpdateTodoRequest) (r *UpdateTodoResponse, err error) {
+	var _args ApiServiceUpdateTodoArgs
+	_args.Req = req
+	var _result ApiServiceUpdateTodoResult
+	if err = p.Client_().Call(ctx, "UpdateTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) DeleteTodo(ctx context.Context, req *DeleteTodoRequest) (r *DeleteTodoResponse, err error) {
+	var _args ApiServiceDeleteTodoArgs
+	_args.Req = req
+	var _result ApiServiceDeleteTodoResult
+	if err = p.Client_().Call(ctx, "DeleteTodo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetSummary(ctx context.Context, req *GetSummaryRequest) (r *GetSummaryResponse, err error) {
+	var _args ApiServiceGetSummaryArgs
+	_args.Req = req
+	var _result ApiServiceGetSummaryResult
+	if err = p.Client_().Call(ctx, "GetSummary", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) ListSummary(ctx context.Context, req *ListSummaryRequest) (r *ListSummaryResponse, err error) {
+	var _args ApiServiceListSummaryArgs
+	_args.Req = req
+	var _result ApiServiceListSummaryResult
+	if err = p.Client_().Call(ctx, "ListSummary", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) UpdateSummary(ctx context.Context, req *UpdateSummaryRequest) (r *UpdateSummaryResponse, err error) {
+	var _args ApiServiceUpdateSummaryArgs
+	_args.Req = req
+	var _result ApiServiceUpdateSummaryResult
+	if err = p.Client_().Call(ctx, "UpdateSummary", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) DeleteSummary(ctx context.Context, req *DeleteSummaryRequest) (r *DeleteSummaryResponse, err error) {
+	var _args ApiServiceDeleteSummaryArgs
+	_args.Req = req
+	var _result ApiServiceDeleteSummaryResult
+	if err = p.Client_().Call(ctx, "DeleteSummary", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetCourseList(ctx context.Context, req *CourseListRequest) (r *CourseListResponse, err error) {
+	var _args ApiServiceGetCourseListArgs
+	_args.Req = req
+	var _result ApiServiceGetCourseListResult
+	if err = p.Client_().Call(ctx, "GetCourseList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetTermList(ctx context.Context, req *CourseTermListRequest) (r *CourseTermListResponse, err error) {
+	var _args ApiServiceGetTermListArgs
+	_args.Req = req
+	var _result ApiServiceGetTermListResult
+	if err = p.Client_().Call(ctx, "GetTermList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetTermsList(ctx context.Context, req *TermListRequest) (r *TermListResponse, err error) {
+	var _args ApiServiceGetTermsListArgs
+	_args.Req = req
+	var _result ApiServiceGetTermsListResult
+	if err = p.Client_().Call(ctx, "GetTermsList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) GetTerm(ctx context.Context, req *TermRequest) (r *TermResponse, err error) {
+	var _args ApiServiceGetTermArgs
+	_args.Req = req
+	var _result ApiServiceGetTermResult
+	if err = p.Client_().Call(ctx, "GetTerm", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ApiServiceClient) DailySchedule(ctx context.Context, req *DailyScheduleRequest) (r *DailyScheduleResponse, err error) {
+	var _args ApiServiceDailyScheduleArgs
+	_args.Req = req
+	var _result ApiServiceDailyScheduleResult
+	if err = p.Client_().Call(ctx, "DailySchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ApiServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ApiService
+}
+
+func (p *ApiServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *ApiServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ApiServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewApiServiceProcessor(handler ApiService) *ApiServiceProcessor {
+	self := &ApiServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("Chat", &apiServiceProcessorChat{handler: handler})
+	self.AddToProcessorMap("ChatSSE", &apiServiceProcessorChatSSE{handler: handler})
+	self.AddToProcessorMap("ChatApproval", &apiServiceProcessorChatApproval{handler: handler})
+	self.AddToProcessorMap("Template", &apiServiceProcessorTemplate{handler: handler})
+	self.AddToProcessorMap("GetConversationHistory", &apiServiceProcessorGetConversationHistory{handler: handler})
+	self.AddToProcessorMap("DeleteConversation", &apiServiceProcessorDeleteConversation{handler: handler})
+	self.AddToProcessorMap("ListConversations", &apiServiceProcessorListConversations{handler: handler})
+	self.AddToProcessorMap("SummarizeConversation", &apiServiceProcessorSummarizeConversation{handler: handler})
+	self.AddToProcessorMap("GetLoginData", &apiServiceProcessorGetLoginData{handler: handler})
+	self.AddToProcessorMap("GetUserInfo", &apiServiceProcessorGetUserInfo{handler: handler})
+	self.AddToProcessorMap("UpdateUserSetting", &apiServiceProcessorUpdateUserSetting{handler: handler})
+	self.AddToProcessorMap("GetUserUsage", &apiServiceProcessorGetUserUsage{handler: handler})
+	self.AddToProcessorMap("ListMCPPrompts", &apiServiceProcessorListMCPPrompts{handler: handler})
+	self.AddToProcessorMap("ListMCPResources", &apiServiceProcessorListMCPResources{handler: handler})
+	self.AddToProcessorMap("CreateTodo", &apiServiceProcessorCreateTodo{handler: handler})
+	self.AddToProcessorMap("GetTodo", &apiServiceProcessorGetTodo{handler: handler})
+	self.AddToProcessorMap("ListTodo", &apiServiceProcessorListTodo{handler: handler})
+	self.AddToProcessorMap("SearchTodo", &apiServiceProcessorSearchTodo{handler: handler})
+	self.AddToProcessorMap("UpdateTodo", &apiServiceProcessorUpdateTodo{handler: handler})
+	self.AddToProcessorMap("DeleteTodo", &apiServiceProcessorDeleteTodo{handler: handler})
+	self.AddToProcessorMap("GetSummary", &apiServiceProcessorGetSummary{handler: handler})
+	self.AddToProcessorMap("ListSummary", &apiServiceProcessorListSummary{handler: handler})
+	self.AddToProcessorMap("UpdateSummary", &apiServiceProcessorUpdateSummary{handler: handler})
+	self.AddToProcessorMap("DeleteSummary", &apiServiceProcessorDeleteSummary{handler: handler})
+	self.AddToProcessorMap("GetCourseList", &apiServiceProcessorGetCourseList{handler: handler})
+	self.AddToProcessorMap("GetTermList", &apiServiceProcessorGetTermList{handler: handler})
+	self.AddToProcessorMap("GetTermsList", &apiServiceProcessorGetTermsList{handler: handler})
+	self.AddToProcessorMap("GetTerm", &apiServiceProcessorGetTerm{handler: handler})
+	self.AddToProcessorMap("DailySchedule", &apiServiceProcessorDailySchedule{handler: handler})
+	return self
+}
+func (p *ApiServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type apiServiceProcessorChat struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorChat) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceChatArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceChatResult{}
+	var retval *ChatResponse
+	if retval, err2 = p.handler.Chat(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Chat: "+err2.Error())
+		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Chat", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorChatSSE struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorChatSSE) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceChatSSEArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ChatSSE", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceChatSSEResult{}
+	var retval *ChatSSEHandlerResponse
+	if retval, err2 = p.handler.ChatSSE(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ChatSSE: "+err2.Error())
+		oprot.WriteMessageBegin("ChatSSE", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ChatSSE", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorChatApproval struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorChatApproval) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceChatApprovalArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ChatApproval", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceChatApprovalResult{}
+	var retval *ChatApprovalResponse
+	if retval, err2 = p.handler.ChatApproval(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ChatApproval: "+err2.Error())
+		oprot.WriteMessageBegin("ChatApproval", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ChatApproval", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorTemplate struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorTemplate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceTemplateArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Template", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceTemplateResult{}
+	var retval *TemplateResponse
+	if retval, err2 = p.handler.Template(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Template: "+err2.Error())
+		oprot.WriteMessageBegin("Template", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Template", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetConversationHistory struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetConversationHistory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetConversationHistoryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetConversationHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetConversationHistoryResult{}
+	var retval *GetConversationHistoryResponse
+	if retval, err2 = p.handler.GetConversationHistory(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetConversationHistory: "+err2.Error())
+		oprot.WriteMessageBegin("GetConversationHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetConversationHistory", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorDeleteConversation struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorDeleteConversation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceDeleteConversationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteConversation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceDeleteConversationResult{}
+	var retval *DeleteConversationResponse
+	if retval, err2 = p.handler.DeleteConversation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteConversation: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteConversation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteConversation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorListConversations struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorListConversations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceListConversationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListConversations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceListConversationsResult{}
+	var retval *ListConversationsResponse
+	if retval, err2 = p.handler.ListConversations(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListConversations: "+err2.Error())
+		oprot.WriteMessageBegin("ListConversations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListConversations", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorSummarizeConversation struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorSummarizeConversation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceSummarizeConversationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SummarizeConversation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceSummarizeConversationResult{}
+	var retval *SummarizeConversationResponse
+	if retval, err2 = p.handler.SummarizeConversation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SummarizeConversation: "+err2.Error())
+		oprot.WriteMessageBegin("SummarizeConversation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SummarizeConversation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetLoginData struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetLoginData) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetLoginDataArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetLoginData", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetLoginDataResult{}
+	var retval *GetLoginDataResponse
+	if retval, err2 = p.handler.GetLoginData(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetLoginData: "+err2.Error())
+		oprot.WriteMessageBegin("GetLoginData", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetLoginData", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetUserInfo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetUserInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetUserInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetUserInfoResult{}
+	var retval *GetUserInfoResponse
+	if retval, err2 = p.handler.GetUserInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserInfo: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorUpdateUserSetting struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUpdateUserSetting) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUpdateUserSettingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateUserSetting", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUpdateUserSettingResult{}
+	var retval *UpdateUserSettingResponse
+	if retval, err2 = p.handler.UpdateUserSetting(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateUserSetting: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateUserSetting", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateUserSetting", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetUserUsage struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetUserUsage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetUserUsageArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUserUsage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetUserUsageResult{}
+	var retval *GetUserUsageResponse
+	if retval, err2 = p.handler.GetUserUsage(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserUsage: "+err2.Error())
+		oprot.WriteMessageBegin("GetUserUsage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUserUsage", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorListMCPPrompts struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorListMCPPrompts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceListMCPPromptsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListMCPPrompts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceListMCPPromptsResult{}
+	var retval *ListMCPPromptsResponse
+	if retval, err2 = p.handler.ListMCPPrompts(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListMCPPrompts: "+err2.Error())
+		oprot.WriteMessageBegin("ListMCPPrompts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListMCPPrompts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorListMCPResources struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorListMCPResources) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceListMCPResourcesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListMCPResources", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceListMCPResourcesResult{}
+	var retval *ListMCPResourcesResponse
+	if retval, err2 = p.handler.ListMCPResources(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListMCPResources: "+err2.Error())
+		oprot.WriteMessageBegin("ListMCPResources", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListMCPResources", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorCreateTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorCreateTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceCreateTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceCreateTodoResult{}
+	var retval *CreateTodoResponse
+	if retval, err2 = p.handler.CreateTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateTodo: "+err2.Error())
+		oprot.WriteMessageBegin("CreateTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreateTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorGetTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorGetTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetTodoResult{}
+	var retval *GetTodoResponse
+	if retval, err2 = p.handler.GetTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTodo: "+err2.Error())
+		oprot.WriteMessageBegin("GetTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorListTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorListTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceListTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceListTodoResult{}
+	var retval *ListTodoResponse
+	if retval, err2 = p.handler.ListTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTodo: "+err2.Error())
+		oprot.WriteMessageBegin("ListTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) SummarizeConversation(ctx context.Context, req *SummarizeConversationRequest) (r *SummarizeConversationResponse, err error) {
-	var _args ApiServiceSummarizeConversationArgs
-	_args.Req = req
-	var _result ApiServiceSummarizeConversationResult
-	if err = p.Client_().Call(ctx, "SummarizeConversation", &_args, &_result); err != nil {
-		return
+	return true, err
+}
+
+type apiServiceProcessorSearchTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorSearchTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceSearchTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SearchTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceSearchTodoResult{}
+	var retval *SearchTodoResponse
+	if retval, err2 = p.handler.SearchTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchTodo: "+err2.Error())
+		oprot.WriteMessageBegin("SearchTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SearchTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorUpdateTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUpdateTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUpdateTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUpdateTodoResult{}
+	var retval *UpdateTodoResponse
+	if retval, err2 = p.handler.UpdateTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateTodo: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type apiServiceProcessorDeleteTodo struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorDeleteTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceDeleteTodoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceDeleteTodoResult{}
+	var retval *DeleteTodoResponse
+	if retval, err2 = p.handler.DeleteTodo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteTodo: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteTodo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteTodo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) GetLoginData(ctx context.Context, req *GetLoginDataRequest) (r *GetLoginDataResponse, err error) {
-	var _args ApiServiceGetLoginDataArgs
-	_args.Req = req
-	var _result ApiServiceGetLoginDataResult
-	if err = p.Client_().Call(ctx, "GetLoginData", &_args, &_result); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (r *GetUserInfoResponse, err error) {
-	var _args ApiServiceGetUserInfoArgs
-	_args.Req = req
-	var _result ApiServiceGetUserInfoResult
-	if err = p.Client_().Call(ctx, "GetUserInfo", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) UpdateUserSetting(ctx context.Context, req *UpdateUserSettingRequest) (r *UpdateUserSettingResponse, err error) {
-	var _args ApiServiceUpdateUserSettingArgs
-	_args.Req = req
-	var _result ApiServiceUpdateUserSettingResult
-	if err = p.Client_().Call(ctx, "UpdateUserSetting", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) CreateTodo(ctx context.Context, req *CreateTodoRequest) (r *CreateTodoResponse, err error) {
-	var _args ApiServiceCreateTodoArgs
-	_args.Req = req
-	var _result ApiServiceCreateTodoResult
-	if err = p.Client_().Call(ctx, "CreateTodo", &_args, &_result); err != nil {
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *ApiServiceClient) GetTodo(ctx context.Context, req *GetTodoRequest) (r *GetTodoResponse, err error) {
-	var _args ApiServiceGetTodoArgs
-	_args.Req = req
-	var _result ApiServiceGetTodoResult
-	if err = p.Client_().Call(ctx, "GetTodo", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+type apiServiceProcessorGetSummary struct {
+	handler ApiService
 }
-func (p *ApiServiceClient) ListTodo(ctx context.Context, req *ListTodoRequest) (r *ListTodoResponse, err error) {
-	var _args ApiServiceListTodoArgs
-	_args.Req = req
-	var _result ApiServiceListTodoResult
-	if err = p.Client_().Call(ctx, "ListTodo", &_args, &_result); err != nil {
+
+func (p *apiServiceProcessorGetSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetSummaryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetSummaryResult{}
+	var retval *GetSummaryResponse
+	if retval, err2 = p.handler.GetSummary(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSummary: "+err2.Error())
+		oprot.WriteMessageBegin("GetSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetSummary", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *ApiServiceClient) SearchTodo(ctx context.Context, req *SearchTodoRequest) (r *SearchTodoResponse, err error) {
-	var _args ApiServiceSearchTodoArgs
-	_args.Req = req
-	var _result ApiServiceSearchTodoResult
-	if err = p.Client_().Call(ctx, "SearchTodo", &_args, &_result); err != nil {
+
+type apiServiceProcessorListSummary struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorListSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceListSummaryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceListSummaryResult{}
+	var retval *ListSummaryResponse
+	if retval, err2 = p.handler.ListSummary(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListSummary: "+err2.Error())
+		oprot.WriteMessageBegin("ListSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListSummary", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *ApiServiceClient) UpdateTodo(ctx context.Context, req *UpdateTodoRequest) (r *UpdateTodoResponse, err error) {
-	var _args ApiServiceUpdateTodoArgs
-	_args.Req = req
-	var _result ApiServiceUpdateTodoResult
-	if err = p.Client_().Call(ctx, "UpdateTodo", &_args, &_result); err != nil {
+
+type apiServiceProcessorUpdateSummary struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorUpdateSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceUpdateSummaryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceUpdateSummaryResult{}
+	var retval *UpdateSummaryResponse
+	if retval, err2 = p.handler.UpdateSummary(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateSummary: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateSummary", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *ApiServiceClient) DeleteTodo(ctx context.Context, req *DeleteTodoRequest) (r *DeleteTodoResponse, err error) {
-	var _args ApiServiceDeleteTodoArgs
-	_args.Req = req
-	var _result ApiServiceDeleteTodoResult
-	if err = p.Client_().Call(ctx, "DeleteTodo", &_args, &_result); err != nil {
-		return
+
+type apiServiceProcessorDeleteSummary struct {
+	handler ApiService
+}
+
+func (p *apiServiceProcessorDeleteSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceDeleteSummaryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceDeleteSummaryResult{}
+	var retval *DeleteSummaryResponse
+	if retval, err2 = p.handler.DeleteSummary(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteSummary: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteSummary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteSummary", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) GetSummary(ctx context.Context, req *GetSummaryRequest) (r *GetSummaryResponse, err error) {
-	var _args ApiServiceGetSummaryArgs
-	_args.Req = req
-	var _result ApiServiceGetSummaryResult
-	if err = p.Client_().Call(ctx, "GetSummary", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) ListSummary(ctx context.Context, req *ListSummaryRequest) (r *ListSummaryResponse, err error) {
-	var _args ApiServiceListSummaryArgs
-	_args.Req = req
-	var _result ApiServiceListSummaryResult
-	if err = p.Client_().Call(ctx, "ListSummary", &_args, &_result); err != nil {
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
-func (p *ApiServiceClient) UpdateSummary(ctx context.Context, req *UpdateSummaryRequest) (r *UpdateSummaryResponse, err error) {
-	var _args ApiServiceUpdateSummaryArgs
-	_args.Req = req
-	var _result ApiServiceUpdateSummaryResult
-	if err = p.Client_().Call(ctx, "UpdateSummary", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+type apiServiceProcessorGetCourseList struct {
+	handler ApiService
 }
-func (p *ApiServiceClient) DeleteSummary(ctx context.Context, req *DeleteSummaryRequest) (r *DeleteSummaryResponse, err error) {
-	var _args ApiServiceDeleteSummaryArgs
-	_args.Req = req
-	var _result ApiServiceDeleteSummaryResult
-	if err = p.Client_().Call(ctx, "DeleteSummary", &_args, &_result); err != nil {
-		return
+
+func (p *apiServiceProcessorGetCourseList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetCourseListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCourseList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) GetCourseList(ctx context.Context, req *CourseListRequest) (r *CourseListResponse, err error) {
-	var _args ApiServiceGetCourseListArgs
-	_args.Req = req
-	var _result ApiServiceGetCourseListResult
-	if err = p.Client_().Call(ctx, "GetCourseList", &_args, &_result); err != nil {
-		return
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetCourseListResult{}
+	var retval *CourseListResponse
+	if retval, err2 = p.handler.GetCourseList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCourseList: "+err2.Error())
+		oprot.WriteMessageBegin("GetCourseList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) GetTermList(ctx context.Context, req *CourseTermListRequest) (r *CourseTermListResponse, err error) {
-	var _args ApiServiceGetTermListArgs
-	_args.Req = req
-	var _result ApiServiceGetTermListResult
-	if err = p.Client_().Call(ctx, "GetTermList", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.WriteMessageBegin("GetCourseList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) GetTermsList(ctx context.Context, req *TermListRequest) (r *TermListResponse, err error) {
-	var _args ApiServiceGetTermsListArgs
-	_args.Req = req
-	var _result ApiServiceGetTermsListResult
-	if err = p.Client_().Call(ctx, "GetTermsList", &_args, &_result); err != nil {
-		return
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) GetTerm(ctx context.Context, req *TermRequest) (r *TermResponse, err error) {
-	var _args ApiServiceGetTermArgs
-	_args.Req = req
-	var _result ApiServiceGetTermResult
-	if err = p.Client_().Call(ctx, "GetTerm", &_args, &_result); err != nil {
-		return
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *ApiServiceClient) DailySchedule(ctx context.Context, req *DailyScheduleRequest) (r *DailyScheduleResponse, err error) {
-	var _args ApiServiceDailyScheduleArgs
-	_args.Req = req
-	var _result ApiServiceDailyScheduleResult
-	if err = p.Client_().Call(ctx, "DailySchedule", &_args, &_result); err != nil {
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
 		return
 	}
-	return _result.GetSuccess(), nil
+	return true, err
 }
 
-type ApiServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      ApiService
+type apiServiceProcessorGetTermList struct {
+	handler ApiService
 }
 
-func (p *ApiServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
+func (p *apiServiceProcessorGetTermList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetTermListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTermList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
 
-func (p *ApiServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ApiServiceGetTermListResult{}
+	var retval *CourseTermListResponse
+	if retval, err2 = p.handler.GetTermList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTermList: "+err2.Error())
+		oprot.WriteMessageBegin("GetTermList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTermList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-func (p *ApiServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+type apiServiceProcessorGetTermsList struct {
+	handler ApiService
 }
 
-func NewApiServiceProcessor(handler ApiService) *ApiServiceProcessor {
-	self := &ApiServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("Chat", &apiServiceProcessorChat{handler: handler})
-	self.AddToProcessorMap("ChatSSE", &apiServiceProcessorChatSSE{handler: handler})
-	self.AddToProcessorMap("Template", &apiServiceProcessorTemplate{handler: handler})
-	self.AddToProcessorMap("GetConversationHistory", &apiServiceProcessorGetConversationHistory{handler: handler})
-	self.AddToProcessorMap("DeleteConversation", &apiServiceProcessorDeleteConversation{handler: handler})
-	self.AddToProcessorMap("ListConversations", &apiServiceProcessorListConversations{handler: handler})
-	self.AddToProcessorMap("SummarizeConversation", &apiServiceProcessorSummarizeConversation{handler: handler})
-	self.AddToProcessorMap("GetLoginData", &apiServiceProcessorGetLoginData{handler: handler})
-	self.AddToProcessorMap("GetUserInfo", &apiServiceProcessorGetUserInfo{handler: handler})
-	self.AddToProcessorMap("UpdateUserSetting", &apiServiceProcessorUpdateUserSetting{handler: handler})
-	self.AddToProcessorMap("CreateTodo", &apiServiceProcessorCreateTodo{handler: handler})
-	self.AddToProcessorMap("GetTodo", &apiServiceProcessorGetTodo{handler: handler})
-	self.AddToProcessorMap("ListTodo", &apiServiceProcessorListTodo{handler: handler})
-	self.AddToProcessorMap("SearchTodo", &apiServiceProcessorSearchTodo{handler: handler})
-	self.AddToProcessorMap("UpdateTodo", &apiServiceProcessorUpdateTodo{handler: handler})
-	self.AddToProcessorMap("DeleteTodo", &apiServiceProcessorDeleteTodo{handler: handler})
-	self.AddToProcessorMap("GetSummary", &apiServiceProcessorGetSummary{handler: handler})
-	self.AddToProcessorMap("ListSummary", &apiServiceProcessorListSummary{handler: handler})
-	self.AddToProcessorMap("UpdateSummary", &apiServiceProcessorUpdateSummary{handler: handler})
-	self.AddToProcessorMap("DeleteSummary", &apiServiceProcessorDeleteSummary{handler: handler})
-	self.AddToProcessorMap("GetCourseList", &apiServiceProcessorGetCourseList{handler: handler})
-	self.AddToProcessorMap("GetTermList", &apiServiceProcessorGetTermList{handler: handler})
-	self.AddToProcessorMap("GetTermsList", &apiServiceProcessorGetTermsList{handler: handler})
-	self.AddToProcessorMap("GetTerm", &apiServiceProcessorGetTerm{handler: handler})
-	self.AddToProcessorMap("DailySchedule", &apiServiceProcessorDailySchedule{handler: handler})
-	return self
-}
-func (p *ApiServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
+func (p *apiServiceProcessorGetTermsList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetTermsListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTermsList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
 		return false, err
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
+
 	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	var err2 error
+	result := ApiServiceGetTermsListResult{}
+	var retval *TermListResponse
+	if retval, err2 = p.handler.GetTermsList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTermsList: "+err2.Error())
+		oprot.WriteMessageBegin("GetTermsList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTermsList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type apiServiceProcessorChat struct {
+type apiServiceProcessorGetTerm struct {
 	handler ApiService
 }
 
-func (p *apiServiceProcessorChat) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceChatArgs{}
+func (p *apiServiceProcessorGetTerm) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceGetTermArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("GetTerm", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -11072,11 +13697,11 @@ func (p *apiServiceProcessorChat) Process(ctx context.Context, seqId int32, ipro
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := ApiServiceChatResult{}
-	var retval *ChatResponse
-	if retval, err2 = p.handler.Chat(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Chat: "+err2.Error())
-		oprot.WriteMessageBegin("Chat", thrift.EXCEPTION, seqId)
+	result := ApiServiceGetTermResult{}
+	var retval *TermResponse
+	if retval, err2 = p.handler.GetTerm(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTerm: "+err2.Error())
+		oprot.WriteMessageBegin("GetTerm", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -11084,7 +13709,7 @@ func (p *apiServiceProcessorChat) Process(ctx context.Context, seqId int32, ipro
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("Chat", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("GetTerm", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -11102,16 +13727,16 @@ func (p *apiServiceProcessorChat) Process(ctx context.Context, seqId int32, ipro
 	return true, err
 }
 
-type apiServiceProcessorChatSSE struct {
+type apiServiceProcessorDailySchedule struct {
 	handler ApiService
 }
 
-func (p *apiServiceProcessorChatSSE) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceChatSSEArgs{}
+func (p *apiServiceProcessorDailySchedule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ApiServiceDailyScheduleArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ChatSSE", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("DailySchedule", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -11120,11 +13745,11 @@ func (p *apiServiceProcessorChatSSE) Process(ctx context.Context, seqId int32, i
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := ApiServiceChatSSEResult{}
-	var retval *ChatSSEHandlerResponse
-	if retval, err2 = p.handler.ChatSSE(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ChatSSE: "+err2.Error())
-		oprot.WriteMessageBegin("ChatSSE", thrift.EXCEPTION, seqId)
+	result := ApiServiceDailyScheduleResult{}
+	var retval *DailyScheduleResponse
+	if retval, err2 = p.handler.DailySchedule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DailySchedule: "+err2.Error())
+		oprot.WriteMessageBegin("DailySchedule", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -11132,7 +13757,7 @@ func (p *apiServiceProcessorChatSSE) Process(ctx context.Context, seqId int32, i
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("ChatSSE", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("DailySchedule", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -11150,1139 +13775,1211 @@ func (p *apiServiceProcessorChatSSE) Process(ctx context.Context, seqId int32, i
 	return true, err
 }
 
-type apiServiceProcessorTemplate struct {
-	handler ApiService
+type ApiServiceChatArgs struct {
+	Req *ChatRequest `thrift:"req,1"`
+}
+
+func NewApiServiceChatArgs() *ApiServiceChatArgs {
+	return &ApiServiceChatArgs{}
+}
+
+func (p *ApiServiceChatArgs) InitDefault() {
+}
+
+var ApiServiceChatArgs_Req_DEFAULT *ChatRequest
+
+func (p *ApiServiceChatArgs) GetReq() (v *ChatRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceChatArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ApiServiceChatArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ApiServiceChatArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ApiServiceChatArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewChatRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ApiServiceChatArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Chat_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApiServiceChatArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceChatArgs(%+v)", *p)
+
+}
+
+type ApiServiceChatResult struct {
+	Success *ChatResponse `thrift:"success,0,optional"`
+}
+
+func NewApiServiceChatResult() *ApiServiceChatResult {
+	return &ApiServiceChatResult{}
+}
+
+func (p *ApiServiceChatResult) InitDefault() {
+}
+
+var ApiServiceChatResult_Success_DEFAULT *ChatResponse
+
+func (p *ApiServiceChatResult) GetSuccess() (v *ChatResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceChatResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ApiServiceChatResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ApiServiceChatResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ApiServiceChatResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorTemplate) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceTemplateArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Template", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceChatResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewChatResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceTemplateResult{}
-	var retval *TemplateResponse
-	if retval, err2 = p.handler.Template(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Template: "+err2.Error())
-		oprot.WriteMessageBegin("Template", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceChatResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Chat_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("Template", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceChatResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ApiServiceChatResult(%+v)", *p)
+
 }
 
-type apiServiceProcessorGetConversationHistory struct {
-	handler ApiService
+type ApiServiceChatSSEArgs struct {
+	Req *ChatSSEHandlerRequest `thrift:"req,1"`
 }
 
-func (p *apiServiceProcessorGetConversationHistory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetConversationHistoryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetConversationHistory", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewApiServiceChatSSEArgs() *ApiServiceChatSSEArgs {
+	return &ApiServiceChatSSEArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetConversationHistoryResult{}
-	var retval *GetConversationHistoryResponse
-	if retval, err2 = p.handler.GetConversationHistory(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetConversationHistory: "+err2.Error())
-		oprot.WriteMessageBegin("GetConversationHistory", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetConversationHistory", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceChatSSEArgs) InitDefault() {
+}
+
+var ApiServiceChatSSEArgs_Req_DEFAULT *ChatSSEHandlerRequest
+
+func (p *ApiServiceChatSSEArgs) GetReq() (v *ChatSSEHandlerRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceChatSSEArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type apiServiceProcessorDeleteConversation struct {
-	handler ApiService
+var fieldIDToName_ApiServiceChatSSEArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *apiServiceProcessorDeleteConversation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceDeleteConversationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteConversation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceChatSSEArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceDeleteConversationResult{}
-	var retval *DeleteConversationResponse
-	if retval, err2 = p.handler.DeleteConversation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteConversation: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteConversation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteConversation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceChatSSEArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatSSEArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-type apiServiceProcessorListConversations struct {
-	handler ApiService
+func (p *ApiServiceChatSSEArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewChatSSEHandlerRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
 }
 
-func (p *apiServiceProcessorListConversations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceListConversationsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListConversations", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceChatSSEArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatSSE_args"); err != nil {
+		goto WriteStructBeginError
 	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceListConversationsResult{}
-	var retval *ListConversationsResponse
-	if retval, err2 = p.handler.ListConversations(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListConversations: "+err2.Error())
-		oprot.WriteMessageBegin("ListConversations", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("ListConversations", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatSSEArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type apiServiceProcessorSummarizeConversation struct {
-	handler ApiService
+func (p *ApiServiceChatSSEArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceChatSSEArgs(%+v)", *p)
+
 }
 
-func (p *apiServiceProcessorSummarizeConversation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceSummarizeConversationArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SummarizeConversation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type ApiServiceChatSSEResult struct {
+	Success *ChatSSEHandlerResponse `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceSummarizeConversationResult{}
-	var retval *SummarizeConversationResponse
-	if retval, err2 = p.handler.SummarizeConversation(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SummarizeConversation: "+err2.Error())
-		oprot.WriteMessageBegin("SummarizeConversation", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("SummarizeConversation", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewApiServiceChatSSEResult() *ApiServiceChatSSEResult {
+	return &ApiServiceChatSSEResult{}
 }
 
-type apiServiceProcessorGetLoginData struct {
-	handler ApiService
+func (p *ApiServiceChatSSEResult) InitDefault() {
 }
 
-func (p *apiServiceProcessorGetLoginData) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetLoginDataArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetLoginData", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var ApiServiceChatSSEResult_Success_DEFAULT *ChatSSEHandlerResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetLoginDataResult{}
-	var retval *GetLoginDataResponse
-	if retval, err2 = p.handler.GetLoginData(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetLoginData: "+err2.Error())
-		oprot.WriteMessageBegin("GetLoginData", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetLoginData", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceChatSSEResult) GetSuccess() (v *ChatSSEHandlerResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceChatSSEResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type apiServiceProcessorGetUserInfo struct {
-	handler ApiService
+var fieldIDToName_ApiServiceChatSSEResult = map[int16]string{
+	0: "success",
 }
 
-func (p *apiServiceProcessorGetUserInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetUserInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUserInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceChatSSEResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetUserInfoResult{}
-	var retval *GetUserInfoResponse
-	if retval, err2 = p.handler.GetUserInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUserInfo: "+err2.Error())
-		oprot.WriteMessageBegin("GetUserInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetUserInfo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceChatSSEResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorUpdateUserSetting struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatSSEResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorUpdateUserSetting) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUpdateUserSettingArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateUserSetting", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceChatSSEResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewChatSSEHandlerResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUpdateUserSettingResult{}
-	var retval *UpdateUserSettingResponse
-	if retval, err2 = p.handler.UpdateUserSetting(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateUserSetting: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateUserSetting", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateUserSetting", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceChatSSEResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatSSE_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type apiServiceProcessorCreateTodo struct {
-	handler ApiService
+func (p *ApiServiceChatSSEResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *apiServiceProcessorCreateTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceCreateTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreateTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceChatSSEResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("ApiServiceChatSSEResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceCreateTodoResult{}
-	var retval *CreateTodoResponse
-	if retval, err2 = p.handler.CreateTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateTodo: "+err2.Error())
-		oprot.WriteMessageBegin("CreateTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("CreateTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type apiServiceProcessorGetTodo struct {
-	handler ApiService
+type ApiServiceChatApprovalArgs struct {
+	Req *ChatApprovalRequest `thrift:"req,1"`
 }
 
-func (p *apiServiceProcessorGetTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewApiServiceChatApprovalArgs() *ApiServiceChatApprovalArgs {
+	return &ApiServiceChatApprovalArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetTodoResult{}
-	var retval *GetTodoResponse
-	if retval, err2 = p.handler.GetTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTodo: "+err2.Error())
-		oprot.WriteMessageBegin("GetTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceChatApprovalArgs) InitDefault() {
+}
+
+var ApiServiceChatApprovalArgs_Req_DEFAULT *ChatApprovalRequest
+
+func (p *ApiServiceChatApprovalArgs) GetReq() (v *ChatApprovalRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceChatApprovalArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type apiServiceProcessorListTodo struct {
-	handler ApiService
+var fieldIDToName_ApiServiceChatApprovalArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *apiServiceProcessorListTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceListTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceChatApprovalArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceListTodoResult{}
-	var retval *ListTodoResponse
-	if retval, err2 = p.handler.ListTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListTodo: "+err2.Error())
-		oprot.WriteMessageBegin("ListTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ListTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceChatApprovalArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorSearchTodo struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatApprovalArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorSearchTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceSearchTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SearchTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceChatApprovalArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewChatApprovalRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceSearchTodoResult{}
-	var retval *SearchTodoResponse
-	if retval, err2 = p.handler.SearchTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchTodo: "+err2.Error())
-		oprot.WriteMessageBegin("SearchTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceChatApprovalArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatApproval_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("SearchTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatApprovalArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type apiServiceProcessorUpdateTodo struct {
-	handler ApiService
+func (p *ApiServiceChatApprovalArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceChatApprovalArgs(%+v)", *p)
+
 }
 
-func (p *apiServiceProcessorUpdateTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUpdateTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type ApiServiceChatApprovalResult struct {
+	Success *ChatApprovalResponse `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUpdateTodoResult{}
-	var retval *UpdateTodoResponse
-	if retval, err2 = p.handler.UpdateTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateTodo: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewApiServiceChatApprovalResult() *ApiServiceChatApprovalResult {
+	return &ApiServiceChatApprovalResult{}
 }
 
-type apiServiceProcessorDeleteTodo struct {
-	handler ApiService
+func (p *ApiServiceChatApprovalResult) InitDefault() {
 }
 
-func (p *apiServiceProcessorDeleteTodo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceDeleteTodoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var ApiServiceChatApprovalResult_Success_DEFAULT *ChatApprovalResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceDeleteTodoResult{}
-	var retval *DeleteTodoResponse
-	if retval, err2 = p.handler.DeleteTodo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteTodo: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteTodo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteTodo", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceChatApprovalResult) GetSuccess() (v *ChatApprovalResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceChatApprovalResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type apiServiceProcessorGetSummary struct {
-	handler ApiService
+var fieldIDToName_ApiServiceChatApprovalResult = map[int16]string{
+	0: "success",
 }
 
-func (p *apiServiceProcessorGetSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetSummaryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceChatApprovalResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetSummaryResult{}
-	var retval *GetSummaryResponse
-	if retval, err2 = p.handler.GetSummary(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSummary: "+err2.Error())
-		oprot.WriteMessageBegin("GetSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetSummary", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceChatApprovalResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorListSummary struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatApprovalResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorListSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceListSummaryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ListSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceChatApprovalResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewChatApprovalResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceListSummaryResult{}
-	var retval *ListSummaryResponse
-	if retval, err2 = p.handler.ListSummary(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListSummary: "+err2.Error())
-		oprot.WriteMessageBegin("ListSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceChatApprovalResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChatApproval_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("ListSummary", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceChatApprovalResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceChatApprovalResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ApiServiceChatApprovalResult(%+v)", *p)
+
 }
 
-type apiServiceProcessorUpdateSummary struct {
-	handler ApiService
+type ApiServiceTemplateArgs struct {
+	Req *TemplateRequest `thrift:"req,1"`
 }
 
-func (p *apiServiceProcessorUpdateSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceUpdateSummaryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewApiServiceTemplateArgs() *ApiServiceTemplateArgs {
+	return &ApiServiceTemplateArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceUpdateSummaryResult{}
-	var retval *UpdateSummaryResponse
-	if retval, err2 = p.handler.UpdateSummary(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateSummary: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdateSummary", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceTemplateArgs) InitDefault() {
+}
+
+var ApiServiceTemplateArgs_Req_DEFAULT *TemplateRequest
+
+func (p *ApiServiceTemplateArgs) GetReq() (v *TemplateRequest) {
+	if !p.IsSetReq() {
+		return ApiServiceTemplateArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type apiServiceProcessorDeleteSummary struct {
-	handler ApiService
+var fieldIDToName_ApiServiceTemplateArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *apiServiceProcessorDeleteSummary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceDeleteSummaryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceTemplateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceDeleteSummaryResult{}
-	var retval *DeleteSummaryResponse
-	if retval, err2 = p.handler.DeleteSummary(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteSummary: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteSummary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteSummary", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceTemplateArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorGetCourseList struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTemplateArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorGetCourseList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetCourseListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetCourseList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTemplateArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTemplateRequest()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetCourseListResult{}
-	var retval *CourseListResponse
-	if retval, err2 = p.handler.GetCourseList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCourseList: "+err2.Error())
-		oprot.WriteMessageBegin("GetCourseList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceTemplateArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Template_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetCourseList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTemplateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type apiServiceProcessorGetTermList struct {
-	handler ApiService
+func (p *ApiServiceTemplateArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApiServiceTemplateArgs(%+v)", *p)
+
 }
 
-func (p *apiServiceProcessorGetTermList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetTermListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTermList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type ApiServiceTemplateResult struct {
+	Success *TemplateResponse `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetTermListResult{}
-	var retval *CourseTermListResponse
-	if retval, err2 = p.handler.GetTermList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTermList: "+err2.Error())
-		oprot.WriteMessageBegin("GetTermList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTermList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewApiServiceTemplateResult() *ApiServiceTemplateResult {
+	return &ApiServiceTemplateResult{}
 }
 
-type apiServiceProcessorGetTermsList struct {
-	handler ApiService
+func (p *ApiServiceTemplateResult) InitDefault() {
 }
 
-func (p *apiServiceProcessorGetTermsList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetTermsListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTermsList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var ApiServiceTemplateResult_Success_DEFAULT *TemplateResponse
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetTermsListResult{}
-	var retval *TermListResponse
-	if retval, err2 = p.handler.GetTermsList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTermsList: "+err2.Error())
-		oprot.WriteMessageBegin("GetTermsList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTermsList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ApiServiceTemplateResult) GetSuccess() (v *TemplateResponse) {
+	if !p.IsSetSuccess() {
+		return ApiServiceTemplateResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
 }
 
-type apiServiceProcessorGetTerm struct {
-	handler ApiService
+var fieldIDToName_ApiServiceTemplateResult = map[int16]string{
+	0: "success",
 }
 
-func (p *apiServiceProcessorGetTerm) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceGetTermArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetTerm", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ApiServiceTemplateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceGetTermResult{}
-	var retval *TermResponse
-	if retval, err2 = p.handler.GetTerm(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTerm: "+err2.Error())
-		oprot.WriteMessageBegin("GetTerm", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetTerm", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ApiServiceTemplateResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type apiServiceProcessorDailySchedule struct {
-	handler ApiService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTemplateResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *apiServiceProcessorDailySchedule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ApiServiceDailyScheduleArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DailySchedule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ApiServiceTemplateResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewTemplateResponse()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ApiServiceDailyScheduleResult{}
-	var retval *DailyScheduleResponse
-	if retval, err2 = p.handler.DailySchedule(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DailySchedule: "+err2.Error())
-		oprot.WriteMessageBegin("DailySchedule", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ApiServiceTemplateResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Template_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("DailySchedule", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApiServiceTemplateResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ApiServiceTemplateResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ApiServiceTemplateResult(%+v)", *p)
+
 }
 
-type ApiServiceChatArgs struct {
-	Req *ChatRequest `thrift:"req,1"`
+type ApiServiceGetConversationHistoryArgs struct {
+	Req *GetConversationHistoryRequest `thrift:"req,1"`
 }
 
-func NewApiServiceChatArgs() *ApiServiceChatArgs {
-	return &ApiServiceChatArgs{}
+func NewApiServiceGetConversationHistoryArgs() *ApiServiceGetConversationHistoryArgs {
+	return &ApiServiceGetConversationHistoryArgs{}
 }
 
-func (p *ApiServiceChatArgs) InitDefault() {
+func (p *ApiServiceGetConversationHistoryArgs) InitDefault() {
 }
 
-var ApiServiceChatArgs_Req_DEFAULT *ChatRequest
+var ApiServiceGetConversationHistoryArgs_Req_DEFAULT *GetConversationHistoryRequest
 
-func (p *ApiServiceChatArgs) GetReq() (v *ChatRequest) {
+func (p *ApiServiceGetConversationHistoryArgs) GetReq() (v *GetConversationHistoryRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceChatArgs_Req_DEFAULT
+		return ApiServiceGetConversationHistoryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceChatArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetConversationHistoryArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceChatArgs) IsSetReq() bool {
+func (p *ApiServiceGetConversationHistoryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceChatArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetConversationHistoryArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12328,7 +15025,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetConversationHistoryArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12338,8 +15035,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewChatRequest()
+func (p *ApiServiceGetConversationHistoryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetConversationHistoryRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12347,9 +15044,9 @@ func (p *ApiServiceChatArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceChatArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetConversationHistoryArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Chat_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetConversationHistory_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12375,7 +15072,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetConversationHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12392,43 +15089,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceChatArgs) String() string {
+func (p *ApiServiceGetConversationHistoryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceChatArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetConversationHistoryArgs(%+v)", *p)
 
 }
 
-type ApiServiceChatResult struct {
-	Success *ChatResponse `thrift:"success,0,optional"`
+type ApiServiceGetConversationHistoryResult struct {
+	Success *GetConversationHistoryResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceChatResult() *ApiServiceChatResult {
-	return &ApiServiceChatResult{}
+func NewApiServiceGetConversationHistoryResult() *ApiServiceGetConversationHistoryResult {
+	return &ApiServiceGetConversationHistoryResult{}
 }
 
-func (p *ApiServiceChatResult) InitDefault() {
+func (p *ApiServiceGetConversationHistoryResult) InitDefault() {
 }
 
-var ApiServiceChatResult_Success_DEFAULT *ChatResponse
+var ApiServiceGetConversationHistoryResult_Success_DEFAULT *GetConversationHistoryResponse
 
-func (p *ApiServiceChatResult) GetSuccess() (v *ChatResponse) {
+func (p *ApiServiceGetConversationHistoryResult) GetSuccess() (v *GetConversationHistoryResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceChatResult_Success_DEFAULT
+		return ApiServiceGetConversationHistoryResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceChatResult = map[int16]string{
+var fieldIDToName_ApiServiceGetConversationHistoryResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceChatResult) IsSetSuccess() bool {
+func (p *ApiServiceGetConversationHistoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceChatResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetConversationHistoryResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12474,7 +15171,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetConversationHistoryResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12484,8 +15181,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewChatResponse()
+func (p *ApiServiceGetConversationHistoryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetConversationHistoryResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12493,9 +15190,9 @@ func (p *ApiServiceChatResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceChatResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetConversationHistoryResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Chat_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetConversationHistory_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12521,7 +15218,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetConversationHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12540,43 +15237,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceChatResult) String() string {
+func (p *ApiServiceGetConversationHistoryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceChatResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetConversationHistoryResult(%+v)", *p)
 
 }
 
-type ApiServiceChatSSEArgs struct {
-	Req *ChatSSEHandlerRequest `thrift:"req,1"`
+type ApiServiceDeleteConversationArgs struct {
+	Req *DeleteConversationRequest `thrift:"req,1"`
 }
 
-func NewApiServiceChatSSEArgs() *ApiServiceChatSSEArgs {
-	return &ApiServiceChatSSEArgs{}
+func NewApiServiceDeleteConversationArgs() *ApiServiceDeleteConversationArgs {
+	return &ApiServiceDeleteConversationArgs{}
 }
 
-func (p *ApiServiceChatSSEArgs) InitDefault() {
+func (p *ApiServiceDeleteConversationArgs) InitDefault() {
 }
 
-var ApiServiceChatSSEArgs_Req_DEFAULT *ChatSSEHandlerRequest
+var ApiServiceDeleteConversationArgs_Req_DEFAULT *DeleteConversationRequest
 
-func (p *ApiServiceChatSSEArgs) GetReq() (v *ChatSSEHandlerRequest) {
+func (p *ApiServiceDeleteConversationArgs) GetReq() (v *DeleteConversationRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceChatSSEArgs_Req_DEFAULT
+		return ApiServiceDeleteConversationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceChatSSEArgs = map[int16]string{
+var fieldIDToName_ApiServiceDeleteConversationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceChatSSEArgs) IsSetReq() bool {
+func (p *ApiServiceDeleteConversationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceChatSSEArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12622,7 +15319,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatSSEArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteConversationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12632,8 +15329,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewChatSSEHandlerRequest()
+func (p *ApiServiceDeleteConversationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteConversationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12641,9 +15338,9 @@ func (p *ApiServiceChatSSEArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceChatSSEArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatSSE_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteConversation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12669,7 +15366,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12686,43 +15383,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEArgs) String() string {
+func (p *ApiServiceDeleteConversationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceChatSSEArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDeleteConversationArgs(%+v)", *p)
 
 }
 
-type ApiServiceChatSSEResult struct {
-	Success *ChatSSEHandlerResponse `thrift:"success,0,optional"`
+type ApiServiceDeleteConversationResult struct {
+	Success *DeleteConversationResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceChatSSEResult() *ApiServiceChatSSEResult {
-	return &ApiServiceChatSSEResult{}
+func NewApiServiceDeleteConversationResult() *ApiServiceDeleteConversationResult {
+	return &ApiServiceDeleteConversationResult{}
 }
 
-func (p *ApiServiceChatSSEResult) InitDefault() {
+func (p *ApiServiceDeleteConversationResult) InitDefault() {
 }
 
-var ApiServiceChatSSEResult_Success_DEFAULT *ChatSSEHandlerResponse
+var ApiServiceDeleteConversationResult_Success_DEFAULT *DeleteConversationResponse
 
-func (p *ApiServiceChatSSEResult) GetSuccess() (v *ChatSSEHandlerResponse) {
+func (p *ApiServiceDeleteConversationResult) GetSuccess() (v *DeleteConversationResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceChatSSEResult_Success_DEFAULT
+		return ApiServiceDeleteConversationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceChatSSEResult = map[int16]string{
+var fieldIDToName_ApiServiceDeleteConversationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceChatSSEResult) IsSetSuccess() bool {
+func (p *ApiServiceDeleteConversationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceChatSSEResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12768,7 +15465,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceChatSSEResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteConversationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12778,8 +15475,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewChatSSEHandlerResponse()
+func (p *ApiServiceDeleteConversationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteConversationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12787,9 +15484,9 @@ func (p *ApiServiceChatSSEResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceChatSSEResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChatSSE_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteConversation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12815,7 +15512,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceDeleteConversationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12834,43 +15531,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceChatSSEResult) String() string {
+func (p *ApiServiceDeleteConversationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceChatSSEResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceDeleteConversationResult(%+v)", *p)
 
 }
 
-type ApiServiceTemplateArgs struct {
-	Req *TemplateRequest `thrift:"req,1"`
+type ApiServiceListConversationsArgs struct {
+	Req *ListConversationsRequest `thrift:"req,1"`
 }
 
-func NewApiServiceTemplateArgs() *ApiServiceTemplateArgs {
-	return &ApiServiceTemplateArgs{}
+func NewApiServiceListConversationsArgs() *ApiServiceListConversationsArgs {
+	return &ApiServiceListConversationsArgs{}
 }
 
-func (p *ApiServiceTemplateArgs) InitDefault() {
+func (p *ApiServiceListConversationsArgs) InitDefault() {
 }
 
-var ApiServiceTemplateArgs_Req_DEFAULT *TemplateRequest
+var ApiServiceListConversationsArgs_Req_DEFAULT *ListConversationsRequest
 
-func (p *ApiServiceTemplateArgs) GetReq() (v *TemplateRequest) {
+func (p *ApiServiceListConversationsArgs) GetReq() (v *ListConversationsRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceTemplateArgs_Req_DEFAULT
+		return ApiServiceListConversationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceTemplateArgs = map[int16]string{
+var fieldIDToName_ApiServiceListConversationsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceTemplateArgs) IsSetReq() bool {
+func (p *ApiServiceListConversationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceTemplateArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12916,7 +15613,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTemplateArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListConversationsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12926,8 +15623,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewTemplateRequest()
+func (p *ApiServiceListConversationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListConversationsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12935,9 +15632,9 @@ func (p *ApiServiceTemplateArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceTemplateArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Template_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListConversations_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12963,7 +15660,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12980,43 +15677,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateArgs) String() string {
+func (p *ApiServiceListConversationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTemplateArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListConversationsArgs(%+v)", *p)
 
 }
 
-type ApiServiceTemplateResult struct {
-	Success *TemplateResponse `thrift:"success,0,optional"`
+type ApiServiceListConversationsResult struct {
+	Success *ListConversationsResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceTemplateResult() *ApiServiceTemplateResult {
-	return &ApiServiceTemplateResult{}
+func NewApiServiceListConversationsResult() *ApiServiceListConversationsResult {
+	return &ApiServiceListConversationsResult{}
 }
 
-func (p *ApiServiceTemplateResult) InitDefault() {
+func (p *ApiServiceListConversationsResult) InitDefault() {
 }
 
-var ApiServiceTemplateResult_Success_DEFAULT *TemplateResponse
+var ApiServiceListConversationsResult_Success_DEFAULT *ListConversationsResponse
 
-func (p *ApiServiceTemplateResult) GetSuccess() (v *TemplateResponse) {
+func (p *ApiServiceListConversationsResult) GetSuccess() (v *ListConversationsResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceTemplateResult_Success_DEFAULT
+		return ApiServiceListConversationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceTemplateResult = map[int16]string{
+var fieldIDToName_ApiServiceListConversationsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceTemplateResult) IsSetSuccess() bool {
+func (p *ApiServiceListConversationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceTemplateResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13062,7 +15759,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceTemplateResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListConversationsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13072,8 +15769,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewTemplateResponse()
+func (p *ApiServiceListConversationsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListConversationsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13081,9 +15778,9 @@ func (p *ApiServiceTemplateResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceTemplateResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Template_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListConversations_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13109,7 +15806,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListConversationsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13128,43 +15825,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceTemplateResult) String() string {
+func (p *ApiServiceListConversationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceTemplateResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListConversationsResult(%+v)", *p)
 
 }
 
-type ApiServiceGetConversationHistoryArgs struct {
-	Req *GetConversationHistoryRequest `thrift:"req,1"`
+type ApiServiceSummarizeConversationArgs struct {
+	Req *SummarizeConversationRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetConversationHistoryArgs() *ApiServiceGetConversationHistoryArgs {
-	return &ApiServiceGetConversationHistoryArgs{}
+func NewApiServiceSummarizeConversationArgs() *ApiServiceSummarizeConversationArgs {
+	return &ApiServiceSummarizeConversationArgs{}
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) InitDefault() {
+func (p *ApiServiceSummarizeConversationArgs) InitDefault() {
 }
 
-var ApiServiceGetConversationHistoryArgs_Req_DEFAULT *GetConversationHistoryRequest
+var ApiServiceSummarizeConversationArgs_Req_DEFAULT *SummarizeConversationRequest
 
-func (p *ApiServiceGetConversationHistoryArgs) GetReq() (v *GetConversationHistoryRequest) {
+func (p *ApiServiceSummarizeConversationArgs) GetReq() (v *SummarizeConversationRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetConversationHistoryArgs_Req_DEFAULT
+		return ApiServiceSummarizeConversationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetConversationHistoryArgs = map[int16]string{
+var fieldIDToName_ApiServiceSummarizeConversationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) IsSetReq() bool {
+func (p *ApiServiceSummarizeConversationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13210,7 +15907,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetConversationHistoryArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSummarizeConversationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13220,8 +15917,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetConversationHistoryRequest()
+func (p *ApiServiceSummarizeConversationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSummarizeConversationRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13229,9 +15926,9 @@ func (p *ApiServiceGetConversationHistoryArgs) ReadField1(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetConversationHistory_args"); err != nil {
+	if err = oprot.WriteStructBegin("SummarizeConversation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13257,7 +15954,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -13274,43 +15971,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryArgs) String() string {
+func (p *ApiServiceSummarizeConversationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetConversationHistoryArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceSummarizeConversationArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetConversationHistoryResult struct {
-	Success *GetConversationHistoryResponse `thrift:"success,0,optional"`
+type ApiServiceSummarizeConversationResult struct {
+	Success *SummarizeConversationResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetConversationHistoryResult() *ApiServiceGetConversationHistoryResult {
-	return &ApiServiceGetConversationHistoryResult{}
+func NewApiServiceSummarizeConversationResult() *ApiServiceSummarizeConversationResult {
+	return &ApiServiceSummarizeConversationResult{}
 }
 
-func (p *ApiServiceGetConversationHistoryResult) InitDefault() {
+func (p *ApiServiceSummarizeConversationResult) InitDefault() {
 }
 
-var ApiServiceGetConversationHistoryResult_Success_DEFAULT *GetConversationHistoryResponse
+var ApiServiceSummarizeConversationResult_Success_DEFAULT *SummarizeConversationResponse
 
-func (p *ApiServiceGetConversationHistoryResult) GetSuccess() (v *GetConversationHistoryResponse) {
+func (p *ApiServiceSummarizeConversationResult) GetSuccess() (v *SummarizeConversationResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetConversationHistoryResult_Success_DEFAULT
+		return ApiServiceSummarizeConversationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetConversationHistoryResult = map[int16]string{
+var fieldIDToName_ApiServiceSummarizeConversationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetConversationHistoryResult) IsSetSuccess() bool {
+func (p *ApiServiceSummarizeConversationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetConversationHistoryResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13356,7 +16053,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetConversationHistoryResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSummarizeConversationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13366,8 +16063,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetConversationHistoryResponse()
+func (p *ApiServiceSummarizeConversationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSummarizeConversationResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13375,9 +16072,9 @@ func (p *ApiServiceGetConversationHistoryResult) ReadField0(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *ApiServiceGetConversationHistoryResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetConversationHistory_result"); err != nil {
+	if err = oprot.WriteStructBegin("SummarizeConversation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13403,7 +16100,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceSummarizeConversationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13422,43 +16119,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetConversationHistoryResult) String() string {
+func (p *ApiServiceSummarizeConversationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetConversationHistoryResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceSummarizeConversationResult(%+v)", *p)
 
 }
 
-type ApiServiceDeleteConversationArgs struct {
-	Req *DeleteConversationRequest `thrift:"req,1"`
+type ApiServiceGetLoginDataArgs struct {
+	Req *GetLoginDataRequest `thrift:"req,1"`
 }
 
-func NewApiServiceDeleteConversationArgs() *ApiServiceDeleteConversationArgs {
-	return &ApiServiceDeleteConversationArgs{}
+func NewApiServiceGetLoginDataArgs() *ApiServiceGetLoginDataArgs {
+	return &ApiServiceGetLoginDataArgs{}
 }
 
-func (p *ApiServiceDeleteConversationArgs) InitDefault() {
+func (p *ApiServiceGetLoginDataArgs) InitDefault() {
 }
 
-var ApiServiceDeleteConversationArgs_Req_DEFAULT *DeleteConversationRequest
+var ApiServiceGetLoginDataArgs_Req_DEFAULT *GetLoginDataRequest
 
-func (p *ApiServiceDeleteConversationArgs) GetReq() (v *DeleteConversationRequest) {
+func (p *ApiServiceGetLoginDataArgs) GetReq() (v *GetLoginDataRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceDeleteConversationArgs_Req_DEFAULT
+		return ApiServiceGetLoginDataArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceDeleteConversationArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetLoginDataArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceDeleteConversationArgs) IsSetReq() bool {
+func (p *ApiServiceGetLoginDataArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceDeleteConversationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13504,7 +16201,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteConversationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetLoginDataArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13514,8 +16211,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteConversationRequest()
+func (p *ApiServiceGetLoginDataArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetLoginDataRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13523,9 +16220,9 @@ func (p *ApiServiceDeleteConversationArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *ApiServiceDeleteConversationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteConversation_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetLoginData_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13551,7 +16248,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -13568,43 +16265,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationArgs) String() string {
+func (p *ApiServiceGetLoginDataArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceDeleteConversationArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetLoginDataArgs(%+v)", *p)
 
 }
 
-type ApiServiceDeleteConversationResult struct {
-	Success *DeleteConversationResponse `thrift:"success,0,optional"`
+type ApiServiceGetLoginDataResult struct {
+	Success *GetLoginDataResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceDeleteConversationResult() *ApiServiceDeleteConversationResult {
-	return &ApiServiceDeleteConversationResult{}
+func NewApiServiceGetLoginDataResult() *ApiServiceGetLoginDataResult {
+	return &ApiServiceGetLoginDataResult{}
 }
 
-func (p *ApiServiceDeleteConversationResult) InitDefault() {
+func (p *ApiServiceGetLoginDataResult) InitDefault() {
 }
 
-var ApiServiceDeleteConversationResult_Success_DEFAULT *DeleteConversationResponse
+var ApiServiceGetLoginDataResult_Success_DEFAULT *GetLoginDataResponse
 
-func (p *ApiServiceDeleteConversationResult) GetSuccess() (v *DeleteConversationResponse) {
+func (p *ApiServiceGetLoginDataResult) GetSuccess() (v *GetLoginDataResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceDeleteConversationResult_Success_DEFAULT
+		return ApiServiceGetLoginDataResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceDeleteConversationResult = map[int16]string{
+var fieldIDToName_ApiServiceGetLoginDataResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceDeleteConversationResult) IsSetSuccess() bool {
+func (p *ApiServiceGetLoginDataResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceDeleteConversationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13650,7 +16347,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceDeleteConversationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetLoginDataResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13660,8 +16357,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteConversationResponse()
+func (p *ApiServiceGetLoginDataResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetLoginDataResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13669,9 +16366,9 @@ func (p *ApiServiceDeleteConversationResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ApiServiceDeleteConversationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteConversation_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetLoginData_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13697,7 +16394,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetLoginDataResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -13716,43 +16413,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceDeleteConversationResult) String() string {
+func (p *ApiServiceGetLoginDataResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceDeleteConversationResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetLoginDataResult(%+v)", *p)
 
 }
 
-type ApiServiceListConversationsArgs struct {
-	Req *ListConversationsRequest `thrift:"req,1"`
+type ApiServiceGetUserInfoArgs struct {
+	Req *GetUserInfoRequest `thrift:"req,1"`
 }
 
-func NewApiServiceListConversationsArgs() *ApiServiceListConversationsArgs {
-	return &ApiServiceListConversationsArgs{}
+func NewApiServiceGetUserInfoArgs() *ApiServiceGetUserInfoArgs {
+	return &ApiServiceGetUserInfoArgs{}
 }
 
-func (p *ApiServiceListConversationsArgs) InitDefault() {
+func (p *ApiServiceGetUserInfoArgs) InitDefault() {
 }
 
-var ApiServiceListConversationsArgs_Req_DEFAULT *ListConversationsRequest
+var ApiServiceGetUserInfoArgs_Req_DEFAULT *GetUserInfoRequest
 
-func (p *ApiServiceListConversationsArgs) GetReq() (v *ListConversationsRequest) {
+func (p *ApiServiceGetUserInfoArgs) GetReq() (v *GetUserInfoRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceListConversationsArgs_Req_DEFAULT
+		return ApiServiceGetUserInfoArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceListConversationsArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetUserInfoArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceListConversationsArgs) IsSetReq() bool {
+func (p *ApiServiceGetUserInfoArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceListConversationsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13798,7 +16495,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListConversationsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserInfoArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13808,8 +16505,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListConversationsRequest()
+func (p *ApiServiceGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserInfoRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13817,9 +16514,9 @@ func (p *ApiServiceListConversationsArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *ApiServiceListConversationsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListConversations_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserInfo_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13845,7 +16542,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -13862,43 +16559,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsArgs) String() string {
+func (p *ApiServiceGetUserInfoArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceListConversationsArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetUserInfoArgs(%+v)", *p)
 
 }
 
-type ApiServiceListConversationsResult struct {
-	Success *ListConversationsResponse `thrift:"success,0,optional"`
+type ApiServiceGetUserInfoResult struct {
+	Success *GetUserInfoResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceListConversationsResult() *ApiServiceListConversationsResult {
-	return &ApiServiceListConversationsResult{}
+func NewApiServiceGetUserInfoResult() *ApiServiceGetUserInfoResult {
+	return &ApiServiceGetUserInfoResult{}
 }
 
-func (p *ApiServiceListConversationsResult) InitDefault() {
+func (p *ApiServiceGetUserInfoResult) InitDefault() {
 }
 
-var ApiServiceListConversationsResult_Success_DEFAULT *ListConversationsResponse
+var ApiServiceGetUserInfoResult_Success_DEFAULT *GetUserInfoResponse
 
-func (p *ApiServiceListConversationsResult) GetSuccess() (v *ListConversationsResponse) {
+func (p *ApiServiceGetUserInfoResult) GetSuccess() (v *GetUserInfoResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceListConversationsResult_Success_DEFAULT
+		return ApiServiceGetUserInfoResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceListConversationsResult = map[int16]string{
+var fieldIDToName_ApiServiceGetUserInfoResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceListConversationsResult) IsSetSuccess() bool {
+func (p *ApiServiceGetUserInfoResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceListConversationsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -13944,7 +16641,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListConversationsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserInfoResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -13954,8 +16651,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListConversationsResponse()
+func (p *ApiServiceGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserInfoResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -13963,9 +16660,9 @@ func (p *ApiServiceListConversationsResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *ApiServiceListConversationsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ListConversations_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserInfo_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -13991,7 +16688,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -14010,43 +16707,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceListConversationsResult) String() string {
+func (p *ApiServiceGetUserInfoResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceListConversationsResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetUserInfoResult(%+v)", *p)
 
 }
 
-type ApiServiceSummarizeConversationArgs struct {
-	Req *SummarizeConversationRequest `thrift:"req,1"`
+type ApiServiceUpdateUserSettingArgs struct {
+	Req *UpdateUserSettingRequest `thrift:"req,1"`
 }
 
-func NewApiServiceSummarizeConversationArgs() *ApiServiceSummarizeConversationArgs {
-	return &ApiServiceSummarizeConversationArgs{}
+func NewApiServiceUpdateUserSettingArgs() *ApiServiceUpdateUserSettingArgs {
+	return &ApiServiceUpdateUserSettingArgs{}
 }
 
-func (p *ApiServiceSummarizeConversationArgs) InitDefault() {
+func (p *ApiServiceUpdateUserSettingArgs) InitDefault() {
 }
 
-var ApiServiceSummarizeConversationArgs_Req_DEFAULT *SummarizeConversationRequest
+var ApiServiceUpdateUserSettingArgs_Req_DEFAULT *UpdateUserSettingRequest
 
-func (p *ApiServiceSummarizeConversationArgs) GetReq() (v *SummarizeConversationRequest) {
+func (p *ApiServiceUpdateUserSettingArgs) GetReq() (v *UpdateUserSettingRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceSummarizeConversationArgs_Req_DEFAULT
+		return ApiServiceUpdateUserSettingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceSummarizeConversationArgs = map[int16]string{
+var fieldIDToName_ApiServiceUpdateUserSettingArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceSummarizeConversationArgs) IsSetReq() bool {
+func (p *ApiServiceUpdateUserSettingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceSummarizeConversationArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14092,7 +16789,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSummarizeConversationArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateUserSettingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14102,8 +16799,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSummarizeConversationRequest()
+func (p *ApiServiceUpdateUserSettingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateUserSettingRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14111,9 +16808,9 @@ func (p *ApiServiceSummarizeConversationArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ApiServiceSummarizeConversationArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SummarizeConversation_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateUserSetting_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14139,7 +16836,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -14156,43 +16853,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationArgs) String() string {
+func (p *ApiServiceUpdateUserSettingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceSummarizeConversationArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceUpdateUserSettingArgs(%+v)", *p)
 
 }
 
-type ApiServiceSummarizeConversationResult struct {
-	Success *SummarizeConversationResponse `thrift:"success,0,optional"`
+type ApiServiceUpdateUserSettingResult struct {
+	Success *UpdateUserSettingResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceSummarizeConversationResult() *ApiServiceSummarizeConversationResult {
-	return &ApiServiceSummarizeConversationResult{}
+func NewApiServiceUpdateUserSettingResult() *ApiServiceUpdateUserSettingResult {
+	return &ApiServiceUpdateUserSettingResult{}
 }
 
-func (p *ApiServiceSummarizeConversationResult) InitDefault() {
+func (p *ApiServiceUpdateUserSettingResult) InitDefault() {
 }
 
-var ApiServiceSummarizeConversationResult_Success_DEFAULT *SummarizeConversationResponse
+var ApiServiceUpdateUserSettingResult_Success_DEFAULT *UpdateUserSettingResponse
 
-func (p *ApiServiceSummarizeConversationResult) GetSuccess() (v *SummarizeConversationResponse) {
+func (p *ApiServiceUpdateUserSettingResult) GetSuccess() (v *UpdateUserSettingResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceSummarizeConversationResult_Success_DEFAULT
+		return ApiServiceUpdateUserSettingResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceSummarizeConversationResult = map[int16]string{
+var fieldIDToName_ApiServiceUpdateUserSettingResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceSummarizeConversationResult) IsSetSuccess() bool {
+func (p *ApiServiceUpdateUserSettingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceSummarizeConversationResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14238,7 +16935,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceSummarizeConversationResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateUserSettingResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14248,8 +16945,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSummarizeConversationResponse()
+func (p *ApiServiceUpdateUserSettingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateUserSettingResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14257,9 +16954,9 @@ func (p *ApiServiceSummarizeConversationResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *ApiServiceSummarizeConversationResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SummarizeConversation_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateUserSetting_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14285,7 +16982,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceUpdateUserSettingResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -14304,43 +17001,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceSummarizeConversationResult) String() string {
+func (p *ApiServiceUpdateUserSettingResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceSummarizeConversationResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceUpdateUserSettingResult(%+v)", *p)
 
 }
 
-type ApiServiceGetLoginDataArgs struct {
-	Req *GetLoginDataRequest `thrift:"req,1"`
+type ApiServiceGetUserUsageArgs struct {
+	Req *GetUserUsageRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetLoginDataArgs() *ApiServiceGetLoginDataArgs {
-	return &ApiServiceGetLoginDataArgs{}
+func NewApiServiceGetUserUsageArgs() *ApiServiceGetUserUsageArgs {
+	return &ApiServiceGetUserUsageArgs{}
 }
 
-func (p *ApiServiceGetLoginDataArgs) InitDefault() {
+func (p *ApiServiceGetUserUsageArgs) InitDefault() {
 }
 
-var ApiServiceGetLoginDataArgs_Req_DEFAULT *GetLoginDataRequest
+var ApiServiceGetUserUsageArgs_Req_DEFAULT *GetUserUsageRequest
 
-func (p *ApiServiceGetLoginDataArgs) GetReq() (v *GetLoginDataRequest) {
+func (p *ApiServiceGetUserUsageArgs) GetReq() (v *GetUserUsageRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetLoginDataArgs_Req_DEFAULT
+		return ApiServiceGetUserUsageArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetLoginDataArgs = map[int16]string{
+var fieldIDToName_ApiServiceGetUserUsageArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetLoginDataArgs) IsSetReq() bool {
+func (p *ApiServiceGetUserUsageArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetLoginDataArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14386,7 +17083,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetLoginDataArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserUsageArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14396,8 +17093,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetLoginDataRequest()
+func (p *ApiServiceGetUserUsageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserUsageRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14405,9 +17102,9 @@ func (p *ApiServiceGetLoginDataArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetLoginDataArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLoginData_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserUsage_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14433,7 +17130,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -14450,43 +17147,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataArgs) String() string {
+func (p *ApiServiceGetUserUsageArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetLoginDataArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetUserUsageArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetLoginDataResult struct {
-	Success *GetLoginDataResponse `thrift:"success,0,optional"`
+type ApiServiceGetUserUsageResult struct {
+	Success *GetUserUsageResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetLoginDataResult() *ApiServiceGetLoginDataResult {
-	return &ApiServiceGetLoginDataResult{}
+func NewApiServiceGetUserUsageResult() *ApiServiceGetUserUsageResult {
+	return &ApiServiceGetUserUsageResult{}
 }
 
-func (p *ApiServiceGetLoginDataResult) InitDefault() {
+func (p *ApiServiceGetUserUsageResult) InitDefault() {
 }
 
-var ApiServiceGetLoginDataResult_Success_DEFAULT *GetLoginDataResponse
+var ApiServiceGetUserUsageResult_Success_DEFAULT *GetUserUsageResponse
 
-func (p *ApiServiceGetLoginDataResult) GetSuccess() (v *GetLoginDataResponse) {
+func (p *ApiServiceGetUserUsageResult) GetSuccess() (v *GetUserUsageResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetLoginDataResult_Success_DEFAULT
+		return ApiServiceGetUserUsageResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetLoginDataResult = map[int16]string{
+var fieldIDToName_ApiServiceGetUserUsageResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetLoginDataResult) IsSetSuccess() bool {
+func (p *ApiServiceGetUserUsageResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetLoginDataResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14532,7 +17229,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetLoginDataResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserUsageResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14542,8 +17239,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetLoginDataResponse()
+func (p *ApiServiceGetUserUsageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserUsageResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14551,9 +17248,9 @@ func (p *ApiServiceGetLoginDataResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *ApiServiceGetLoginDataResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLoginData_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserUsage_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14579,7 +17276,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceGetUserUsageResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -14598,43 +17295,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetLoginDataResult) String() string {
+func (p *ApiServiceGetUserUsageResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetLoginDataResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceGetUserUsageResult(%+v)", *p)
 
 }
 
-type ApiServiceGetUserInfoArgs struct {
-	Req *GetUserInfoRequest `thrift:"req,1"`
+type ApiServiceListMCPPromptsArgs struct {
+	Req *ListMCPPromptsRequest `thrift:"req,1"`
 }
 
-func NewApiServiceGetUserInfoArgs() *ApiServiceGetUserInfoArgs {
-	return &ApiServiceGetUserInfoArgs{}
+func NewApiServiceListMCPPromptsArgs() *ApiServiceListMCPPromptsArgs {
+	return &ApiServiceListMCPPromptsArgs{}
 }
 
-func (p *ApiServiceGetUserInfoArgs) InitDefault() {
+func (p *ApiServiceListMCPPromptsArgs) InitDefault() {
 }
 
-var ApiServiceGetUserInfoArgs_Req_DEFAULT *GetUserInfoRequest
+var ApiServiceListMCPPromptsArgs_Req_DEFAULT *ListMCPPromptsRequest
 
-func (p *ApiServiceGetUserInfoArgs) GetReq() (v *GetUserInfoRequest) {
+func (p *ApiServiceListMCPPromptsArgs) GetReq() (v *ListMCPPromptsRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceGetUserInfoArgs_Req_DEFAULT
+		return ApiServiceListMCPPromptsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceGetUserInfoArgs = map[int16]string{
+var fieldIDToName_ApiServiceListMCPPromptsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceGetUserInfoArgs) IsSetReq() bool {
+func (p *ApiServiceListMCPPromptsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceGetUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPPromptsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14680,7 +17377,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListMCPPromptsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14690,8 +17387,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetUserInfoRequest()
+func (p *ApiServiceListMCPPromptsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListMCPPromptsRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14699,9 +17396,9 @@ func (p *ApiServiceGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPPromptsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListMCPPrompts_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14727,7 +17424,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPPromptsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -14744,43 +17441,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoArgs) String() string {
+func (p *ApiServiceListMCPPromptsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetUserInfoArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListMCPPromptsArgs(%+v)", *p)
 
 }
 
-type ApiServiceGetUserInfoResult struct {
-	Success *GetUserInfoResponse `thrift:"success,0,optional"`
+type ApiServiceListMCPPromptsResult struct {
+	Success *ListMCPPromptsResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceGetUserInfoResult() *ApiServiceGetUserInfoResult {
-	return &ApiServiceGetUserInfoResult{}
+func NewApiServiceListMCPPromptsResult() *ApiServiceListMCPPromptsResult {
+	return &ApiServiceListMCPPromptsResult{}
 }
 
-func (p *ApiServiceGetUserInfoResult) InitDefault() {
+func (p *ApiServiceListMCPPromptsResult) InitDefault() {
 }
 
-var ApiServiceGetUserInfoResult_Success_DEFAULT *GetUserInfoResponse
+var ApiServiceListMCPPromptsResult_Success_DEFAULT *ListMCPPromptsResponse
 
-func (p *ApiServiceGetUserInfoResult) GetSuccess() (v *GetUserInfoResponse) {
+func (p *ApiServiceListMCPPromptsResult) GetSuccess() (v *ListMCPPromptsResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceGetUserInfoResult_Success_DEFAULT
+		return ApiServiceListMCPPromptsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceGetUserInfoResult = map[int16]string{
+var fieldIDToName_ApiServiceListMCPPromptsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceGetUserInfoResult) IsSetSuccess() bool {
+func (p *ApiServiceListMCPPromptsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceGetUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPPromptsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14826,7 +17523,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceGetUserInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListMCPPromptsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14836,8 +17533,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetUserInfoResponse()
+func (p *ApiServiceListMCPPromptsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListMCPPromptsResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14845,9 +17542,9 @@ func (p *ApiServiceGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ApiServiceGetUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPPromptsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListMCPPrompts_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -14873,7 +17570,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPPromptsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -14892,43 +17589,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceGetUserInfoResult) String() string {
+func (p *ApiServiceListMCPPromptsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceGetUserInfoResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListMCPPromptsResult(%+v)", *p)
 
 }
 
-type ApiServiceUpdateUserSettingArgs struct {
-	Req *UpdateUserSettingRequest `thrift:"req,1"`
+type ApiServiceListMCPResourcesArgs struct {
+	Req *ListMCPResourcesRequest `thrift:"req,1"`
 }
 
-func NewApiServiceUpdateUserSettingArgs() *ApiServiceUpdateUserSettingArgs {
-	return &ApiServiceUpdateUserSettingArgs{}
+func NewApiServiceListMCPResourcesArgs() *ApiServiceListMCPResourcesArgs {
+	return &ApiServiceListMCPResourcesArgs{}
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) InitDefault() {
+func (p *ApiServiceListMCPResourcesArgs) InitDefault() {
 }
 
-var ApiServiceUpdateUserSettingArgs_Req_DEFAULT *UpdateUserSettingRequest
+var ApiServiceListMCPResourcesArgs_Req_DEFAULT *ListMCPResourcesRequest
 
-func (p *ApiServiceUpdateUserSettingArgs) GetReq() (v *UpdateUserSettingRequest) {
+func (p *ApiServiceListMCPResourcesArgs) GetReq() (v *ListMCPResourcesRequest) {
 	if !p.IsSetReq() {
-		return ApiServiceUpdateUserSettingArgs_Req_DEFAULT
+		return ApiServiceListMCPResourcesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ApiServiceUpdateUserSettingArgs = map[int16]string{
+var fieldIDToName_ApiServiceListMCPResourcesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) IsSetReq() bool {
+func (p *ApiServiceListMCPResourcesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPResourcesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -14974,7 +17671,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateUserSettingArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListMCPResourcesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -14984,8 +17681,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateUserSettingRequest()
+func (p *ApiServiceListMCPResourcesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListMCPResourcesRequest()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -14993,9 +17690,9 @@ func (p *ApiServiceUpdateUserSettingArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPResourcesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserSetting_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListMCPResources_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15021,7 +17718,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPResourcesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -15038,43 +17735,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingArgs) String() string {
+func (p *ApiServiceListMCPResourcesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUpdateUserSettingArgs(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListMCPResourcesArgs(%+v)", *p)
 
 }
 
-type ApiServiceUpdateUserSettingResult struct {
-	Success *UpdateUserSettingResponse `thrift:"success,0,optional"`
+type ApiServiceListMCPResourcesResult struct {
+	Success *ListMCPResourcesResponse `thrift:"success,0,optional"`
 }
 
-func NewApiServiceUpdateUserSettingResult() *ApiServiceUpdateUserSettingResult {
-	return &ApiServiceUpdateUserSettingResult{}
+func NewApiServiceListMCPResourcesResult() *ApiServiceListMCPResourcesResult {
+	return &ApiServiceListMCPResourcesResult{}
 }
 
-func (p *ApiServiceUpdateUserSettingResult) InitDefault() {
+func (p *ApiServiceListMCPResourcesResult) InitDefault() {
 }
 
-var ApiServiceUpdateUserSettingResult_Success_DEFAULT *UpdateUserSettingResponse
+var ApiServiceListMCPResourcesResult_Success_DEFAULT *ListMCPResourcesResponse
 
-func (p *ApiServiceUpdateUserSettingResult) GetSuccess() (v *UpdateUserSettingResponse) {
+func (p *ApiServiceListMCPResourcesResult) GetSuccess() (v *ListMCPResourcesResponse) {
 	if !p.IsSetSuccess() {
-		return ApiServiceUpdateUserSettingResult_Success_DEFAULT
+		return ApiServiceListMCPResourcesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ApiServiceUpdateUserSettingResult = map[int16]string{
+var fieldIDToName_ApiServiceListMCPResourcesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ApiServiceUpdateUserSettingResult) IsSetSuccess() bool {
+func (p *ApiServiceListMCPResourcesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ApiServiceUpdateUserSettingResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPResourcesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -15120,7 +17817,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceUpdateUserSettingResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApiServiceListMCPResourcesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -15130,8 +17827,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateUserSettingResponse()
+func (p *ApiServiceListMCPResourcesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListMCPResourcesResponse()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -15139,9 +17836,9 @@ func (p *ApiServiceUpdateUserSettingResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *ApiServiceUpdateUserSettingResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPResourcesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserSetting_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListMCPResources_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -15167,7 +17864,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ApiServiceListMCPResourcesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -15186,11 +17883,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ApiServiceUpdateUserSettingResult) String() string {
+func (p *ApiServiceListMCPResourcesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ApiServiceUpdateUserSettingResult(%+v)", *p)
+	return fmt.Sprintf("ApiServiceListMCPResourcesResult(%+v)", *p)
 
 }
 
@@ -0,0 +1,31 @@
+package mw
+
+import (
+	"context"
+
+	"github.com/FantasyRL/go-mcp-demo/api/pack"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// QuotaChecker 检查用户是否还有可用配额，超限时返回对应 errno
+type QuotaChecker func(ctx context.Context, userID string) error
+
+// Quota 在调用模型的接口前检查 token 配额，需放在 Auth 之后
+func Quota(check QuotaChecker) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		uid, ok := utils.ExtractStuID(ctx)
+		if !ok {
+			pack.RespError(c, errno.AuthInvalid)
+			c.Abort()
+			return
+		}
+		if err := check(ctx, uid); err != nil {
+			pack.RespError(c, err)
+			c.Abort()
+			return
+		}
+		c.Next(ctx)
+	}
+}
@@ -0,0 +1,41 @@
+package pack
+
+import (
+	"github.com/FantasyRL/go-mcp-demo/api/model/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+)
+
+// BuildMCPPromptList 构建提示词模板列表响应
+func BuildMCPPromptList(prompts []mcp_client.Prompt) []*model.MCPPrompt {
+	items := make([]*model.MCPPrompt, 0, len(prompts))
+	for _, p := range prompts {
+		args := make([]*model.MCPPromptArgument, 0, len(p.Arguments))
+		for _, arg := range p.Arguments {
+			args = append(args, &model.MCPPromptArgument{
+				Name:        arg.Name,
+				Description: arg.Description,
+				Required:    arg.Required,
+			})
+		}
+		items = append(items, &model.MCPPrompt{
+			Name:        p.Name,
+			Description: p.Description,
+			Arguments:   args,
+		})
+	}
+	return items
+}
+
+// BuildMCPResourceList 构建资源列表响应
+func BuildMCPResourceList(resources []mcp_client.Resource) []*model.MCPResource {
+	items := make([]*model.MCPResource, 0, len(resources))
+	for _, r := range resources {
+		items = append(items, &model.MCPResource{
+			URI:         r.URI,
+			Name:        r.Name,
+			Description: r.Description,
+			MimeType:    r.MIMEType,
+		})
+	}
+	return items
+}
@@ -23,6 +23,7 @@ func Register(r *server.Hertz) {
 			_v1 := _api.Group("/v1", _v1Mw()...)
 			_v1.POST("/chat", append(_chat0Mw(), api.Chat)...)
 			_chat := _v1.Group("/chat", _chatMw()...)
+			_chat.POST("/approval", append(_chatapprovalMw(), api.ChatApproval)...)
 			_chat.POST("/sse", append(_chatsseMw(), api.ChatSSE)...)
 			_v1.POST("/template", append(_templateMw(), api.Template)...)
 			{
@@ -40,6 +41,11 @@ func Register(r *server.Hertz) {
 					_term.GET("/list", append(_gettermlistMw(), api.GetTermList)...)
 				}
 			}
+			{
+				_mcp := _v1.Group("/mcp", _mcpMw()...)
+				_mcp.GET("/prompts", append(_listmcppromptsMw(), api.ListMCPPrompts)...)
+				_mcp.GET("/resources", append(_listmcpresourcesMw(), api.ListMCPResources)...)
+			}
 			{
 				_schedule := _v1.Group("/schedule", _scheduleMw()...)
 				_schedule.GET("/daily", append(_dailyscheduleMw(), api.DailySchedule)...)
@@ -70,6 +76,7 @@ func Register(r *server.Hertz) {
 				_user.GET("/info", append(_getuserinfoMw(), api.GetUserInfo)...)
 				_user.POST("/login", append(_getlogindataMw(), api.GetLoginData)...)
 				_user.PUT("/setting", append(_updateusersettingMw(), api.UpdateUserSetting)...)
+				_user.GET("/usage", append(_getuserusageMw(), api.GetUserUsage)...)
 			}
 		}
 	}
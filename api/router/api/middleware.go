@@ -3,6 +3,7 @@
 package api
 
 import (
+	handler "github.com/FantasyRL/go-mcp-demo/api/handler/api"
 	"github.com/FantasyRL/go-mcp-demo/api/mw"
 	"github.com/cloudwego/hertz/pkg/app"
 )
@@ -24,6 +25,7 @@ func _v1Mw() []app.HandlerFunc {
 func _chatMw() []app.HandlerFunc {
 	return []app.HandlerFunc{
 		mw.Auth(),
+		mw.Quota(handler.CheckQuota),
 		mw.GetHeaderParams(),
 	}
 }
@@ -31,6 +33,7 @@ func _chatMw() []app.HandlerFunc {
 func _chat0Mw() []app.HandlerFunc {
 	return []app.HandlerFunc{
 		mw.Auth(),
+		mw.Quota(handler.CheckQuota),
 		mw.GetHeaderParams(),
 	}
 }
@@ -54,6 +57,7 @@ func _conversationMw() []app.HandlerFunc {
 func _summarizeconversationMw() []app.HandlerFunc {
 	return []app.HandlerFunc{
 		mw.Auth(),
+		mw.Quota(handler.CheckQuota),
 		mw.GetHeaderParams(),
 	}
 }
@@ -213,7 +217,34 @@ func _scheduleMw() []app.HandlerFunc {
 func _dailyscheduleMw() []app.HandlerFunc {
 	return []app.HandlerFunc{
 		mw.Auth(),
+		mw.Quota(handler.CheckQuota),
 		mw.GetHeaderParams(),
 	}
+}
+
+func _getuserusageMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _chatapprovalMw() []app.HandlerFunc {
+	return nil
+}
+
+func _mcpMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		mw.Auth(),
+		mw.GetHeaderParams(),
+	}
+}
+
+func _listmcppromptsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listmcpresourcesMw() []app.HandlerFunc {
+	// your code...
 	return nil
 }
@@ -3,8 +3,12 @@ package router
 import (
 	"github.com/FantasyRL/go-mcp-demo/api/handler"
 	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/adaptor"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 func customizedRegister(r *server.Hertz) {
 	r.GET("/ping", handler.Ping)
+	// 模型调用重试、熔断器状态等指标
+	r.GET("/metrics", adaptor.HertzHandler(promhttp.Handler()))
 }
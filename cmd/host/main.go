@@ -8,6 +8,7 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/api/handler/api"
 	"github.com/FantasyRL/go-mcp-demo/api/router"
 	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/lifecycle"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
@@ -26,13 +27,15 @@ import (
 var (
 	serviceName = constant.ServiceNameAPI
 	configPath  = flag.String("cfg", "config/config.yaml", "config file path")
+	lc          *lifecycle.Manager
 )
 
 func init() {
 	flag.Parse()
 	config.Load(*configPath, serviceName)
 	logger.Init(serviceName, config.GetLoggerLevel())
-	api.Init()
+	lc = lifecycle.New(serviceName)
+	api.Init(lc)
 }
 
 func main() {
@@ -82,7 +85,14 @@ func main() {
 		}),
 	))
 	router.Register(h)
-	h.Spin()
+
+	// 由 lifecycle 处理 SIGTERM：先停止接收新请求并排空 SSE 流与后台生成，再关闭客户端
+	lc.Go(h.Run)
+	lc.OnDrain(h.Shutdown)
+	lc.SetReady()
+	if err = lc.Wait(); err != nil {
+		logger.Errorf("Api: shutdown: %v", err)
+	}
 }
 
 func recoveryHandler(ctx context.Context, c *app.RequestContext, err interface{}, stack []byte) {
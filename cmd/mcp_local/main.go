@@ -6,8 +6,11 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/internal/mcp"
 	"github.com/FantasyRL/go-mcp-demo/internal/mcp/application"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/lifecycle"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_server"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/prompt_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/resource_set"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
@@ -19,6 +22,7 @@ var (
 	configPath  = flag.String("cfg", "config/config.yaml", "config file path")
 	toolSet     = new(tool_set.ToolSet)
 	promptSet   = new(prompt_set.PromptSet)
+	resourceSet = new(resource_set.ResourceSet)
 )
 
 func init() {
@@ -33,20 +37,23 @@ func init() {
 		application.WithTodoTools(),
 		application.WithCourseTools(),
 	)
-	promptSet = prompt_set.NewPromptSet()
+	promptSet = prompt_set.NewPromptSet(application.WithPrompts())
+	resourceSet = resource_set.NewResourceSet(application.WithUserResources())
 }
 
 func main() {
 	logger.Infof("starting mcp server, transport = %s", config.MCP.Transport)
+	lc := lifecycle.New(serviceName)
+	lc.OnClose("client set", base.GetGlobalClientSet().Close)
 	// 初始化MCP核心业务
-	coreServer := mcp_server.NewCoreServer(config.MCP.ServerName, config.MCP.Transport, toolSet, promptSet)
+	coreServer := mcp_server.NewCoreServer(config.MCP.ServerName, config.MCP.Transport, toolSet, promptSet, resourceSet)
 	// 初始化MCP使用的传输层
 	switch config.MCP.Transport {
 	case constant.MCPTransportStdio:
 		if err := mcp_server.ServeStdio(coreServer); err != nil {
 			logger.Errorf("serve stdio: %v", err)
-			return
 		}
+		_ = lc.Shutdown()
 	// streamable HTTP 启动
 	case constant.MCPTransportHTTP:
 		addr, err := utils.GetAvailablePort()
@@ -55,10 +62,15 @@ func main() {
 			return
 		}
 		logger.Infof("mcp_server: http server listening at %s", addr)
-		if err := mcp_server.NewStreamableHTTPServer(coreServer, serviceName, addr).Start(addr); err != nil {
+		// 收到 SIGTERM 后先从注册中心注销，再排空进行中的工具调用
+		if err := mcp_server.ServeStreamableHTTP(lc, coreServer, serviceName, addr); err != nil {
 			logger.Errorf("serve http: %v", err)
+			_ = lc.Shutdown()
 			return
 		}
+		if err := lc.Wait(); err != nil {
+			logger.Errorf("mcp_server: shutdown: %v", err)
+		}
 	default:
 		logger.Errorf("mcp_server: unknown transport type: %s", config.MCP.Transport)
 		return
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/internal/mcp/application"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/lifecycle"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_server"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
@@ -21,18 +22,19 @@ func init() {
 	flag.Parse()
 	config.Load(*configPath, serviceName)
 	logger.Init(serviceName, config.GetLoggerLevel())
-	toolSet = tool_set.NewToolSet(application.WithTimeTool())
+	toolSet = tool_set.NewToolSet(application.WithTimeTool(), application.WithLongRunningOperationTool())
 }
 
 func main() {
 	logger.Infof("starting mcp server, transport = %s", config.MCP.Transport)
-	coreServer := mcp_server.NewCoreServer(config.MCP.ServerName, config.MCP.Transport, toolSet, nil)
+	lc := lifecycle.New(serviceName)
+	coreServer := mcp_server.NewCoreServer(config.MCP.ServerName, config.MCP.Transport, toolSet, nil, nil)
 	switch config.MCP.Transport {
 	case constant.MCPTransportStdio:
 		if err := mcp_server.ServeStdio(coreServer); err != nil {
 			logger.Errorf("serve stdio: %v", err)
-			return
 		}
+		_ = lc.Shutdown()
 	// streamable HTTP 启动
 	case constant.MCPTransportHTTP:
 		addr, err := utils.GetAvailablePort()
@@ -41,10 +43,15 @@ func main() {
 			return
 		}
 		logger.Infof("mcp_server: http server listening at %s", addr)
-		if err := mcp_server.NewStreamableHTTPServer(coreServer, serviceName, addr).Start(addr); err != nil {
+		// 收到 SIGTERM 后先从注册中心注销，再排空进行中的工具调用
+		if err := mcp_server.ServeStreamableHTTP(lc, coreServer, serviceName, addr); err != nil {
 			logger.Errorf("serve http: %v", err)
+			_ = lc.Shutdown()
 			return
 		}
+		if err := lc.Wait(); err != nil {
+			logger.Errorf("mcp_server: shutdown: %v", err)
+		}
 	default:
 		logger.Errorf("mcp_server: unknown transport type: %s", config.MCP.Transport)
 		return
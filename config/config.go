@@ -7,6 +7,7 @@ import (
 
 var (
 	AiProvider   *AiProviderConfig
+	Quota        *QuotaConfig
 	CLI          *cliConfig
 	MCP          *mcpConfig
 	Server       *server
@@ -32,6 +33,7 @@ func Load(path string, srv string) {
 	}
 
 	AiProvider = &cfg.AiProvider
+	Quota = &cfg.Quota
 	CLI = &cfg.CLI
 	MCP = &cfg.MCP
 	Server = &cfg.Server
@@ -53,8 +55,9 @@ func getService(name string) *service {
 	addrList := runtimeViper.GetStringSlice("services." + name + ".addr")
 
 	return &service{
-		Name:     runtimeViper.GetString("services." + name + ".name"),
-		AddrList: addrList,
-		LB:       runtimeViper.GetBool("services." + name + ".load-balance"),
+		Name:      runtimeViper.GetString("services." + name + ".name"),
+		AddrList:  addrList,
+		LB:        runtimeViper.GetBool("services." + name + ".load-balance"),
+		ProbeAddr: runtimeViper.GetString("services." + name + ".probe-addr"),
 	}
 }
@@ -8,7 +8,14 @@ type server struct {
 	Secret   string `mapstructure:"private-key"`
 	Version  string
 	Name     string
-	LogLevel string `mapstructure:"log-level"`
+	LogLevel string         `mapstructure:"log-level"`
+	Shutdown shutdownConfig `mapstructure:"shutdown"`
+}
+
+// shutdownConfig 收到 SIGTERM 后的优雅关闭，未配置的字段使用 constant 中的默认值
+type shutdownConfig struct {
+	Timeout    time.Duration `mapstructure:"timeout"`     // 排空进行中请求（工具调用、SSE 流、后台生成）的时限，超时后取消剩余请求
+	DrainDelay time.Duration `mapstructure:"drain_delay"` // 注销后仍正常服务的时长，等待 Host 刷新实例列表后再停止接收新请求
 }
 
 type OllamaOptions struct {
@@ -27,7 +34,79 @@ type AiProviderConfig struct {
 	Model   string                 `mapstructure:"model"`    // e.g. qwen3:1.7b
 	Remote  AiProviderRemoteConfig `mapstructure:"remote"`
 	Options OllamaOptions          `mapstructure:"options"`
+	Context AiContextConfig        `mapstructure:"context"`
+	// Providers/Routes 为空时按 mode/base_url/remote 生成单一提供方，兼容旧配置
+	Providers []AiProviderEntry `mapstructure:"providers"`
+	Routes    []AiRouteConfig   `mapstructure:"routes"`
+	Retry     AiRetryConfig     `mapstructure:"retry"`
+	Breaker   AiBreakerConfig   `mapstructure:"breaker"`
+	Cache     AiCacheConfig     `mapstructure:"cache"`
+}
+
+// AiCacheConfig 非流式调用的精确匹配响应缓存（Redis），默认关闭
+type AiCacheConfig struct {
+	TTL             time.Duration `mapstructure:"ttl"`              // 缓存有效期，未配置时使用默认值
+	Routes          []string      `mapstructure:"routes"`           // 开启缓存的路由，如 summarize、schedule
+	ZeroTemperature bool          `mapstructure:"zero_temperature"` // 其余路由上 temperature 为 0 的调用也走缓存
+}
+
+// AiRetryConfig 单个模型上的重试，未配置的字段使用 constant 中的默认值
+type AiRetryConfig struct {
+	MaxAttempts int           `mapstructure:"max_attempts"` // 含首次调用，1 表示不重试
+	BaseDelay   time.Duration `mapstructure:"base_delay"`   // 首次重试的退避时长，之后指数增长并加随机抖动
+	MaxDelay    time.Duration `mapstructure:"max_delay"`    // 单次等待上限，Retry-After 超过该值时直接回退到下一个模型
+}
+
+// AiBreakerConfig 每个提供方的熔断器
+type AiBreakerConfig struct {
+	FailureThreshold int           `mapstructure:"failure_threshold"` // 连续失败多少次后熔断
+	OpenTimeout      time.Duration `mapstructure:"open_timeout"`      // 熔断持续时间，之后半开放行一个探测请求
+}
+
+// AiProviderEntry 具名的模型提供方及其提供的模型
+type AiProviderEntry struct {
+	Name    string          `mapstructure:"name"`
+	Type    string          `mapstructure:"type"` // "openai" | "ollama"
+	BaseURL string          `mapstructure:"base_url"`
+	APIKey  string          `mapstructure:"api_key"`
+	Models  []AiModelConfig `mapstructure:"models"`
+}
+
+// AiModelConfig 模型及其能力，路由时会跳过不满足请求所需能力的模型
+type AiModelConfig struct {
+	Name          string `mapstructure:"name"`
+	Vision        bool   `mapstructure:"vision"`         // 支持图片输入
+	Tools         bool   `mapstructure:"tools"`          // 支持工具调用
+	JSONMode      bool   `mapstructure:"json_mode"`      // 支持 response_format 结构化输出
+	ContextLength int    `mapstructure:"context_length"` // 上下文窗口(token)，0 表示未知
+}
+
+// AiRouteConfig 某类请求的模型选择：按 chain 顺序尝试，遇到 5xx/超时/限流时回退到下一个
+type AiRouteConfig struct {
+	Route string          `mapstructure:"route"` // "chat" | "vision" | "summarize" | "schedule"
+	Chain []AiRouteTarget `mapstructure:"chain"`
+}
+
+type AiRouteTarget struct {
+	Provider string `mapstructure:"provider"`
+	Model    string `mapstructure:"model"`
+}
+
+// AiContextConfig 对话上下文窗口管理，未配置的字段使用 constant 中的默认值
+type AiContextConfig struct {
+	DefaultWindow             int           `mapstructure:"default_window"`                // 未单独配置的模型的上下文窗口(token)
+	Windows                   []ModelWindow `mapstructure:"windows"`                       // 按模型单独配置的上下文窗口
+	ReserveTokens             int           `mapstructure:"reserve_tokens"`                // 为模型输出预留的 token
+	ToolResultMaxChars        int           `mapstructure:"tool_result_max_chars"`         // 单条工具结果进入上下文的最大字符数
+	HistoryToolResultMaxChars int           `mapstructure:"history_tool_result_max_chars"` // 历史轮次中工具结果保留的最大字符数
 }
+
+// ModelWindow 模型名含 "." 时不能作为 viper 的 map key，因此用列表配置
+type ModelWindow struct {
+	Model  string `mapstructure:"model"`
+	Window int    `mapstructure:"window"` // 上下文窗口(token)
+}
+
 type AiProviderRemoteConfig struct {
 	Provider string `mapstructure:"provider"`
 	BaseURL  string `mapstructure:"base_url"`
@@ -35,6 +114,12 @@ type AiProviderRemoteConfig struct {
 	Model    string `mapstructure:"model"`
 }
 
+// QuotaConfig 每个用户的 token 配额，0 表示不限制
+type QuotaConfig struct {
+	Daily   int64 `mapstructure:"daily"`   // 每日 token 上限
+	Monthly int64 `mapstructure:"monthly"` // 每月 token 上限
+}
+
 type cliConfig struct {
 	SystemPrompt string `mapstructure:"system_prompt"`
 	History      bool   `mapstructure:"history"`
@@ -52,11 +137,91 @@ type mcpHTTP struct {
 	BaseURL string `mapstructure:"base_url"` // 直连时使用，如 "http://127.0.0.1:8080/mcp"
 }
 
+// mcpToolCall Host 侧执行工具调用的参数
+type mcpToolCall struct {
+	Concurrency int           `mapstructure:"concurrency"` // 同一轮内并发执行的工具调用上限
+	Timeout     time.Duration `mapstructure:"timeout"`     // 单次工具调用超时
+	Balance     string        `mapstructure:"balance"`     // 同名工具有多个实例时的负载均衡策略
+}
+
+// MCPToolsConfig 多个 MCP 服务的工具如何命名
+type MCPToolsConfig struct {
+	Namespace string         `mapstructure:"namespace"` // "collision"(默认，仅同名冲突时使用 服务__工具) | "always"
+	Aliases   []MCPToolAlias `mapstructure:"aliases"`
+}
+
+// MCPToolAlias 以 Alias 的名字向模型暴露 Tool（服务__工具 形式的全名）
+type MCPToolAlias struct {
+	Alias string `mapstructure:"alias"`
+	Tool  string `mapstructure:"tool"`
+}
+
+// MCPPolicyConfig 工具访问策略：哪些用户/角色/接口可以看到、调用哪些工具
+type MCPPolicyConfig struct {
+	Default         string           `mapstructure:"default"`          // 没有命中任何 allow 列表时的行为："allow"(默认) | "deny"
+	ReloadInterval  time.Duration    `mapstructure:"reload_interval"`  // 数据库中策略的刷新间隔，0 表示只在启动时加载
+	ApprovalTimeout time.Duration    `mapstructure:"approval_timeout"` // 等待用户确认工具调用的时限，超时按失败回填给模型
+	Roles           []ToolPolicyRole `mapstructure:"roles"`
+	Rules           []ToolPolicyRule `mapstructure:"rules"`
+}
+
+// ToolPolicyRole 将用户归入角色
+type ToolPolicyRole struct {
+	Role  string   `mapstructure:"role"`
+	Users []string `mapstructure:"users"`
+}
+
+// ToolPolicyRule 一条策略规则。Users/Roles/Endpoints 为空表示不限制该维度，三者同时满足才命中；
+// 工具名支持通配，如 "fs_*"
+type ToolPolicyRule struct {
+	Name       string              `mapstructure:"name"`
+	Users      []string            `mapstructure:"users"`
+	Roles      []string            `mapstructure:"roles"`
+	Endpoints  []string            `mapstructure:"endpoints"` // 发起对话的接口，见 constant.ToolPolicyEndpoint*
+	Allow      []string            `mapstructure:"allow"`     // 命中规则的 allow 列表取并集，均为空时按 Default 处理
+	Deny       []string            `mapstructure:"deny"`      // 优先于 allow
+	Confirm    []string            `mapstructure:"confirm"`   // 调用前需要用户确认的工具
+	Args       []ToolArgConstraint `mapstructure:"args"`
+	RateLimits []ToolRateLimit     `mapstructure:"rate_limits"`
+}
+
+// ToolArgConstraint 工具参数约束，只检查出现的约束项
+type ToolArgConstraint struct {
+	Tool      string   `mapstructure:"tool"`
+	Arg       string   `mapstructure:"arg"`
+	Required  bool     `mapstructure:"required"`
+	Enum      []string `mapstructure:"enum"`
+	Pattern   string   `mapstructure:"pattern"` // 正则，要求完整匹配
+	Min       *float64 `mapstructure:"min"`
+	Max       *float64 `mapstructure:"max"`
+	MaxLength int      `mapstructure:"max_length"`
+}
+
+// ToolRateLimit 每个用户在 Window 内最多调用 Limit 次 Tools 中的工具（合并计数），Tools 为空表示所有工具
+type ToolRateLimit struct {
+	Tools  []string      `mapstructure:"tools"`
+	Limit  int           `mapstructure:"limit"`
+	Window time.Duration `mapstructure:"window"`
+}
+
+// MCPArgInjection 由 Host 根据已认证的请求上下文填写的工具参数。
+// override 模式下该参数从模型看到的 schema 中移除，模型给出的值一律丢弃
+type MCPArgInjection struct {
+	Tools  []string `mapstructure:"tools"`  // 工具名，支持通配
+	Arg    string   `mapstructure:"arg"`    // 参数名
+	Source string   `mapstructure:"source"` // 取值来源，见 constant.ToolArgSource*
+	Mode   string   `mapstructure:"mode"`   // "override"(默认) | "default"(仅在模型未给出时填写，参数对模型可见但不再必填)
+}
+
 type mcpConfig struct {
-	ServerName string   `mapstructure:"server_name"`
-	Transport  string   `mapstructure:"transport"` // "stdio" | "sse" | "http"
-	Stdio      mcpStdio `mapstructure:"stdio"`
-	HTTP       mcpHTTP  `mapstructure:"http"`
+	ServerName string            `mapstructure:"server_name"`
+	Transport  string            `mapstructure:"transport"` // "stdio" | "sse" | "http"
+	Stdio      mcpStdio          `mapstructure:"stdio"`
+	HTTP       mcpHTTP           `mapstructure:"http"`
+	ToolCall   mcpToolCall       `mapstructure:"tool_call"`
+	Tools      MCPToolsConfig    `mapstructure:"tools"`
+	Policy     MCPPolicyConfig   `mapstructure:"policy"`
+	Inject     []MCPArgInjection `mapstructure:"inject"`
 }
 
 type consulConfig struct {
@@ -69,16 +234,49 @@ type consulConfig struct {
 	Path       string `mapstructure:"path"`       // 例如 "/mcp"
 }
 
+type etcdConfig struct {
+	Endpoints   []string      `mapstructure:"endpoints"`    // 例如 ["127.0.0.1:2379"]
+	Username    string        `mapstructure:"username"`     // 可空
+	Password    string        `mapstructure:"password"`     // 可空
+	Prefix      string        `mapstructure:"prefix"`       // 实例键前缀，默认 /go-mcp-demo/services
+	DialTimeout time.Duration `mapstructure:"dial_timeout"` // 默认 5s
+	LeaseTTL    time.Duration `mapstructure:"lease_ttl"`    // 租约时长，实例失联超过该时间后自动删除，默认 15s
+}
+
+type nacosConfig struct {
+	Address   string `mapstructure:"address"`   // 例如 "http://127.0.0.1:8848"
+	Namespace string `mapstructure:"namespace"` // 命名空间 ID，可空（public）
+	Group     string `mapstructure:"group"`     // 分组，默认 DEFAULT_GROUP
+	Username  string `mapstructure:"username"`  // 开启鉴权时填写
+	Password  string `mapstructure:"password"`  // 开启鉴权时填写
+}
+
+// StaticService 静态配置的一个 MCP 服务端点，同名的多条即同一服务的多个实例
+type StaticService struct {
+	Name   string `mapstructure:"name"`   // 服务名，工具重名时作为命名空间
+	URL    string `mapstructure:"url"`    // 完整的 MCP 地址，如 https://fzuhelper.west2.online/mcp
+	Weight int    `mapstructure:"weight"` // 负载均衡权重，默认 1
+}
+
+type staticConfig struct {
+	Services []StaticService `mapstructure:"services"`
+	File     string          `mapstructure:"file"` // 可选，从该 YAML 文件的 services 读取，文件变更时热更新；同名服务以文件为准
+}
+
 // registryConfig 是“注册中心/地址解析”的顶层入口
-// Provider: "consul" | "static" | "none"（或留空）
-// - 当 Provider=consul 时使用 Consul 子配置
-// - 当 Provider=static 时使用 services.* 的静态地址（见 services 配置）
-// - 当 BaseURL 非空时，优先使用 BaseURL（由 MCP 自身指定）
+// Provider: "consul" | "etcd" | "nacos" | "static" | "none"（或留空）
+// - 当 Provider=consul/etcd/nacos 时使用对应子配置，Static 中的服务（如 fzuhelper）一并聚合
+// - 当 Provider=static 时只聚合 Static 中的服务，无需运行 Consul
+// - 当 Provider=none 时使用 mcp.http.base_url 单点连接
 type registryConfig struct {
-	Provider        string        `mapstructure:"provider"` // "consul" | "none"
+	Provider        string        `mapstructure:"provider"` // "consul" | "etcd" | "nacos" | "static" | "none"
 	Consul          consulConfig  `mapstructure:"consul"`
+	Etcd            etcdConfig    `mapstructure:"etcd"`
+	Nacos           nacosConfig   `mapstructure:"nacos"`
+	Static          staticConfig  `mapstructure:"static"`
 	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
 	ResolveTimeout  time.Duration `mapstructure:"resolve_timeout"`
+	Weight          int           `mapstructure:"weight"` // 本实例注册时写入元信息的负载均衡权重，默认 1
 }
 
 type pgSqlConfig struct {
@@ -90,9 +288,10 @@ type pgSqlConfig struct {
 }
 
 type service struct {
-	Name     string
-	AddrList []string
-	LB       bool `mapstructure:"load-balance"`
+	Name      string
+	AddrList  []string
+	LB        bool   `mapstructure:"load-balance"`
+	ProbeAddr string `mapstructure:"probe-addr"` // 存活/就绪探针的监听地址，为空时不启动探针
 }
 
 type redis struct {
@@ -103,6 +302,7 @@ type redis struct {
 type Config struct {
 	Server     server           `mapstructure:"server"`
 	AiProvider AiProviderConfig `mapstructure:"ai_provider"`
+	Quota      QuotaConfig      `mapstructure:"quota"`
 	CLI        cliConfig        `mapstructure:"cli"`
 	MCP        mcpConfig        `mapstructure:"mcp"`
 	Registry   registryConfig   `mapstructure:"registry"`
@@ -21,6 +21,8 @@ create table conversations (
     messages     jsonb       NOT NULL,
     is_summarized smallint   NOT NULL DEFAULT 0,
     title        varchar(128),
+    compact_summary text     NOT NULL DEFAULT '',
+    compacted_until integer  NOT NULL DEFAULT 0,
     created_at   TIMESTAMP NOT NULL DEFAULT now(),
     updated_at TIMESTAMP(6) WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL,
     deleted_at   TIMESTAMP
@@ -39,6 +41,8 @@ comment on column conversations.user_id is '用户ID';
 comment on column conversations.messages is '对话消息，JSON格式存储';
 comment on column conversations.is_summarized is '是否已生成摘要，0-否，1-是';
 comment on column conversations.title is '对话标题';
+comment on column conversations.compact_summary is '上下文压缩生成的滚动摘要，与用户手动生成的总结分开存放';
+comment on column conversations.compacted_until is '滚动摘要覆盖的消息数，即 messages 中未被覆盖的第一条消息的下标';
 comment on column conversations.created_at is '创建时间';
 comment on column conversations.updated_at is '更新时间';
 comment on column conversations.deleted_at is '删除时间';
@@ -102,3 +106,50 @@ comment on column todolists.category is '待办事项标签';
 comment on column todolists.created_at is '创建时间';
 comment on column todolists.updated_at is '更新时间';
 comment on column todolists.deleted_at is '删除时间';
+
+create table token_usages(
+    id                uuid        PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id           varchar(32) NOT NULL,
+    conversation_id   varchar(64) NOT NULL DEFAULT '',
+    usage_date        date        NOT NULL,
+    prompt_tokens     bigint      NOT NULL DEFAULT 0,
+    completion_tokens bigint      NOT NULL DEFAULT 0,
+    total_tokens      bigint      NOT NULL DEFAULT 0,
+    request_count     integer     NOT NULL DEFAULT 0,
+    created_at        TIMESTAMP   NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+create unique index uk_token_usages_user_conversation_date
+    on token_usages (user_id, conversation_id, usage_date);
+
+comment on table token_usages is 'token 用量表，按用户、对话、日期聚合';
+comment on column token_usages.id is '记录ID';
+comment on column token_usages.user_id is '用户ID';
+comment on column token_usages.conversation_id is '对话ID，非对话场景(如每日日程)为空串';
+comment on column token_usages.usage_date is '统计日期';
+comment on column token_usages.prompt_tokens is '输入 token 数';
+comment on column token_usages.completion_tokens is '输出 token 数';
+comment on column token_usages.total_tokens is '总 token 数';
+comment on column token_usages.request_count is '模型调用次数';
+comment on column token_usages.created_at is '创建时间';
+comment on column token_usages.updated_at is '更新时间';
+
+create table tool_policies(
+    id         uuid        PRIMARY KEY DEFAULT gen_random_uuid(),
+    name       varchar(64) NOT NULL,
+    rule       jsonb       NOT NULL,
+    enabled    boolean     NOT NULL DEFAULT true,
+    created_at TIMESTAMP   NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP(6) WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+create unique index uk_tool_policies_name on tool_policies (name);
+
+comment on table tool_policies is '工具访问策略表，启用的规则追加在配置文件 mcp.policy.rules 之后';
+comment on column tool_policies.id is '策略ID';
+comment on column tool_policies.name is '策略名称';
+comment on column tool_policies.rule is '策略规则，JSON格式存储';
+comment on column tool_policies.enabled is '是否启用';
+comment on column tool_policies.created_at is '创建时间';
+comment on column tool_policies.updated_at is '更新时间';
@@ -19,6 +19,12 @@ struct ChatRequest{
         description:"前端生成的UUID，多轮会话唯一标识",
         type:"string"
     }')
+    4: optional list<string> resources(api.body="resources", openapi.property='{
+        title:"附加资源",
+        description:"附加到本轮的 MCP 资源 URI，取值见 /api/v1/mcp/resources",
+        type:"array",
+        items:{type:"string"}
+    }')
 }(
     openapi.schema='{
         title: "聊天请求",
@@ -62,6 +68,12 @@ struct ChatSSEHandlerRequest{
         description:"前端生成的UUID，多轮会话标识",
         type:"string"
     }')
+    4: optional list<string> resources(api.query="resources", openapi.property='{
+        title:"附加资源",
+        description:"附加到本轮的 MCP 资源 URI，可重复传递，取值见 /api/v1/mcp/resources",
+        type:"array",
+        items:{type:"string"}
+    }')
 }(
      openapi.schema='{
          title: "流式聊天请求",
@@ -166,6 +178,44 @@ struct ListConversationsResponse {
     }'
 )
 
+struct ChatApprovalRequest{
+    1: required string conversation_id(api.body="conversation_id", openapi.property='{
+        title:"对话ID",
+        description:"暂停在待确认工具调用处的对话",
+        type:"string"
+    }')
+    2: bool approved(api.body="approved", openapi.property='{
+        title:"是否批准",
+        description:"true 批准、false 拒绝待确认的工具调用",
+        type:"boolean"
+    }')
+    3: optional list<string> tool_call_ids(api.body="tool_call_ids", openapi.property='{
+        title:"工具调用ID",
+        description:"批准时可只批准其中的调用，其余调用视为拒绝；为空表示全部",
+        type:"array",
+        items: {type: "string"}
+    }')
+    4: optional string reason(api.body="reason", openapi.property='{
+        title:"拒绝原因",
+        description:"拒绝时回填给模型的说明",
+        type:"string"
+    }')
+}(
+     openapi.schema='{
+         title: "工具调用确认请求",
+         description: "批准或拒绝待确认的工具调用，响应为恢复后的流式对话(SSE)",
+         required: ["conversation_id", "approved"]
+     }'
+)
+
+struct ChatApprovalResponse{
+}(
+    openapi.schema='{
+        title:"工具调用确认响应",
+        description:"与流式聊天相同的 SSE 事件流"
+    }'
+)
+
 struct TemplateRequest{
     1: string templateId(api.body="templateId", openapi.property='{
         title: "示范用param",
@@ -312,6 +362,70 @@ struct GetUserInfoResponse {
     }'
 )
 
+struct GetUserUsageRequest {
+}(
+    openapi.schema='{
+        title: "用户用量请求",
+        description: "查询当前用户的 token 用量与配额"
+    }'
+)
+
+struct GetUserUsageResponse {
+    1: model.UserTokenUsage usage(api.body="usage", openapi.property='{
+        title: "用量",
+        description: "今日、本月及本月每日的 token 用量",
+        type: "object"
+    }')
+}(
+    openapi.schema='{
+        title: "用户用量响应",
+        description: "包含 token 用量与配额的响应",
+        required: ["usage"]
+    }'
+)
+
+struct ListMCPPromptsRequest {
+}(
+    openapi.schema='{
+        title: "提示词模板列表请求",
+        description: "获取可作为斜杠命令使用的 MCP 提示词模板"
+    }'
+)
+
+struct ListMCPPromptsResponse {
+    1: list<model.MCPPrompt> prompts(api.body="prompts", openapi.property='{
+        title: "提示词模板列表",
+        type: "array"
+    }')
+}(
+    openapi.schema='{
+        title: "提示词模板列表响应",
+        description: "返回提示词模板列表",
+        required: ["prompts"]
+    }'
+)
+
+struct ListMCPResourcesRequest {
+}(
+    openapi.schema='{
+        title: "资源列表请求",
+        description: "获取当前用户可附加到对话的 MCP 资源"
+    }'
+)
+
+struct ListMCPResourcesResponse {
+    1: list<model.MCPResource> resources(api.body="resources", openapi.property='{
+        title: "资源列表",
+        type: "array"
+    }')
+}(
+    openapi.schema='{
+        title: "资源列表响应",
+        description: "返回资源列表",
+        required: ["resources"]
+    }'
+)
+
 struct CreateTodoRequest {
     1: string title(api.body="title", openapi.property='{
         title: "待办事项标题",
@@ -974,8 +1088,10 @@ struct DailyScheduleResponse {
 service ApiService {
     // 非流式对话
     ChatResponse Chat(1: ChatRequest req)(api.post="/api/v1/chat")
-    // 流式对话
+    // 流式对话，携带 Last-Event-ID 请求头可在断线后续传
     ChatSSEHandlerResponse ChatSSE(1: ChatSSEHandlerRequest req)(api.post="/api/v1/chat/sse")
+    // 批准或拒绝待确认的工具调用，响应为恢复后的流式对话
+    ChatApprovalResponse ChatApproval(1: ChatApprovalRequest req)(api.post="/api/v1/chat/approval")
     // 示例接口 idl写好后运行make hertz-gen-api生成脚手架
     TemplateResponse Template(1: TemplateRequest req)(api.post="/api/v1/template")
     // 获取会话历史
@@ -992,6 +1108,14 @@ service ApiService {
     GetUserInfoResponse GetUserInfo(1: GetUserInfoRequest req)(api.get="/api/v1/user/info")
     // 更新用户设置
     UpdateUserSettingResponse UpdateUserSetting(1: UpdateUserSettingRequest req)(api.put="/api/v1/user/setting")
+    // 获取 token 用量与配额
+    GetUserUsageResponse GetUserUsage(1: GetUserUsageRequest req)(api.get="/api/v1/user/usage")
+
+    // MCP 提示词与资源
+    // 获取提示词模板列表，对话中以 /name 调用
+    ListMCPPromptsResponse ListMCPPrompts(1: ListMCPPromptsRequest req)(api.get="/api/v1/mcp/prompts")
+    // 获取可附加到对话的资源列表
+    ListMCPResourcesResponse ListMCPResources(1: ListMCPResourcesRequest req)(api.get="/api/v1/mcp/resources")
     
     // 待办事项管理
     // 创建待办事项
@@ -220,3 +220,385 @@ struct TermInfo {
         type: "array"
     }')
 }
+
+// ===== 流式对话 SSE 事件负载，SSE 的 event 字段即事件名，version 用于前端兼容判断 =====
+struct ChatStreamToolCall {
+    1: string id(api.body="id", openapi.property='{
+        title: "工具调用ID",
+        description: "模型生成的工具调用ID",
+        type: "string"
+    }')
+    2: string name(api.body="name", openapi.property='{
+        title: "工具名",
+        description: "被调用的工具名称",
+        type: "string"
+    }')
+    3: string arguments(api.body="arguments", openapi.property='{
+        title: "调用参数",
+        description: "JSON 编码的工具调用参数",
+        type: "string"
+    }')
+}
+
+struct ChatStreamDeltaEvent {
+    1: i32 version(api.body="version", openapi.property='{
+        title: "负载版本",
+        type: "integer"
+    }')
+    2: string text(api.body="text", openapi.property='{
+        title: "内容增量",
+        description: "模型输出的文本片段",
+        type: "string"
+    }')
+}(
+    openapi.schema='{
+        title: "delta 事件",
+        description: "模型内容增量",
+        required: ["version", "text"]
+    }'
+)
+
+struct ChatStreamStartToolCallEvent {
+    1: i32 version(api.body="version", openapi.property='{
+        title: "负载版本",
+        type: "integer"
+    }')
+    2: i32 round(api.body="round", openapi.property='{
+        title: "轮次",
+        description: "工具调用轮次，从 0 开始",
+        type: "integer"
+    }')
+    3: list<ChatStreamToolCall> tool_calls(api.body="tool_calls", openapi.property='{
+        title: "工具调用列表",
+        description: "本轮模型请求的全部工具调用",
+        type: "array"
+    }')
+}(
+    openapi.schema='{
+        title: "start_tool_call 事件",
+        description: "模型决定调用工具，本轮即将开始执行",
+        required: ["version", "round", "tool_calls"]
+    }'
+)
+
+struct ChatStreamToolCallEvent {
+    1: i32 version(api.body="version", openapi.property='{
+        title: "负载版本",
+        type: "integer"
+    }')
+    2: i32 round(api.body="round", openapi.property='{
+        title: "轮次",
+        type: "integer"
+    }')
+    3: string id(api.body="id", openapi.property='{
+        title: "工具调用ID",
+        type: "string"
+    }')
+    4: string name(api.body="name", openapi.property='{
+        title: "工具名",
+        type: "string"
+    }')
+    5: string arguments(api.body="arguments", openapi.property='{
+        title: "调用参数",
+        description: "JSON 编码的实际调用参数（已完成服务端参数注入）",
+        type: "string"
+    }')
+}(
+    openapi.schema='{
+        title: "tool_call 事件",
+        description: "单个工具开始执行",
+        required: ["version", "round", "id", "name", "arguments"]
+    }'
+)
+
+struct ChatStreamToolResultEvent {
+    1: i32 version(api.body="version", openapi.property='{
+        title: "负载版本",
+        type: "integer"
+    }')
+    2: i32 round(api.body="round", openapi.property='{
+        title: "轮次",
+        type: "integer"
+    }')
+    3: string id(api.body="id", openapi.property='{
+        title: "工具调用ID",
+        type: "string"
+    }')
+    4: string name(api.body="name", openapi.property='{
+        title: "工具名",
+        type: "string"
+    }')
+    5: string result(api.body="result", openapi.property='{
+        title: "调用结果",
+        description: "工具返回的文本结果，失败时为错误信息",
+        type: "string"
+    }')
+    6: optional bool is_error(api.body="is_error", openapi.property='{
+        title: "是否失败",
+        description: "工具报告失败或调用出错时为 true，被策略拒绝不算失败",
+        type: "boolean"
+    }')
+}(
+    openapi.schema='{
+        title: "tool_result 事件",
+        description: "单个工具执行完成",
+        required: ["version", "round", "id", "name", "result"]
+    }'
+)
+
+struct ChatStreamToolProgressEvent {
+    1: i32 version(api.body="version", openapi.property='{
+        title: "负载版本",
+        type: "integer"
+    }')
+    2: i32 round(api.body="round", openapi.property='{
+        title: "轮次",
+        type: "integer"
+    }')
+    3: string id(api.body="id", openapi.property='{
+        title: "工具调用ID",
+        type: "string"
+    }')
+    4: string name(api.body="name", openapi.property='{
+        title: "工具名",
+        type: "string"
+    }')
+    5: double progress(api.body="progress", openapi.property='{
+        title: "当前进度",
+        description: "单调递增，单位由工具决定",
+        type: "number"
+    }')
+    6: optional double total(api.body="total", openapi.property='{
+        title: "总量",
+        description: "工具未给出总量时不返回",
+        type: "number"
+    }')
+    7: optional string message(api.body="message", openapi.property='{
+        title: "进度说明",
+        type: "string"
+    }')
+}(
+    openapi.schema='{
+        title: "tool_progress 事件",
+        description: "工具执行中上报的进度，由 MCP 服务端的 notifications/progress 转发，可能出现多次",
+        required: ["version", "round", "id", "name", "progress"]
+    }'
+)
+
+struct ChatStreamApprovalRequiredEvent {
+    1: i32 version(api.body="version", openapi.property='{
+        title: "负载版本",
+        type: "integer"
+    }')
+    2: i32 round(api.body="round", openapi.property='{
+        title: "轮次",
+        type: "integer"
+    }')
+    3: list<ChatStreamToolCall> tool_calls(api.body="tool_calls", openapi.property='{
+        title: "待确认的工具调用",
+        description: "需要用户确认后才会执行的工具调用及其参数",
+        type: "array"
+    }')
+    4: i64 expires_at(api.body="expires_at", openapi.property='{
+        title: "确认截止时间",
+        description: "Unix 毫秒时间戳，超时未确认的调用按失败回填给模型",
+        type: "integer"
+    }')
+}(
+    openapi.schema='{
+        title: "approval_required 事件",
+        description: "生成暂停，等待用户通过 /api/v1/chat/approval 批准或拒绝工具调用，随后会收到 reason 为 approval_required 的 done 事件",
+        required: ["version", "round", "tool_calls", "expires_at"]
+    }'
+)
+
+struct ChatStreamUsageEvent {
+    1: i32 version(api.body="version", openapi.property='{
+        title: "负载版本",
+        type: "integer"
+    }')
+    2: i64 prompt_tokens(api.body="prompt_tokens", openapi.property='{
+        title: "输入 token 数",
+        type: "integer"
+    }')
+    3: i64 completion_tokens(api.body="completion_tokens", openapi.property='{
+        title: "输出 token 数",
+        type: "integer"
+    }')
+    4: i64 total_tokens(api.body="total_tokens", openapi.property='{
+        title: "总 token 数",
+        type: "integer"
+    }')
+}(
+    openapi.schema='{
+        title: "usage 事件",
+        description: "本次对话所有模型调用的 token 用量合计，在 done 之前发送",
+        required: ["version", "prompt_tokens", "completion_tokens", "total_tokens"]
+    }'
+)
+
+struct ChatStreamDoneEvent {
+    1: i32 version(api.body="version", openapi.property='{
+        title: "负载版本",
+        type: "integer"
+    }')
+    2: string reason(api.body="reason", openapi.property='{
+        title: "结束原因",
+        description: "completed / tool_round_limit / no_tool_details / empty_choices / approval_required",
+        type: "string"
+    }')
+}(
+    openapi.schema='{
+        title: "done 事件",
+        description: "流正常结束",
+        required: ["version", "reason"]
+    }'
+)
+
+struct ChatStreamErrorEvent {
+    1: i32 version(api.body="version", openapi.property='{
+        title: "负载版本",
+        type: "integer"
+    }')
+    2: i64 code(api.body="code", openapi.property='{
+        title: "错误码",
+        description: "与普通接口一致的业务错误码",
+        type: "integer"
+    }')
+    3: string message(api.body="message", openapi.property='{
+        title: "错误信息",
+        type: "string"
+    }')
+}(
+    openapi.schema='{
+        title: "error 事件",
+        description: "生成出错，流随之结束",
+        required: ["version", "code", "message"]
+    }'
+)
+
+struct ChatStreamHeartbeatEvent {
+    1: i32 version(api.body="version", openapi.property='{
+        title: "负载版本",
+        type: "integer"
+    }')
+    2: i64 timestamp(api.body="timestamp", openapi.property='{
+        title: "时间戳",
+        description: "服务端发送时的 Unix 毫秒时间戳",
+        type: "integer"
+    }')
+}(
+    openapi.schema='{
+        title: "heartbeat 事件",
+        description: "空闲时定期发送的保活事件，不携带 id，不参与续传",
+        required: ["version", "timestamp"]
+    }'
+)
+
+// ===== token 用量 =====
+struct TokenUsageStat {
+    1: i64 prompt_tokens(api.body="prompt_tokens", openapi.property='{
+        title: "输入 token 数",
+        type: "integer"
+    }')
+    2: i64 completion_tokens(api.body="completion_tokens", openapi.property='{
+        title: "输出 token 数",
+        type: "integer"
+    }')
+    3: i64 total_tokens(api.body="total_tokens", openapi.property='{
+        title: "总 token 数",
+        type: "integer"
+    }')
+    4: i64 request_count(api.body="request_count", openapi.property='{
+        title: "模型调用次数",
+        type: "integer"
+    }')
+}
+
+struct DailyTokenUsage {
+    1: string date(api.body="date", openapi.property='{
+        title: "日期",
+        description: "格式 YYYY-MM-DD",
+        type: "string"
+    }')
+    2: TokenUsageStat usage(api.body="usage", openapi.property='{
+        title: "当日用量",
+        type: "object"
+    }')
+}
+
+struct UserTokenUsage {
+    1: TokenUsageStat today(api.body="today", openapi.property='{
+        title: "今日用量",
+        type: "object"
+    }')
+    2: TokenUsageStat month(api.body="month", openapi.property='{
+        title: "本月用量",
+        type: "object"
+    }')
+    3: i64 daily_limit(api.body="daily_limit", openapi.property='{
+        title: "每日配额",
+        description: "每日 token 上限，0 表示不限制",
+        type: "integer"
+    }')
+    4: i64 monthly_limit(api.body="monthly_limit", openapi.property='{
+        title: "每月配额",
+        description: "每月 token 上限，0 表示不限制",
+        type: "integer"
+    }')
+    5: list<DailyTokenUsage> days(api.body="days", openapi.property='{
+        title: "本月每日用量",
+        type: "array"
+    }')
+}
+
+struct MCPPromptArgument {
+    1: string name(api.body="name", openapi.property='{
+        title: "参数名",
+        type: "string"
+    }')
+    2: string description(api.body="description", openapi.property='{
+        title: "参数说明",
+        type: "string"
+    }')
+    3: bool required(api.body="required", openapi.property='{
+        title: "是否必填",
+        type: "boolean"
+    }')
+}
+
+struct MCPPrompt {
+    1: string name(api.body="name", openapi.property='{
+        title: "模板名",
+        description: "对话中以 /name 调用",
+        type: "string"
+    }')
+    2: string description(api.body="description", openapi.property='{
+        title: "模板说明",
+        type: "string"
+    }')
+    3: list<MCPPromptArgument> arguments(api.body="arguments", openapi.property='{
+        title: "参数列表",
+        description: "以 key=value 传递，其余文本填入第一个未填写的参数",
+        type: "array"
+    }')
+}
+
+struct MCPResource {
+    1: string uri(api.body="uri", openapi.property='{
+        title: "资源URI",
+        type: "string"
+    }')
+    2: string name(api.body="name", openapi.property='{
+        title: "资源名",
+        type: "string"
+    }')
+    3: string description(api.body="description", openapi.property='{
+        title: "资源说明",
+        type: "string"
+    }')
+    4: string mime_type(api.body="mime_type", openapi.property='{
+        title: "MIME 类型",
+        type: "string"
+    }')
+}
@@ -0,0 +1,761 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/api/model/model"
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_policy"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_schema"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/bytedance/sonic"
+)
+
+const maxToolRounds = 10 // 防御性上限，避免死循环
+
+// Agent 结束原因，流式接口会原样放进 done 事件的 reason 字段
+const (
+	agentStopCompleted      = "completed"
+	agentStopToolRoundLimit = "tool_round_limit"
+	agentStopNoToolDetails  = "no_tool_details"
+	agentStopEmptyChoices   = "empty_choices"
+	agentStopApproval       = "approval_required" // 有工具调用等待用户确认，见 Agent.Resume
+)
+
+// ToolPolicy 调用方对本次对话的额外工具限制（如 vision 模型不支持工具），
+// 与配置/数据库中的声明式策略（见 tool_policy.Engine）同时生效
+type ToolPolicy func(name string) bool
+
+// ArgInjector 在工具调用前改写参数，用于注入/覆盖模型不应自行决定的字段
+type ArgInjector func(ctx context.Context, name string, args map[string]any)
+
+// EventSink 接收 Agent 运行过程中的事件（delta/tool_call/tool_result...），一般对接 SSE
+type EventSink func(event string, v any) error
+
+// AllowTools 只放行给定名称的工具
+func AllowTools(names ...string) ToolPolicy {
+	return func(name string) bool {
+		return slices.Contains(names, name)
+	}
+}
+
+// DenyTools 屏蔽给定名称的工具，其余全部放行
+func DenyTools(names ...string) ToolPolicy {
+	return func(name string) bool {
+		return !slices.Contains(names, name)
+	}
+}
+
+// DenyAllTools 不向模型暴露任何工具
+func DenyAllTools(string) bool {
+	return false
+}
+
+// InjectArg 对指定工具强制写入 key=value
+func InjectArg(key string, value any, tools ...string) ArgInjector {
+	return func(_ context.Context, name string, args map[string]any) {
+		if slices.Contains(tools, name) {
+			args[key] = value
+		}
+	}
+}
+
+// DefaultArg 对指定工具在 key 缺失时写入默认值
+func DefaultArg(key string, value any, tools ...string) ArgInjector {
+	return func(_ context.Context, name string, args map[string]any) {
+		if !slices.Contains(tools, name) {
+			return
+		}
+		if _, ok := args[key]; !ok {
+			args[key] = value
+		}
+	}
+}
+
+// Agent 统一的工具调用编排循环：模型生成 -> 执行 tool_calls -> 结果回填 -> 再生成，
+// 流式/非流式对话、每日日程、本地模型对话都经由它完成多轮工具调用
+type Agent struct {
+	host            *Host
+	route           string // 模型路由，见 constant.AiRoute*
+	model           string // 非空时固定使用该模型，不走路由
+	maxRounds       int
+	stream          bool
+	failOnToolError bool
+	toolConcurrency int           // 同一轮内并发执行的工具调用上限
+	toolTimeout     time.Duration // 单次工具调用超时
+	policy          ToolPolicy
+	endpoint        string // 声明式工具策略中的接口，见 constant.ToolPolicyEndpoint*
+	injectors       []ArgInjector
+	sink            EventSink
+	sinkMu          sync.Mutex // 并发工具调用会同时推送事件，SSE writer 不是并发安全的
+	usageUserID     string     // 用量记账的用户，为空时不记账
+	usageConvID     string
+
+	schemaOnce sync.Once
+	schemas    map[string]map[string]any // 工具名 -> InputSchema，首次调用工具时载入
+
+	approval        bool            // 需要确认的工具调用是否暂停等待用户决定，否则直接拒绝
+	approvalTimeout time.Duration   // 等待用户确认的时限
+	approved        map[string]bool // 用户已批准的 tool_call_id
+}
+
+type AgentOption func(a *Agent)
+
+// AgentResult Agent 一次运行的产物
+type AgentResult struct {
+	Content  string                    // 模型最终回答
+	Reason   string                    // 结束原因，见 agentStop*
+	Messages []ai_provider.ChatMessage // 运行结束后的完整历史（含传入部分）
+	Usage    AgentUsage                // 本次运行所有模型调用的 token 用量合计
+
+	// 以下仅在 Reason 为 approval_required 时有效，恢复时原样交给 Resume
+	Round           int                        // 暂停时所在轮次
+	Pending         []ai_provider.ChatToolCall // 等待用户确认的工具调用
+	PendingDeadline time.Time                  // 确认截止时间
+	PendingImages   []ai_provider.ChatMessage  // 同一轮已执行调用返回的图片，恢复时跟在本轮全部工具结果之后
+}
+
+// AgentUsage token 用量
+type AgentUsage struct {
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+}
+
+func (u *AgentUsage) add(cu ai_provider.ChatUsage) {
+	u.PromptTokens += cu.PromptTokens
+	u.CompletionTokens += cu.CompletionTokens
+	u.TotalTokens += cu.TotalTokens
+}
+
+// WithRoute 按请求类型选择模型路由（含回退链）
+func WithRoute(route string) AgentOption {
+	return func(a *Agent) {
+		a.route = route
+	}
+}
+
+// WithModel 固定使用某个模型，不走路由
+func WithModel(model string) AgentOption {
+	return func(a *Agent) {
+		a.model = model
+	}
+}
+
+// WithMaxRounds 设置工具调用轮次上限
+func WithMaxRounds(n int) AgentOption {
+	return func(a *Agent) {
+		if n > 0 {
+			a.maxRounds = n
+		}
+	}
+}
+
+// WithStreaming 使用流式接口生成，delta 会实时推给 EventSink
+func WithStreaming() AgentOption {
+	return func(a *Agent) {
+		a.stream = true
+	}
+}
+
+// WithToolPolicy 设置工具可见性策略
+func WithToolPolicy(policy ToolPolicy) AgentOption {
+	return func(a *Agent) {
+		a.policy = policy
+	}
+}
+
+// WithPolicyEndpoint 设置发起对话的接口，用于匹配工具策略中按接口生效的规则
+func WithPolicyEndpoint(endpoint string) AgentOption {
+	return func(a *Agent) {
+		a.endpoint = endpoint
+	}
+}
+
+// WithArgInjectors 追加参数注入器，按顺序执行
+func WithArgInjectors(injectors ...ArgInjector) AgentOption {
+	return func(a *Agent) {
+		a.injectors = append(a.injectors, injectors...)
+	}
+}
+
+// WithEventSink 设置事件接收方
+func WithEventSink(sink EventSink) AgentOption {
+	return func(a *Agent) {
+		a.sink = sink
+	}
+}
+
+// WithToolConcurrency 设置同一轮内工具调用的并发上限，1 表示串行执行
+func WithToolConcurrency(n int) AgentOption {
+	return func(a *Agent) {
+		if n > 0 {
+			a.toolConcurrency = n
+		}
+	}
+}
+
+// WithToolTimeout 设置单次工具调用超时
+func WithToolTimeout(d time.Duration) AgentOption {
+	return func(a *Agent) {
+		if d > 0 {
+			a.toolTimeout = d
+		}
+	}
+}
+
+// WithUsageAccount 将每次模型调用的 token 用量记到该用户/对话名下
+func WithUsageAccount(userID, conversationID string) AgentOption {
+	return func(a *Agent) {
+		a.usageUserID = userID
+		a.usageConvID = conversationID
+	}
+}
+
+// WithApproval 需要确认的工具调用会暂停运行并推送 approval_required 事件，
+// 由调用方保存 AgentResult 并在用户决定后调用 Resume；未设置时这类调用直接拒绝
+func WithApproval() AgentOption {
+	return func(a *Agent) {
+		a.approval = true
+	}
+}
+
+// WithFailOnToolError 工具调用失败时直接中止，而不是把错误回填给模型
+func WithFailOnToolError() AgentOption {
+	return func(a *Agent) {
+		a.failOnToolError = true
+	}
+}
+
+func NewAgent(h *Host, opts ...AgentOption) *Agent {
+	a := &Agent{
+		host:            h,
+		route:           constant.AiRouteChat,
+		maxRounds:       maxToolRounds,
+		toolConcurrency: constant.MCPDefaultToolConcurrency,
+		toolTimeout:     constant.MCPDefaultCallTimeout,
+		approvalTimeout: constant.ToolApprovalDefaultTimeout,
+	}
+	if config.MCP.ToolCall.Concurrency > 0 {
+		a.toolConcurrency = config.MCP.ToolCall.Concurrency
+	}
+	if config.MCP.ToolCall.Timeout > 0 {
+		a.toolTimeout = config.MCP.ToolCall.Timeout
+	}
+	if config.MCP.Policy.ApprovalTimeout > 0 {
+		a.approvalTimeout = config.MCP.Policy.ApprovalTimeout
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// agentTurn 一轮模型生成的结果
+type agentTurn struct {
+	content   string
+	toolCalls []ai_provider.ChatToolCall
+	needTools bool
+	empty     bool
+	usage     ai_provider.ChatUsage
+}
+
+// Run 在 hist 的基础上执行完整的工具调用循环，hist 最后一条一般是本轮的用户消息
+func (a *Agent) Run(ctx context.Context, hist []ai_provider.ChatMessage) (*AgentResult, error) {
+	return a.loop(ctx, hist, 0)
+}
+
+// Resume 从 approval_required 暂停处继续：decide 对每个待确认调用返回 nil 表示批准，
+// 否则返回回填给模型的拒绝说明；全部结果回填后进入下一轮生成。
+// hist/round/pending/images 即暂停时 AgentResult 的 Messages/Round/Pending/PendingImages
+func (a *Agent) Resume(
+	ctx context.Context,
+	hist []ai_provider.ChatMessage,
+	round int,
+	pending []ai_provider.ChatToolCall,
+	images []ai_provider.ChatMessage,
+	decide func(tc ai_provider.ChatToolCall) *tool_policy.Denial,
+) (*AgentResult, error) {
+	if a.approved == nil {
+		a.approved = make(map[string]bool, len(pending))
+	}
+	denials := make(map[string]*tool_policy.Denial, len(pending))
+	var approved []ai_provider.ChatToolCall
+	for _, tc := range pending {
+		if d := decide(tc); d != nil {
+			denials[tc.ID] = d
+			continue
+		}
+		a.approved[tc.ID] = true
+		approved = append(approved, tc)
+	}
+
+	outs, err := a.callTools(ctx, round, approved)
+	if err != nil {
+		return nil, err
+	}
+	images = append(images, a.toolImages(approved, outs)...)
+	for _, tc := range pending {
+		var out string
+		if d, ok := denials[tc.ID]; ok {
+			logger.Infof("agent: tool %s not approved: %v", tc.Name, d)
+			out = d.JSON()
+			a.emitToolResult(round, tc, out, false)
+		} else {
+			out, outs = outs[0].text, outs[1:]
+		}
+		hist = append(hist, ai_provider.ToolMessage(out, tc.ID))
+	}
+	hist = append(hist, images...)
+	return a.loop(ctx, hist, round)
+}
+
+// loop 工具调用循环，round 为已经完成的轮次
+func (a *Agent) loop(ctx context.Context, hist []ai_provider.ChatMessage, round int) (*AgentResult, error) {
+	tools := a.tools(ctx)
+
+	var usage AgentUsage
+	// finish 生成最终结果，并在流结束(done)之前推送本次运行的用量
+	finish := func(content, reason string) *AgentResult {
+		a.emit(constant.SSEEventUsage, &model.ChatStreamUsageEvent{
+			Version:          constant.SSEPayloadVersion,
+			PromptTokens:     usage.PromptTokens,
+			CompletionTokens: usage.CompletionTokens,
+			TotalTokens:      usage.TotalTokens,
+		})
+		return &AgentResult{Content: content, Reason: reason, Messages: hist, Usage: usage}
+	}
+
+	for {
+		round++
+		if round > a.maxRounds {
+			return finish("", agentStopToolRoundLimit), nil
+		}
+
+		params := &ai_provider.ChatParams{
+			Model:    a.model,
+			Messages: hist,
+			Tools:    tools,
+		}
+		applySamplingOptions(params)
+
+		var turn *agentTurn
+		var err error
+		if a.stream {
+			turn, err = a.streamTurn(ctx, round, params)
+		} else {
+			turn, err = a.completeTurn(ctx, params)
+		}
+		if err != nil {
+			return nil, err
+		}
+		usage.add(turn.usage)
+		a.host.recordUsage(ctx, a.usageUserID, a.usageConvID, turn.usage)
+		if turn.empty {
+			return finish("", agentStopEmptyChoices), nil
+		}
+
+		// 本轮不需要工具，说明模型已经给出最终答案
+		if !turn.needTools {
+			if turn.content != "" {
+				hist = append(hist, ai_provider.AssistantMessage(turn.content))
+			}
+			return finish(turn.content, agentStopCompleted), nil
+		}
+		// 偶发兜底：标记需要工具但没聚合到（理论上不会发生）
+		if len(turn.toolCalls) == 0 {
+			return finish(turn.content, agentStopNoToolDetails), nil
+		}
+
+		// tool 结果之前需要一条带 tool_calls 的 assistant 消息
+		hist = append(hist, ai_provider.AssistantMessage(turn.content, turn.toolCalls...))
+
+		// 并发执行（可能多个）工具调用，然后按原顺序将每个工具结果以 ToolMessage 落历史；
+		// 需要用户确认的调用先挂起，其余照常执行
+		calls, pending := a.partitionApproval(ctx, turn.toolCalls)
+		outs, err := a.callTools(ctx, round, calls)
+		if err != nil {
+			return nil, err
+		}
+		for i, tc := range calls {
+			// 工具结果回模型（重要）：必须带对应的 tool_call_id
+			hist = append(hist, ai_provider.ToolMessage(outs[i].text, tc.ID))
+		}
+		if len(pending) > 0 {
+			// 待确认调用的结果要紧跟在已执行调用之后，已执行调用的图片留到恢复时再插入
+			deadline := time.Now().Add(a.approvalTimeout)
+			a.emit(constant.SSEEventApproval, &model.ChatStreamApprovalRequiredEvent{
+				Version:   constant.SSEPayloadVersion,
+				Round:     int32(round),
+				ToolCalls: streamToolCalls(pending),
+				ExpiresAt: deadline.UnixMilli(),
+			})
+			res := finish(turn.content, agentStopApproval)
+			res.Round, res.Pending, res.PendingDeadline = round, pending, deadline
+			res.PendingImages = a.toolImages(calls, outs)
+			return res, nil
+		}
+		hist = append(hist, a.toolImages(calls, outs)...)
+		// 循环进入下一轮：模型会在新的上下文（含工具结果）上继续生成
+	}
+}
+
+// tools 按策略过滤后的工具定义
+func (a *Agent) tools(ctx context.Context) []ai_provider.ChatTool {
+	allTools := a.host.mcpCli.ConvertTools()
+	subject := a.toolSubject(ctx)
+	tools := make([]ai_provider.ChatTool, 0, len(allTools))
+	for _, tool := range allTools {
+		id := a.toolIdentity(tool.Name)
+		if a.policy != nil && !a.policy(id.Name) {
+			continue
+		}
+		if !a.host.toolPolicy.Visible(subject, id) {
+			continue
+		}
+		// 由 Host 注入的参数不让模型看到，模型也就无从伪造
+		tool.Parameters = argInjector().Schema(id, tool.Parameters)
+		tools = append(tools, tool)
+	}
+	return tools
+}
+
+// completeTurn 非流式生成一轮
+func (a *Agent) completeTurn(ctx context.Context, params *ai_provider.ChatParams) (*agentTurn, error) {
+	resp, err := a.host.aiProviderCli.Complete(ctx, a.route, params)
+	if err != nil {
+		logger.Errorf("agent: Complete API error: %v", err)
+		return nil, err
+	}
+	if resp.FinishReason == "" && resp.Content == "" && len(resp.ToolCalls) == 0 {
+		logger.Errorf("agent: no choices in response")
+		return &agentTurn{empty: true, usage: resp.Usage}, nil
+	}
+
+	turn := &agentTurn{content: resp.Content, usage: resp.Usage}
+	if resp.FinishReason == ai_provider.FinishReasonToolCalls && len(resp.ToolCalls) > 0 {
+		turn.needTools = true
+		turn.toolCalls = resp.ToolCalls
+	}
+	return turn, nil
+}
+
+// streamTurn 流式生成一轮：边流边推，若需要工具则在本轮流结束后交给调用方执行
+func (a *Agent) streamTurn(ctx context.Context, round int, params *ai_provider.ChatParams) (*agentTurn, error) {
+	turn := new(agentTurn)
+	finished := false
+	err := a.host.aiProviderCli.CompleteStream(ctx, a.route, params, func(chunk *ai_provider.ChatChunk) error {
+		if chunk.Usage != nil {
+			turn.usage = *chunk.Usage
+		}
+		if chunk.FinishReason != "" {
+			finished = true
+		}
+		if s := chunk.Content; s != "" {
+			turn.content += s
+			a.emit(constant.SSEEventDelta, &model.ChatStreamDeltaEvent{
+				Version: constant.SSEPayloadVersion,
+				Text:    s,
+			})
+		}
+		// 工具调用结束标志：结束帧 finish_reason = "tool_calls"，并带上聚合好的 tool_calls
+		if chunk.FinishReason == ai_provider.FinishReasonToolCalls {
+			turn.needTools = true
+			turn.toolCalls = chunk.ToolCalls
+			if len(chunk.ToolCalls) > 0 {
+				a.emit(constant.SSEEventStartToolCall, &model.ChatStreamStartToolCallEvent{
+					Version:   constant.SSEPayloadVersion,
+					Round:     int32(round),
+					ToolCalls: streamToolCalls(chunk.ToolCalls),
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 与非流式一致：既没有内容也没有结束帧视为没有 choices
+	if !finished && turn.content == "" {
+		logger.Errorf("agent: no choices in stream")
+		turn.empty = true
+	}
+	return turn, nil
+}
+
+// streamToolCalls 将模型的 tool_calls 转成 SSE 事件负载
+func streamToolCalls(calls []ai_provider.ChatToolCall) []*model.ChatStreamToolCall {
+	out := make([]*model.ChatStreamToolCall, 0, len(calls))
+	for _, tc := range calls {
+		out = append(out, &model.ChatStreamToolCall{
+			ID:        tc.ID,
+			Name:      tc.Name,
+			Arguments: tc.Arguments,
+		})
+	}
+	return out
+}
+
+// partitionApproval 将需要用户确认的调用挑出来，未开启确认流程时全部照常执行（在 callTool 中拒绝）
+func (a *Agent) partitionApproval(ctx context.Context, calls []ai_provider.ChatToolCall) (run, pending []ai_provider.ChatToolCall) {
+	if !a.approval {
+		return calls, nil
+	}
+	subject := a.toolSubject(ctx)
+	for _, tc := range calls {
+		if a.needsApproval(subject, tc) {
+			pending = append(pending, tc)
+		} else {
+			run = append(run, tc)
+		}
+	}
+	return run, pending
+}
+
+// needsApproval 调用本身可以执行、但需要先得到用户确认
+func (a *Agent) needsApproval(subject tool_policy.Subject, tc ai_provider.ChatToolCall) bool {
+	tool := a.toolIdentity(tc.Name)
+	if a.approved[tc.ID] || (a.policy != nil && !a.policy(tool.Name)) {
+		return false
+	}
+	return a.host.toolPolicy.Visible(subject, tool) && a.host.toolPolicy.RequiresApproval(subject, tool)
+}
+
+// toolIdentity 将模型使用的工具名（可能带服务前缀或别名）解析为原始的 服务 + 工具，
+// 策略、注入与调用方限制都按它匹配；客户端不认识的名字（如模型臆造）按 服务__工具 拆分
+func (a *Agent) toolIdentity(name string) tool_policy.Tool {
+	if service, tool, ok := a.host.mcpCli.Resolve(name); ok {
+		return tool_policy.Tool{Exposed: name, Service: service, Name: tool}
+	}
+	return tool_policy.ParseTool(name)
+}
+
+// toolOutput 单个工具调用回填给模型的内容
+type toolOutput struct {
+	text   string
+	images []ai_provider.ChatImage
+}
+
+// callTools 并发执行同一轮的所有工具调用，返回值与 calls 一一对应
+func (a *Agent) callTools(ctx context.Context, round int, calls []ai_provider.ChatToolCall) ([]toolOutput, error) {
+	outs := make([]toolOutput, len(calls))
+	errs := make([]error, len(calls))
+
+	// failOnToolError 时任一调用失败即取消其余调用
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// 按调用顺序占用并发名额，串行执行时也保持模型给出的顺序
+	sem := make(chan struct{}, a.toolConcurrency)
+	var wg sync.WaitGroup
+	for i, tc := range calls {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, tc ai_provider.ChatToolCall) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			outs[i], errs[i] = a.callTool(ctx, round, tc)
+			if errs[i] != nil {
+				cancel()
+			}
+		}(i, tc)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return outs, nil
+}
+
+// callTool 执行单个工具调用，返回回填给模型的内容
+func (a *Agent) callTool(ctx context.Context, round int, tc ai_provider.ChatToolCall) (toolOutput, error) {
+	name := tc.Name
+	args, invalid := a.toolArgs(tc)
+
+	// 日志与事件只展示模型给出的参数，注入的 cookie 等不外泄
+	logger.Infof("agent: calling tool %s with args %v", name, args)
+	argsJSON, _ := sonic.MarshalString(args)
+	if invalid != nil {
+		argsJSON = tc.Arguments
+	}
+	a.emit(constant.SSEEventToolCall, &model.ChatStreamToolCallEvent{
+		Version:   constant.SSEPayloadVersion,
+		Round:     int32(round),
+		ID:        tc.ID,
+		Name:      name,
+		Arguments: argsJSON,
+	})
+
+	subject := a.toolSubject(ctx)
+	id := a.toolIdentity(name)
+	argInjector().Apply(id, args, a.trustedArgs(ctx, subject))
+	for _, inject := range a.injectors {
+		inject(ctx, id.Name, args)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.toolTimeout)
+	defer cancel()
+
+	// 模型可能臆造出未暴露的工具名或越权参数，这里兜底拦截；
+	// 拒绝结果以结构化 JSON 回填给模型，不视为工具调用失败
+	var denial *tool_policy.Denial
+	switch {
+	case a.policy != nil && !a.policy(id.Name):
+		denial = tool_policy.NotAllowed(name, fmt.Sprintf("tool %q is not available in this conversation; do not call it again", name))
+	case invalid != nil:
+		denial = invalid
+	case a.needsApproval(subject, tc):
+		// 未开启确认流程（如非流式对话）时无法询问用户
+		denial = tool_policy.Deny(name, tool_policy.ReasonApprovalRequired,
+			fmt.Sprintf("tool %q needs user confirmation, which is not available in this conversation; answer without it", name))
+	default:
+		denial = a.host.toolPolicy.Check(subject, id, args)
+	}
+
+	var out toolOutput
+	var isError bool
+	var callErr error
+	switch {
+	case denial != nil:
+		logger.Infof("agent: tool %s denied by policy: %v", name, denial)
+		out.text = denial.JSON()
+	default:
+		var res *mcp_client.ToolResult
+		callCtx := ctx
+		if a.sink != nil {
+			callCtx = mcp_client.WithProgress(ctx, a.toolProgress(round, tc))
+		}
+		res, callErr = a.host.mcpCli.CallTool(callCtx, name, args)
+		if callErr == nil {
+			out.text, out.images, isError = res.Text, res.Images, res.IsError
+		}
+	}
+	switch {
+	case callErr != nil:
+		if a.failOnToolError {
+			return toolOutput{}, fmt.Errorf("调用工具 %s 失败: %w", name, callErr)
+		}
+		out.text, isError = "tool error: "+callErr.Error(), true
+	case isError:
+		// 工具自身报告的失败同样要让模型看出来，而不是当成正常结果
+		if a.failOnToolError {
+			return toolOutput{}, fmt.Errorf("调用工具 %s 失败: %s", name, out.text)
+		}
+		out.text = "tool error: " + out.text
+	}
+	// 过长的结果（如 fs_cat、课表 JSON）会迅速撑满上下文，进入历史前先截断
+	out.text = truncateToolResult(out.text, toolResultMaxChars())
+
+	a.emitToolResult(round, tc, out.text, isError)
+	return out, nil
+}
+
+// toolImages tool 消息不能携带图片，工具返回的图片以一条 user 消息跟在本轮工具结果之后交给模型；
+// 路由上没有同时支持图片与工具的模型时不转发，模型只能看到结果文本中的 [image #n] 占位
+func (a *Agent) toolImages(calls []ai_provider.ChatToolCall, outs []toolOutput) []ai_provider.ChatMessage {
+	var images []ai_provider.ChatImage
+	var from []string
+	for i, out := range outs {
+		if len(out.images) > 0 {
+			images = append(images, out.images...)
+			from = append(from, fmt.Sprintf("%s(%s)", calls[i].Name, calls[i].ID))
+		}
+	}
+	if len(images) == 0 || !a.host.aiProviderCli.SupportsToolImages(a.route, a.model) {
+		return nil
+	}
+	return []ai_provider.ChatMessage{ai_provider.UserMessage(
+		fmt.Sprintf("以下图片由工具 %s 返回，顺序与结果中的 [image #n] 一致", strings.Join(from, "、")), images...)}
+}
+
+// toolArgs 解析模型给出的参数，并按模型看到的 schema 校验、修正类型；
+// 不合法时返回回填给模型的纠正说明，该调用不会发往 MCP 服务
+func (a *Agent) toolArgs(tc ai_provider.ChatToolCall) (map[string]any, *tool_policy.Denial) {
+	args, err := parseToolArgs(tc.Arguments)
+	if err != nil {
+		return args, tool_policy.Deny(tc.Name, tool_policy.ReasonInvalidArgument,
+			fmt.Sprintf("arguments are not a valid JSON object (%v); call the tool again with a JSON object", err))
+	}
+	a.schemaOnce.Do(func() {
+		a.schemas = map[string]map[string]any{}
+		for _, t := range a.host.mcpCli.ConvertTools() {
+			a.schemas[t.Name] = t.Parameters
+		}
+	})
+	schema := argInjector().Schema(a.toolIdentity(tc.Name), a.schemas[tc.Name])
+	if verr := tool_schema.Validate(schema, args); verr != nil {
+		d := tool_policy.Deny(tc.Name, tool_policy.ReasonInvalidArgument, verr.Error()+"; fix the argument and call the tool again")
+		d.Argument = verr.Path
+		return args, d
+	}
+	return args, nil
+}
+
+func (a *Agent) emitToolResult(round int, tc ai_provider.ChatToolCall, out string, isError bool) {
+	ev := &model.ChatStreamToolResultEvent{
+		Version: constant.SSEPayloadVersion,
+		Round:   int32(round),
+		ID:      tc.ID,
+		Name:    tc.Name,
+		Result:  out,
+	}
+	if isError {
+		ev.IsError = &isError
+	}
+	a.emit(constant.SSEEventToolResult, ev)
+}
+
+// toolProgress 把 MCP 服务端对本次调用上报的进度转发为 tool_progress 事件
+func (a *Agent) toolProgress(round int, tc ai_provider.ChatToolCall) mcp_client.ProgressFunc {
+	return func(p mcp_client.Progress) {
+		ev := &model.ChatStreamToolProgressEvent{
+			Version:  constant.SSEPayloadVersion,
+			Round:    int32(round),
+			ID:       tc.ID,
+			Name:     tc.Name,
+			Progress: p.Progress,
+		}
+		if p.Total > 0 {
+			ev.Total = &p.Total
+		}
+		if p.Message != "" {
+			ev.Message = &p.Message
+		}
+		a.emit(constant.SSEEventToolProgress, ev)
+	}
+}
+
+func (a *Agent) emit(event string, v any) {
+	if a.sink == nil {
+		return
+	}
+	a.sinkMu.Lock()
+	defer a.sinkMu.Unlock()
+	_ = a.sink(event, v)
+}
+
+// applySamplingOptions 将配置中的采样参数写入请求
+func applySamplingOptions(params *ai_provider.ChatParams) {
+	if config.AiProvider.Options.MaxTokens != nil {
+		params.MaxTokens = int64(*config.AiProvider.Options.MaxTokens)
+	}
+	params.Temperature = config.AiProvider.Options.Temperature
+	params.TopP = config.AiProvider.Options.TopP
+}
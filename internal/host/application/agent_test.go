@@ -0,0 +1,626 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_policy"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+)
+
+// testConfig 与部署时一致的最小配置；模型提供方在 newTestHost 中指向本地桩服务
+const testConfig = `
+mcp:
+  transport: stdio
+  tools:
+    namespace: always
+`
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "host-application")
+	if err != nil {
+		panic(err)
+	}
+	path := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(path, []byte(testConfig), 0o600); err != nil {
+		panic(err)
+	}
+	config.Load(path, "host")
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// fakeTool 某个 MCP 服务提供的工具，按 tools.namespace: always 以 服务__工具 暴露给模型，alias 非空时以别名暴露
+type fakeTool struct {
+	service string
+	name    string
+	alias   string
+	params  map[string]any
+	call    func(ctx context.Context, args map[string]any) (*mcp_client.ToolResult, error)
+}
+
+func (t fakeTool) exposed() string {
+	if t.alias != "" {
+		return t.alias
+	}
+	return t.service + constant.MCPToolNamespaceSeparator + t.name
+}
+
+type fakeCall struct {
+	name string
+	args map[string]any
+}
+
+// fakeToolClient 模拟聚合客户端，记录实际发往 MCP 服务的调用
+type fakeToolClient struct {
+	tools []fakeTool
+
+	mu    sync.Mutex
+	calls []fakeCall
+}
+
+func (c *fakeToolClient) find(name string) (fakeTool, bool) {
+	i := slices.IndexFunc(c.tools, func(t fakeTool) bool { return t.exposed() == name })
+	if i < 0 {
+		return fakeTool{}, false
+	}
+	return c.tools[i], true
+}
+
+func (c *fakeToolClient) called() []fakeCall {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.calls)
+}
+
+func (c *fakeToolClient) ConvertToolsToOllama() []map[string]any { return nil }
+
+func (c *fakeToolClient) ConvertTools() []ai_provider.ChatTool {
+	out := make([]ai_provider.ChatTool, 0, len(c.tools))
+	for _, t := range c.tools {
+		params := t.params
+		if params == nil {
+			params = map[string]any{"type": "object", "properties": map[string]any{}}
+		}
+		out = append(out, ai_provider.ChatTool{Name: t.exposed(), Description: t.name, Parameters: params})
+	}
+	return out
+}
+
+func (c *fakeToolClient) CallTool(ctx context.Context, name string, args any) (*mcp_client.ToolResult, error) {
+	m, _ := args.(map[string]any)
+	c.mu.Lock()
+	c.calls = append(c.calls, fakeCall{name: name, args: m})
+	c.mu.Unlock()
+	t, ok := c.find(name)
+	if !ok {
+		return nil, fmt.Errorf("tool %s not found", name)
+	}
+	if t.call != nil {
+		return t.call(ctx, m)
+	}
+	return &mcp_client.ToolResult{Text: "result of " + name}, nil
+}
+
+func (c *fakeToolClient) Resolve(name string) (string, string, bool) {
+	t, ok := c.find(name)
+	return t.service, t.name, ok
+}
+
+func (c *fakeToolClient) ListPrompts(context.Context) ([]mcp_client.Prompt, error) { return nil, nil }
+
+func (c *fakeToolClient) GetPrompt(context.Context, string, map[string]string) (*mcp_client.PromptResult, error) {
+	return nil, fmt.Errorf("no prompts")
+}
+
+func (c *fakeToolClient) ListResources(context.Context) ([]mcp_client.Resource, error) {
+	return nil, nil
+}
+
+func (c *fakeToolClient) ReadResource(context.Context, string) (*mcp_client.ToolResult, error) {
+	return nil, fmt.Errorf("no resources")
+}
+
+func (c *fakeToolClient) Close() {}
+
+// stubCall 桩模型回复中的一个工具调用
+type stubCall struct {
+	ID        string
+	Name      string
+	Arguments string
+}
+
+// stubReply 桩模型一轮的回复；empty 时返回空 choices
+type stubReply struct {
+	content string
+	calls   []stubCall
+	empty   bool
+}
+
+// stubRequest 桩模型收到的请求中测试关心的部分
+type stubRequest struct {
+	Stream   bool          `json:"stream"`
+	Messages []stubMessage `json:"messages"`
+	Tools    []struct {
+		Function struct {
+			Name       string         `json:"name"`
+			Parameters map[string]any `json:"parameters"`
+		} `json:"function"`
+	} `json:"tools"`
+}
+
+type stubMessage struct {
+	Role       string          `json:"role"`
+	Content    json.RawMessage `json:"content"`
+	ToolCallID string          `json:"tool_call_id"`
+	ToolCalls  []struct {
+		ID string `json:"id"`
+	} `json:"tool_calls"`
+}
+
+func (m stubMessage) toolCallIDs() []string {
+	out := make([]string, 0, len(m.ToolCalls))
+	for _, tc := range m.ToolCalls {
+		out = append(out, tc.ID)
+	}
+	return out
+}
+
+func (r stubRequest) toolNames() []string {
+	out := make([]string, 0, len(r.Tools))
+	for _, t := range r.Tools {
+		out = append(out, t.Function.Name)
+	}
+	return out
+}
+
+// stubModel 本地模拟 OpenAI 兼容的 /chat/completions，按顺序返回预设的回复，用完后固定回答 "done"
+type stubModel struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	replies  []stubReply
+	requests []stubRequest
+}
+
+func newStubModel(replies ...stubReply) *stubModel {
+	s := &stubModel{replies: replies}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *stubModel) received() []stubRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+func (s *stubModel) handle(w http.ResponseWriter, r *http.Request) {
+	var req stubRequest
+	if r.URL.Path != "/chat/completions" {
+		http.NotFound(w, r)
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	reply := stubReply{content: "done"}
+	if len(s.replies) > 0 {
+		reply, s.replies = s.replies[0], s.replies[1:]
+	}
+	s.mu.Unlock()
+
+	finish := "stop"
+	calls := make([]map[string]any, 0, len(reply.calls))
+	for i, tc := range reply.calls {
+		finish = "tool_calls"
+		calls = append(calls, map[string]any{
+			"index": i, "id": tc.ID, "type": "function",
+			"function": map[string]any{"name": tc.Name, "arguments": tc.Arguments},
+		})
+	}
+	usage := map[string]any{"prompt_tokens": 10, "completion_tokens": 5, "total_tokens": 15}
+	message := map[string]any{"role": "assistant", "content": reply.content}
+	if len(calls) > 0 {
+		message["tool_calls"] = calls
+	}
+	choices := []map[string]any{{"index": 0, "finish_reason": finish, "message": message}}
+	if reply.empty {
+		choices = nil
+	}
+
+	if !req.Stream {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id": "chatcmpl-stub", "object": "chat.completion", "model": "stub-model",
+			"choices": choices, "usage": usage,
+		})
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	chunk := func(choices any, usage any) {
+		b, _ := json.Marshal(map[string]any{
+			"id": "chatcmpl-stub", "object": "chat.completion.chunk", "model": "stub-model",
+			"choices": choices, "usage": usage,
+		})
+		_, _ = fmt.Fprintf(w, "data: %s\n\n", b)
+	}
+	if !reply.empty {
+		delete(message, "role")
+		chunk([]map[string]any{{"index": 0, "delta": message}}, nil)
+		chunk([]map[string]any{{"index": 0, "delta": map[string]any{}, "finish_reason": finish}}, nil)
+	}
+	chunk([]any{}, usage)
+	_, _ = fmt.Fprint(w, "data: [DONE]\n\n")
+}
+
+// newTestHost 以默认工具策略（追加 rules）构建 Host，模型调用发往 stub
+func newTestHost(tools *fakeToolClient, stub *stubModel, rules ...config.ToolPolicyRule) *Host {
+	config.AiProvider.Providers = []config.AiProviderEntry{{
+		Name:    "stub",
+		Type:    constant.AiProviderTypeOpenAI,
+		BaseURL: stub.URL,
+		APIKey:  "test-key",
+		Models:  []config.AiModelConfig{{Name: "stub-model", Vision: true, Tools: true}},
+	}}
+	engine := tool_policy.NewEngine()
+	if err := engine.Load(config.MCPPolicyConfig{Rules: append(slices.Clone(defaultToolPolicyRules), rules...)}); err != nil {
+		panic(err)
+	}
+	return &Host{
+		ctx:           context.Background(),
+		mcpCli:        tools,
+		aiProviderCli: ai_provider.NewAiProviderClient(),
+		toolPolicy:    engine,
+	}
+}
+
+func loginCtx(user string) context.Context {
+	return utils.WithLoginData(context.Background(), &utils.LoginData{ID: user, Cookie: "cookie-" + user})
+}
+
+// schoolTools 按服务命名空间暴露的内部工具与普通工具，其中 get_course_local 以别名暴露
+func schoolTools() *fakeToolClient {
+	userParams := func(props ...string) map[string]any {
+		p := map[string]any{}
+		for _, k := range props {
+			p[k] = map[string]any{"type": "string"}
+		}
+		return map[string]any{"type": "object", "properties": p}
+	}
+	return &fakeToolClient{tools: []fakeTool{
+		{service: "mcp_local", name: "get_todos", params: userParams("user_id")},
+		{service: "mcp_local", name: "get_course_local", alias: "courses", params: userParams("user_id", "term")},
+		{service: "mcp_remote", name: "web_search", params: userParams("query")},
+	}}
+}
+
+func Test_Agent_namespacedToolPolicy(t *testing.T) {
+	Convey("Test tool policy and injection with namespaced tool names", t, func() {
+		tools := schoolTools()
+
+		Convey("chat hides and denies internal tools whatever name they are exposed under", func() {
+			stub := newStubModel(
+				stubReply{calls: []stubCall{
+					{ID: "call_1", Name: "mcp_local__get_todos", Arguments: `{"user_id":"someone_else"}`},
+					{ID: "call_2", Name: "courses", Arguments: `{}`},
+				}},
+				stubReply{content: "sorry"},
+			)
+			defer stub.Close()
+			agent := NewAgent(newTestHost(tools, stub), WithPolicyEndpoint(constant.ToolPolicyEndpointChat))
+
+			res, err := agent.Run(loginCtx("u1"), []ai_provider.ChatMessage{ai_provider.UserMessage("show my todos")})
+			So(err, ShouldBeNil)
+			So(res.Reason, ShouldEqual, agentStopCompleted)
+			So(stub.received()[0].toolNames(), ShouldResemble, []string{"mcp_remote__web_search"})
+			So(tools.called(), ShouldBeEmpty)
+
+			for _, id := range []string{"call_1", "call_2"} {
+				i := slices.IndexFunc(res.Messages, func(m ai_provider.ChatMessage) bool { return m.ToolCallID == id })
+				So(i, ShouldBeGreaterThan, 0)
+				var d tool_policy.Denial
+				So(json.Unmarshal([]byte(res.Messages[i].Content), &d), ShouldBeNil)
+				So(d.Reason, ShouldEqual, tool_policy.ReasonNotAllowed)
+				So(d.Rule, ShouldEqual, "chat_internal_tools")
+			}
+		})
+
+		Convey("daily_schedule allow-list matches and user_id is injected from the login user", func() {
+			stub := newStubModel(
+				stubReply{calls: []stubCall{
+					{ID: "call_1", Name: "mcp_local__get_todos", Arguments: `{"user_id":"someone_else"}`},
+					{ID: "call_2", Name: "courses", Arguments: `{}`},
+					{ID: "call_3", Name: "mcp_remote__web_search", Arguments: `{"query":"x"}`},
+				}},
+				stubReply{content: "schedule"},
+			)
+			defer stub.Close()
+			agent := NewAgent(newTestHost(tools, stub), WithPolicyEndpoint(constant.ToolPolicyEndpointDailySchedule))
+
+			res, err := agent.Run(loginCtx("u1"), []ai_provider.ChatMessage{ai_provider.UserMessage("plan my day")})
+			So(err, ShouldBeNil)
+			So(res.Content, ShouldEqual, "schedule")
+
+			req := stub.received()[0]
+			So(req.toolNames(), ShouldResemble, []string{"mcp_local__get_todos", "courses"})
+			// 注入的参数不出现在模型看到的 schema 中
+			So(req.Tools[0].Function.Parameters["properties"], ShouldNotContainKey, "user_id")
+			So(req.Tools[1].Function.Parameters["properties"], ShouldNotContainKey, "user_id")
+
+			calls := tools.called()
+			slices.SortFunc(calls, func(a, b fakeCall) int { return strings.Compare(a.name, b.name) })
+			So(calls, ShouldHaveLength, 2)
+			So(calls[0].name, ShouldEqual, "courses")
+			So(calls[0].args["user_id"], ShouldEqual, "u1")
+			So(calls[0].args["term"], ShouldNotBeEmpty)
+			So(calls[1].name, ShouldEqual, "mcp_local__get_todos")
+			So(calls[1].args["user_id"], ShouldEqual, "u1")
+		})
+
+		Convey("caller restrictions and injectors see the original tool name", func() {
+			stub := newStubModel(stubReply{calls: []stubCall{
+				{ID: "call_1", Name: "mcp_remote__web_search", Arguments: `{"query":"x"}`},
+			}})
+			defer stub.Close()
+			var injected []string
+			agent := NewAgent(newTestHost(tools, stub),
+				WithToolPolicy(AllowTools("web_search")),
+				WithArgInjectors(func(_ context.Context, name string, args map[string]any) {
+					injected = append(injected, name)
+				}),
+			)
+
+			_, err := agent.Run(loginCtx("u1"), []ai_provider.ChatMessage{ai_provider.UserMessage("search")})
+			So(err, ShouldBeNil)
+			So(stub.received()[0].toolNames(), ShouldResemble, []string{"mcp_remote__web_search"})
+			So(injected, ShouldResemble, []string{"web_search"})
+			So(tools.called(), ShouldHaveLength, 1)
+		})
+	})
+}
+
+func Test_Agent_Run(t *testing.T) {
+	Convey("Test Agent tool calling loop", t, func() {
+		ctx := loginCtx("u1")
+		hist := []ai_provider.ChatMessage{ai_provider.SystemMessage("you are a bot"), ai_provider.UserMessage("hi")}
+		call := func(id, name string) stubCall {
+			return stubCall{ID: id, Name: name, Arguments: `{}`}
+		}
+
+		Convey("stops at the round limit when the model keeps calling tools", func() {
+			tools := &fakeToolClient{tools: []fakeTool{{service: "svc", name: "ping"}}}
+			stub := newStubModel(
+				stubReply{calls: []stubCall{call("call_1", "svc__ping")}},
+				stubReply{calls: []stubCall{call("call_2", "svc__ping")}},
+				stubReply{calls: []stubCall{call("call_3", "svc__ping")}},
+			)
+			defer stub.Close()
+
+			res, err := NewAgent(newTestHost(tools, stub), WithMaxRounds(2)).Run(ctx, hist)
+			So(err, ShouldBeNil)
+			So(res.Reason, ShouldEqual, agentStopToolRoundLimit)
+			So(stub.received(), ShouldHaveLength, 2)
+			So(tools.called(), ShouldHaveLength, 2)
+			So(res.Usage.TotalTokens, ShouldEqual, 30)
+		})
+
+		Convey("tool results follow the assistant message in call order", func() {
+			tools := &fakeToolClient{tools: []fakeTool{{service: "svc", name: "a"}, {service: "svc", name: "b"}, {service: "svc", name: "c"}}}
+			stub := newStubModel(
+				stubReply{content: "checking", calls: []stubCall{
+					call("call_a", "svc__a"), call("call_b", "svc__b"), call("call_c", "svc__c"),
+				}},
+				stubReply{content: "final"},
+			)
+			defer stub.Close()
+
+			res, err := NewAgent(newTestHost(tools, stub), WithStreaming()).Run(ctx, hist)
+			So(err, ShouldBeNil)
+			So(res.Reason, ShouldEqual, agentStopCompleted)
+			So(res.Content, ShouldEqual, "final")
+			So(res.Messages, ShouldHaveLength, len(hist)+5)
+
+			// 下一轮请求中模型看到 assistant 的 tool_calls 与逐条回填的结果一一对应
+			next := stub.received()[1].Messages[len(hist):]
+			So(next, ShouldHaveLength, 4)
+			So(next[0].toolCallIDs(), ShouldResemble, []string{"call_a", "call_b", "call_c"})
+			So([]string{next[1].ToolCallID, next[2].ToolCallID, next[3].ToolCallID}, ShouldResemble, []string{"call_a", "call_b", "call_c"})
+			So(string(next[1].Content), ShouldEqual, `"result of svc__a"`)
+		})
+
+		Convey("calls in one round run concurrently and results keep the call order", func() {
+			var (
+				mu            sync.Mutex
+				running, peak int
+				finished      []string
+			)
+			// 先发起的调用耗时更长，结束顺序与调用顺序相反
+			slow := func(name string, d time.Duration) fakeTool {
+				return fakeTool{service: "svc", name: name, call: func(context.Context, map[string]any) (*mcp_client.ToolResult, error) {
+					mu.Lock()
+					running++
+					peak = max(peak, running)
+					mu.Unlock()
+					time.Sleep(d)
+					mu.Lock()
+					running--
+					finished = append(finished, name)
+					mu.Unlock()
+					return &mcp_client.ToolResult{Text: "result of " + name}, nil
+				}}
+			}
+			tools := &fakeToolClient{tools: []fakeTool{
+				slow("a", 150*time.Millisecond), slow("b", 100*time.Millisecond), slow("c", 50*time.Millisecond),
+			}}
+			stub := newStubModel(
+				stubReply{content: "checking", calls: []stubCall{
+					call("call_a", "svc__a"), call("call_b", "svc__b"), call("call_c", "svc__c"),
+				}},
+				stubReply{content: "final"},
+			)
+			defer stub.Close()
+
+			res, err := NewAgent(newTestHost(tools, stub), WithToolConcurrency(3), WithStreaming()).Run(ctx, hist)
+			So(err, ShouldBeNil)
+			So(res.Reason, ShouldEqual, agentStopCompleted)
+			So(peak, ShouldEqual, 3)
+			So(finished, ShouldResemble, []string{"c", "b", "a"})
+
+			next := stub.received()[1].Messages[len(hist):]
+			So(next[0].toolCallIDs(), ShouldResemble, []string{"call_a", "call_b", "call_c"})
+			So([]string{next[1].ToolCallID, next[2].ToolCallID, next[3].ToolCallID}, ShouldResemble, []string{"call_a", "call_b", "call_c"})
+			for i, name := range []string{"a", "b", "c"} {
+				So(string(next[i+1].Content), ShouldEqual, `"result of `+name+`"`)
+			}
+		})
+
+		Convey("the limit caps parallel calls and a call past the timeout reports an error in its slot", func() {
+			var (
+				mu            sync.Mutex
+				running, peak int
+			)
+			track := func(name string, call func(ctx context.Context) error) fakeTool {
+				return fakeTool{service: "svc", name: name, call: func(ctx context.Context, _ map[string]any) (*mcp_client.ToolResult, error) {
+					mu.Lock()
+					running++
+					peak = max(peak, running)
+					mu.Unlock()
+					defer func() {
+						mu.Lock()
+						running--
+						mu.Unlock()
+					}()
+					if err := call(ctx); err != nil {
+						return nil, err
+					}
+					return &mcp_client.ToolResult{Text: "result of " + name}, nil
+				}}
+			}
+			quick := func(ctx context.Context) error {
+				time.Sleep(30 * time.Millisecond)
+				return nil
+			}
+			hang := func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			tools := &fakeToolClient{tools: []fakeTool{
+				track("a", quick), track("b", hang), track("c", quick), track("d", quick),
+			}}
+			stub := newStubModel(stubReply{calls: []stubCall{
+				call("call_a", "svc__a"), call("call_b", "svc__b"), call("call_c", "svc__c"), call("call_d", "svc__d"),
+			}})
+			defer stub.Close()
+
+			start := time.Now()
+			res, err := NewAgent(newTestHost(tools, stub), WithToolConcurrency(2), WithToolTimeout(100*time.Millisecond)).Run(ctx, hist)
+			So(err, ShouldBeNil)
+			So(peak, ShouldEqual, 2)
+			So(time.Since(start), ShouldBeLessThan, time.Second)
+
+			msgs := res.Messages[len(hist)+1 : len(hist)+5]
+			So(eventNames(msgs, func(m ai_provider.ChatMessage) string { return m.ToolCallID }),
+				ShouldResemble, []string{"call_a", "call_b", "call_c", "call_d"})
+			So(msgs[1].Content, ShouldStartWith, "tool error: ")
+			So(msgs[1].Content, ShouldContainSubstring, context.DeadlineExceeded.Error())
+			for _, i := range []int{0, 2, 3} {
+				So(msgs[i].Content, ShouldStartWith, "result of ")
+			}
+		})
+
+		Convey("concurrency 1 runs the calls one by one", func() {
+			var order []string
+			record := func(name string) fakeTool {
+				return fakeTool{service: "svc", name: name, call: func(context.Context, map[string]any) (*mcp_client.ToolResult, error) {
+					order = append(order, name)
+					return &mcp_client.ToolResult{Text: name}, nil
+				}}
+			}
+			tools := &fakeToolClient{tools: []fakeTool{record("a"), record("b")}}
+			stub := newStubModel(stubReply{calls: []stubCall{call("call_a", "svc__a"), call("call_b", "svc__b")}})
+			defer stub.Close()
+
+			_, err := NewAgent(newTestHost(tools, stub), WithToolConcurrency(1)).Run(ctx, hist)
+			So(err, ShouldBeNil)
+			So(order, ShouldResemble, []string{"a", "b"})
+		})
+
+		Convey("a tool hidden by the policy is neither offered nor called", func() {
+			tools := &fakeToolClient{tools: []fakeTool{{service: "svc", name: "ping"}, {service: "svc", name: "secret"}}}
+			stub := newStubModel(stubReply{calls: []stubCall{call("call_1", "svc__secret")}}, stubReply{content: "sorry"})
+			defer stub.Close()
+
+			res, err := NewAgent(newTestHost(tools, stub), WithToolPolicy(DenyTools("secret"))).Run(ctx, hist)
+			So(err, ShouldBeNil)
+			So(res.Reason, ShouldEqual, agentStopCompleted)
+			So(stub.received()[0].toolNames(), ShouldResemble, []string{"svc__ping"})
+			So(tools.called(), ShouldBeEmpty)
+			var content string
+			So(json.Unmarshal(stub.received()[1].Messages[len(hist)+1].Content, &content), ShouldBeNil)
+			var d tool_policy.Denial
+			So(json.Unmarshal([]byte(content), &d), ShouldBeNil)
+			So(d.Reason, ShouldEqual, tool_policy.ReasonNotAllowed)
+			So(d.Tool, ShouldEqual, "svc__secret")
+		})
+
+		Convey("injectors overwrite arguments before the call", func() {
+			tools := &fakeToolClient{tools: []fakeTool{{service: "svc", name: "ping"}}}
+			stub := newStubModel(stubReply{calls: []stubCall{{ID: "call_1", Name: "svc__ping", Arguments: `{"user_id":"someone_else"}`}}})
+			defer stub.Close()
+
+			_, err := NewAgent(newTestHost(tools, stub), WithArgInjectors(InjectArg("user_id", "u1", "ping"))).Run(ctx, hist)
+			So(err, ShouldBeNil)
+			So(tools.called()[0].args["user_id"], ShouldEqual, "u1")
+		})
+
+		Convey("a call to a tool the host does not expose is not answered locally", func() {
+			tools := &fakeToolClient{tools: []fakeTool{{service: "svc", name: "ping"}}}
+			stub := newStubModel(stubReply{calls: []stubCall{call("call_1", "login")}})
+			defer stub.Close()
+
+			res, err := NewAgent(newTestHost(tools, stub)).Run(ctx, hist)
+			So(err, ShouldBeNil)
+			So(toolMessage(res.Messages, "call_1"), ShouldStartWith, "tool error: ")
+			for _, m := range res.Messages {
+				So(m.Content, ShouldNotContainSubstring, "cookie-u1")
+			}
+		})
+
+		Convey("a response without choices ends the run", func() {
+			for _, streaming := range []bool{false, true} {
+				stub := newStubModel(stubReply{empty: true})
+				var opts []AgentOption
+				if streaming {
+					opts = append(opts, WithStreaming())
+				}
+				res, err := NewAgent(newTestHost(&fakeToolClient{}, stub), opts...).Run(ctx, hist)
+				stub.Close()
+				So(err, ShouldBeNil)
+				So(res.Reason, ShouldEqual, agentStopEmptyChoices)
+				So(res.Content, ShouldBeEmpty)
+				So(res.Messages, ShouldResemble, hist)
+			}
+		})
+	})
+}
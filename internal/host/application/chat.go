@@ -2,10 +2,9 @@ package application
 
 import (
 	"context"
-	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/api/model/model"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
-	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
 )
 
 //func (h *Host) Chat(id int64, msg string, imageData []byte) (string, error) {
@@ -99,118 +98,31 @@ import (
 //	return resp.Message.Content, nil
 //}
 
+// StreamChat 本地模型（Ollama）流式对话，历史仅保存在内存中。
+// Ollama 提供了 OpenAI 兼容层，因此与远程模型共用同一个 Agent 循环
 func (h *Host) StreamChat(
 	ctx context.Context,
 	id int64,
 	userMsg string,
 	emit func(event string, v any) error, // SSE: event 名 + 任意 JSON 数据
 ) error {
-	// 历史
-	hist := history[id]
-	if hist == nil {
-		hist = []ai_provider.Message{}
-	}
-	// 加用户消息
-	hist = append(hist, ai_provider.Message{Role: "user", Content: userMsg})
-
-	tools := h.mcpCli.ConvertToolsToOllama()
-	opts := ai_provider.BuildOptions()
-	// 首次流式：边生成边推，遇到 tool_calls 停止
-	var assistantBuf string
-	var toolCalls []ai_provider.ToolCall
+	// 历史 + 用户消息
+	hist := append(historyOpenAI[id], ai_provider.UserMessage(userMsg))
 
-	err := h.aiProviderCli.ChatStream(ctx, ai_provider.ChatRequest{
-		Model:     config.AiProvider.Model,
-		Messages:  hist,
-		Tools:     tools,
-		Options:   opts,
-		KeepAlive: config.AiProvider.Options.KeepAlive,
-	}, func(chunk *ai_provider.ChatResponse) error {
-		// 增量文本
-		if s := chunk.Message.Content; s != "" {
-			assistantBuf += s
-			// 推送到handler层
-			_ = emit(constant.SSEEventDelta, map[string]any{"text": s})
-		}
-		// 工具调用（可能在中途出现）
-		if len(chunk.Message.ToolCalls) > 0 {
-			toolCalls = append(toolCalls, chunk.Message.ToolCalls...)
-			_ = emit(constant.SSEEventStartToolCall, map[string]any{"tool_calls": chunk.Message.ToolCalls})
-			return errno.OllamaInternalStopStream // 提前结束首次流
-		}
-		return nil
-	})
+	res, err := NewAgent(h,
+		WithStreaming(),
+		WithEventSink(emit),
+		WithPolicyEndpoint(constant.ToolPolicyEndpointLocalChat),
+	).Run(ctx, hist)
 	if err != nil {
 		return err
 	}
 
-	// 把模型已生成的片段先落历史
-	if assistantBuf != "" {
-		hist = append(hist, ai_provider.Message{Role: "assistant", Content: assistantBuf})
-	}
-
-	// 没有工具调用：直接完成
-	if len(toolCalls) == 0 {
-		history[id] = hist
-		_ = emit("done", map[string]any{"reason": "no_tool"})
-		return nil
-	}
-
-	// 执行工具
-	for _, tc := range toolCalls {
-		args, err := ai_provider.ParseToolArguments(tc.Function.Arguments)
-		if err != nil {
-			args = map[string]any{"_error": err.Error()}
-		}
-
-		_ = emit(constant.SSEEventToolCall, map[string]any{
-			"name": tc.Function.Name,
-			"args": args,
-		})
-
-		out, callErr := h.mcpCli.CallTool(ctx, tc.Function.Name, args)
-		if callErr != nil {
-			out = "tool error: " + callErr.Error()
-		}
-
-		// 工具结果给前端
-		_ = emit(constant.SSEEventToolResult, map[string]any{
-			"name":   tc.Function.Name,
-			"result": out,
-		})
-
-		// 工具结果落历史
-		hist = append(hist, ai_provider.Message{
-			Role:     "tool",
-			ToolName: tc.Function.Name,
-			Content:  out,
-		})
-	}
-
-	// 6) 二次流式：带工具结果，让模型给最终回答
-	var finalBuf string
-	err = h.aiProviderCli.ChatStream(ctx, ai_provider.ChatRequest{
-		Model:     config.AiProvider.Model,
-		Messages:  hist,
-		Tools:     tools,
-		Options:   opts,
-		KeepAlive: config.AiProvider.Options.KeepAlive,
-	}, func(chunk *ai_provider.ChatResponse) error {
-		if s := chunk.Message.Content; s != "" {
-			finalBuf += s
-			_ = emit(constant.SSEEventDelta, map[string]any{"text": s})
-		}
-		return nil
+	// 结束收尾：保存历史、发 done
+	historyOpenAI[id] = res.Messages
+	_ = emit(constant.SSEEventDone, &model.ChatStreamDoneEvent{
+		Version: constant.SSEPayloadVersion,
+		Reason:  res.Reason,
 	})
-	if err != nil {
-		return err
-	}
-
-	// 7) 结束收尾：保存历史、发 done
-	if finalBuf != "" {
-		hist = append(hist, ai_provider.Message{Role: "assistant", Content: finalBuf})
-	}
-	history[id] = hist
-	_ = emit(constant.SSEEventDone, map[string]any{"reason": "completed"})
 	return nil
 }
@@ -0,0 +1,159 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_policy"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// pendingApproval 暂停在待确认工具调用处的对话，恢复生成所需的全部状态
+type pendingApproval struct {
+	UserID    string                     `json:"user_id"`
+	Route     string                     `json:"route"`
+	Round     int                        `json:"round"`
+	BaseLen   int                        `json:"base_len"` // Messages 中本轮新增部分的起点，恢复结束后从这里开始持久化
+	Messages  []ai_provider.ChatMessage  `json:"messages"`
+	ToolCalls []ai_provider.ChatToolCall `json:"tool_calls"`
+	Images    []ai_provider.ChatMessage  `json:"images,omitempty"` // 已执行调用返回的图片，跟在全部工具结果之后
+	Deadline  time.Time                  `json:"deadline"`
+}
+
+// ToolApproval 用户对待确认工具调用的决定
+type ToolApproval struct {
+	Approved    bool
+	ToolCallIDs []string // 批准时只批准其中的调用，其余视为拒绝；为空表示全部
+	Reason      string   // 拒绝原因，回填给模型
+}
+
+// ownedBy 只有发起对话的用户可以确认、拒绝或了结暂停的调用
+func (p *pendingApproval) ownedBy(userID string) error {
+	if p.UserID != userID {
+		return errno.NewErrNo(errno.AuthInvalidCode, "无权访问该对话")
+	}
+	return nil
+}
+
+// decide 给出单个调用的结论，nil 表示批准
+func (d ToolApproval) decide(deadline time.Time, tc ai_provider.ChatToolCall) *tool_policy.Denial {
+	switch {
+	case time.Now().After(deadline):
+		return tool_policy.Deny(tc.Name, tool_policy.ReasonApprovalTimeout,
+			fmt.Sprintf("the user did not confirm tool %q in time, it was not executed", tc.Name))
+	case d.Approved && (len(d.ToolCallIDs) == 0 || slices.Contains(d.ToolCallIDs, tc.ID)):
+		return nil
+	}
+	msg := fmt.Sprintf("the user rejected tool %q, it was not executed", tc.Name)
+	if d.Reason != "" {
+		msg += ": " + d.Reason
+	}
+	return tool_policy.Deny(tc.Name, tool_policy.ReasonApprovalRejected, msg)
+}
+
+// savePendingApproval 状态在确认截止后（留出少许余量）自动过期，被放弃的暂停不会一直占用 Redis
+func (h *Host) savePendingApproval(ctx context.Context, conversationID string, state *pendingApproval) error {
+	data, err := sonic.Marshal(state)
+	if err != nil {
+		return err
+	}
+	expire := max(time.Until(state.Deadline), 0) + constant.ChatApprovalGrace
+	return h.templateRepository.SaveChatApproval(ctx, conversationID, data, expire)
+}
+
+// takePendingApproval 取出并删除暂停状态；状态属于其他用户时原样保留并返回权限错误
+func (h *Host) takePendingApproval(ctx context.Context, userID, conversationID string) (*pendingApproval, error) {
+	data, err := h.templateRepository.GetChatApproval(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	state, err := decodePendingApproval(data)
+	if err != nil || state == nil {
+		return nil, err
+	}
+	if err := state.ownedBy(userID); err != nil {
+		return nil, err
+	}
+	data, err = h.templateRepository.TakeChatApproval(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	return decodePendingApproval(data)
+}
+
+func decodePendingApproval(data []byte) (*pendingApproval, error) {
+	if data == nil {
+		return nil, nil
+	}
+	state := new(pendingApproval)
+	if err := sonic.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("unmarshal pending approval: %w", err)
+	}
+	return state, nil
+}
+
+// settlePendingApproval 用户未确认就发送了新消息：待确认的调用全部按拒绝（或超时）回填，
+// 并把暂停那一轮的历史持久化，保证 tool_calls 之后总有对应的工具结果
+func (h *Host) settlePendingApproval(ctx context.Context, userID, conversationID string) error {
+	state, err := h.takePendingApproval(ctx, userID, conversationID)
+	if err != nil || state == nil {
+		return err
+	}
+	decision := ToolApproval{Reason: "the user moved on without confirming"}
+	msgs := state.Messages
+	for _, tc := range state.ToolCalls {
+		msgs = append(msgs, ai_provider.ToolMessage(decision.decide(state.Deadline, tc).JSON(), tc.ID))
+	}
+	msgs = append(msgs, state.Images...)
+	return h.persistConversation(ctx, userID, conversationID, msgs[state.BaseLen:])
+}
+
+// ResumeChatStream 按用户的决定恢复暂停在待确认工具调用处的流式对话，
+// 事件写入同一对话的缓冲，调用方随后通过 AttachChatStream 接收
+func (h *Host) ResumeChatStream(userID, conversationID string, decision ToolApproval) error {
+	data, err := h.templateRepository.GetChatApproval(h.ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	state, err := decodePendingApproval(data)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return errno.NewErrNo(errno.BizNotExist, "没有待确认的工具调用")
+	}
+	if err := state.ownedBy(userID); err != nil {
+		return err
+	}
+
+	decision.ToolCallIDs = slices.Clone(decision.ToolCallIDs)
+	decision.Reason = strings.Clone(decision.Reason)
+	return h.runChatStream(userID, conversationID, func(ctx context.Context, conversationID string, emit EventSink) error {
+		// 加锁后重新读取并删除，防止并发的确认请求重复执行同一批调用
+		state, err := h.takePendingApproval(ctx, userID, conversationID)
+		if err != nil {
+			return err
+		}
+		if state == nil {
+			return errno.NewErrNo(errno.BizNotExist, "没有待确认的工具调用")
+		}
+
+		logger.Infof("ResumeChatStream: conversation %s approved=%v ids=%v", conversationID, decision.Approved, decision.ToolCallIDs)
+		res, err := NewAgent(h, h.streamChatOptions(userID, conversationID, state.Route, emit)...).
+			Resume(ctx, state.Messages, state.Round, state.ToolCalls, state.Images, func(tc ai_provider.ChatToolCall) *tool_policy.Denial {
+				return decision.decide(state.Deadline, tc)
+			})
+		if err != nil {
+			return err
+		}
+		return h.finishStreamTurn(ctx, userID, conversationID, state.Route, state.BaseLen, res, emit)
+	})
+}
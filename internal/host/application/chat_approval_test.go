@@ -0,0 +1,271 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/internal/host/infra"
+	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_policy"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+)
+
+// testRepository Redis 部分使用真实实现（miniredis），对话与用量记录保存在内存中
+type testRepository struct {
+	*infra.TemplateRepository
+
+	mu            sync.Mutex
+	conversations map[string][]ai_provider.ChatMessage
+	compactions   map[string]compaction
+	beforeList    func() // 读取事件缓冲之前调用，用于在订阅与读缓冲之间插入事件
+}
+
+func (r *testRepository) GetConversationByID(_ context.Context, id string) (*model.Conversations, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	msgs, ok := r.conversations[id]
+	if !ok {
+		return nil, nil
+	}
+	data, err := json.Marshal(msgs)
+	if err != nil {
+		return nil, err
+	}
+	c := r.compactions[id]
+	return &model.Conversations{
+		ID:             id,
+		Messages:       string(data),
+		CompactSummary: c.summary,
+		CompactedUntil: int32(c.until),
+	}, nil
+}
+
+func (r *testRepository) UpsertConversation(_ context.Context, _ string, id string, messages []ai_provider.ChatMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.conversations[id] = append(r.conversations[id], messages...)
+	return nil
+}
+
+func (r *testRepository) AddTokenUsage(context.Context, *model.TokenUsages) error { return nil }
+
+func (r *testRepository) conversation(id string) []ai_provider.ChatMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.conversations[id])
+}
+
+// newStreamHost 在 newTestHost 的基础上接入 miniredis，用于流式对话、续传与确认流程
+func newStreamHost(tools *fakeToolClient, stub *stubModel, rules ...config.ToolPolicyRule) (*Host, *testRepository, *miniredis.Miniredis) {
+	mr := miniredis.NewMiniRedis()
+	if err := mr.Start(); err != nil {
+		panic(err)
+	}
+	repo := &testRepository{
+		TemplateRepository: infra.NewTemplateRepository(nil, redis.NewClient(&redis.Options{Addr: mr.Addr()})),
+		conversations:      map[string][]ai_provider.ChatMessage{},
+		compactions:        map[string]compaction{},
+	}
+	h := newTestHost(tools, stub, rules...)
+	h.templateRepository = repo
+	return h, repo, mr
+}
+
+// attachAll 接到对话的事件流上直到 done/error，返回收到的全部事件
+func attachAll(h *Host, userID, conversationID string, lastEventID int64) ([]*repository.ChatStreamEvent, error) {
+	var events []*repository.ChatStreamEvent
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := h.AttachChatStream(ctx, userID, conversationID, lastEventID, func(ev *repository.ChatStreamEvent) error {
+		if ev.Event != constant.SSEEventHeartbeat {
+			events = append(events, ev)
+		}
+		return nil
+	})
+	return events, err
+}
+
+func eventNames[T any](events []T, name func(T) string) []string {
+	out := make([]string, 0, len(events))
+	for _, ev := range events {
+		out = append(out, name(ev))
+	}
+	return out
+}
+
+func streamEventName(ev *repository.ChatStreamEvent) string { return ev.Event }
+
+func messageRoles(msgs []ai_provider.ChatMessage) []string {
+	return eventNames(msgs, func(m ai_provider.ChatMessage) string { return m.Role })
+}
+
+// toolMessage 历史中 tool_call_id 对应的工具结果
+func toolMessage(msgs []ai_provider.ChatMessage, id string) string {
+	i := slices.IndexFunc(msgs, func(m ai_provider.ChatMessage) bool { return m.ToolCallID == id })
+	if i < 0 {
+		return ""
+	}
+	return msgs[i].Content
+}
+
+func denialOf(content string) tool_policy.Denial {
+	var d tool_policy.Denial
+	_ = json.Unmarshal([]byte(content), &d)
+	return d
+}
+
+func Test_ChatApproval(t *testing.T) {
+	Convey("Test tool calls waiting for user approval", t, func() {
+		const (
+			user = "u1"
+			conv = "conv-approval"
+		)
+		photo := ai_provider.ChatImage{URL: "data:image/png;base64,aGVsbG8="}
+		tools := &fakeToolClient{tools: []fakeTool{
+			{service: "mcp_local", name: "take_photo", call: func(context.Context, map[string]any) (*mcp_client.ToolResult, error) {
+				return &mcp_client.ToolResult{Text: "[image #1]", Images: []ai_provider.ChatImage{photo}}, nil
+			}},
+			{service: "mcp_local", name: "delete_todo"},
+		}}
+		stub := newStubModel(
+			stubReply{content: "let me check", calls: []stubCall{
+				{ID: "call_photo", Name: "mcp_local__take_photo", Arguments: `{}`},
+				{ID: "call_delete", Name: "mcp_local__delete_todo", Arguments: `{}`},
+			}},
+			stubReply{content: "all done"},
+		)
+		defer stub.Close()
+		h, repo, mr := newStreamHost(tools, stub, config.ToolPolicyRule{Name: "confirm_delete", Confirm: []string{"delete_*"}})
+		defer mr.Close()
+
+		var paused []string
+		err := h.StreamChatOpenAI(loginCtx(user), user, conv, ai_provider.UserMessage("clean up my todos"),
+			func(event string, v any) error {
+				paused = append(paused, event)
+				return nil
+			})
+		So(err, ShouldBeNil)
+
+		Convey("the run pauses before the confirmed tool and saves its state", func() {
+			So(paused, ShouldContain, constant.SSEEventApproval)
+			So(paused[len(paused)-1], ShouldEqual, constant.SSEEventDone)
+			So(eventNames(tools.called(), func(c fakeCall) string { return c.name }), ShouldResemble, []string{"mcp_local__take_photo"})
+			So(repo.conversation(conv), ShouldBeEmpty)
+
+			data, err := h.templateRepository.GetChatApproval(context.Background(), conv)
+			So(err, ShouldBeNil)
+			state, err := decodePendingApproval(data)
+			So(err, ShouldBeNil)
+			So(state.UserID, ShouldEqual, user)
+			So(state.ToolCalls, ShouldHaveLength, 1)
+			So(state.ToolCalls[0].ID, ShouldEqual, "call_delete")
+			So(messageRoles(state.Messages), ShouldResemble, []string{"system", "user", "assistant", "tool"})
+			// 已执行调用返回的图片随状态保存，不能在暂停时丢失
+			So(state.Images, ShouldHaveLength, 1)
+			So(state.Images[0].Images, ShouldResemble, []ai_provider.ChatImage{photo})
+		})
+
+		Convey("another user can neither resume nor settle the paused calls", func() {
+			So(h.ResumeChatStream("u2", conv, ToolApproval{Approved: true}), ShouldNotBeNil)
+
+			err := h.StreamChatOpenAI(loginCtx("u2"), "u2", conv, ai_provider.UserMessage("hijack"),
+				func(string, any) error { return nil })
+			So(err, ShouldNotBeNil)
+			So(repo.conversation(conv), ShouldBeEmpty)
+			data, _ := h.templateRepository.GetChatApproval(context.Background(), conv)
+			So(data, ShouldNotBeNil)
+			So(tools.called(), ShouldHaveLength, 1)
+		})
+
+		Convey("the saved state expires shortly after the deadline", func() {
+			data, _ := h.templateRepository.GetChatApproval(context.Background(), conv)
+			state, _ := decodePendingApproval(data)
+			ttl := mr.TTL("chat_approval:" + conv)
+			So(ttl, ShouldBeGreaterThan, constant.ChatApprovalGrace)
+			So(ttl, ShouldBeLessThanOrEqualTo, time.Until(state.Deadline)+constant.ChatApprovalGrace+time.Second)
+
+			mr.FastForward(ttl)
+			data, _ = h.templateRepository.GetChatApproval(context.Background(), conv)
+			So(data, ShouldBeNil)
+		})
+
+		Convey("approving runs the call and resumes from the saved state", func() {
+			So(h.ResumeChatStream(user, conv, ToolApproval{Approved: true}), ShouldBeNil)
+			events, err := attachAll(h, user, conv, 0)
+			So(err, ShouldBeNil)
+			So(eventNames(events, streamEventName), ShouldContain, constant.SSEEventToolResult)
+			So(events[len(events)-1].Event, ShouldEqual, constant.SSEEventDone)
+			So(string(events[len(events)-1].Data), ShouldContainSubstring, agentStopCompleted)
+			So(tools.called(), ShouldHaveLength, 2)
+
+			// 持久化的是本轮新增部分：两个调用的结果紧跟 assistant，图片在全部工具结果之后
+			msgs := repo.conversation(conv)
+			So(messageRoles(msgs), ShouldResemble, []string{"user", "assistant", "tool", "tool", "user", "assistant"})
+			So(msgs[2].ToolCallID, ShouldEqual, "call_photo")
+			So(msgs[3].ToolCallID, ShouldEqual, "call_delete")
+			So(msgs[3].Content, ShouldEqual, "result of mcp_local__delete_todo")
+			So(msgs[4].Images, ShouldResemble, []ai_provider.ChatImage{photo})
+			So(msgs[5].Content, ShouldEqual, "all done")
+
+			reqs := stub.received()
+			So(reqs, ShouldHaveLength, 2)
+			So(eventNames(reqs[1].Messages, func(m stubMessage) string { return m.Role }),
+				ShouldResemble, []string{"system", "user", "assistant", "tool", "tool", "user"})
+
+			state, _ := h.templateRepository.GetChatApproval(context.Background(), conv)
+			So(state, ShouldBeNil)
+			So(h.ResumeChatStream(user, conv, ToolApproval{Approved: true}), ShouldNotBeNil)
+		})
+
+		Convey("rejecting reports the reason to the model without running the call", func() {
+			So(h.ResumeChatStream(user, conv, ToolApproval{Reason: "keep it"}), ShouldBeNil)
+			_, err := attachAll(h, user, conv, 0)
+			So(err, ShouldBeNil)
+			So(tools.called(), ShouldHaveLength, 1)
+
+			msgs := repo.conversation(conv)
+			d := denialOf(toolMessage(msgs, "call_delete"))
+			So(d.Reason, ShouldEqual, tool_policy.ReasonApprovalRejected)
+			So(d.Message, ShouldContainSubstring, "keep it")
+			So(msgs[4].Images, ShouldResemble, []ai_provider.ChatImage{photo})
+		})
+
+		Convey("approving after the deadline is reported as a timeout", func() {
+			data, _ := h.templateRepository.GetChatApproval(context.Background(), conv)
+			state, _ := decodePendingApproval(data)
+			state.Deadline = time.Now().Add(-time.Second)
+			So(h.savePendingApproval(context.Background(), conv, state), ShouldBeNil)
+
+			So(h.ResumeChatStream(user, conv, ToolApproval{Approved: true}), ShouldBeNil)
+			_, err := attachAll(h, user, conv, 0)
+			So(err, ShouldBeNil)
+			So(tools.called(), ShouldHaveLength, 1)
+			So(denialOf(toolMessage(repo.conversation(conv), "call_delete")).Reason, ShouldEqual, tool_policy.ReasonApprovalTimeout)
+		})
+
+		Convey("a new message settles the pending calls as rejected and keeps the images", func() {
+			err := h.StreamChatOpenAI(loginCtx(user), user, conv, ai_provider.UserMessage("never mind"),
+				func(string, any) error { return nil })
+			So(err, ShouldBeNil)
+			So(tools.called(), ShouldHaveLength, 1)
+
+			msgs := repo.conversation(conv)
+			So(messageRoles(msgs), ShouldResemble, []string{"user", "assistant", "tool", "tool", "user", "user", "assistant"})
+			So(denialOf(msgs[3].Content).Reason, ShouldEqual, tool_policy.ReasonApprovalRejected)
+			So(msgs[4].Images, ShouldResemble, []ai_provider.ChatImage{photo})
+			So(msgs[5].Content, ShouldEqual, "never mind")
+		})
+	})
+}
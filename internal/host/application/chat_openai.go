@@ -4,16 +4,13 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
-
-	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
-	"github.com/bytedance/sonic"
+	"strings"
 
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 
-	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/api/model/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
-	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
-	openai "github.com/openai/openai-go/v2"
 )
 
 // systemPrompt 系统提示词，用于指导 AI 处理课表查询等任务
@@ -100,256 +97,137 @@ var systemPrompt = `你是一个智能助手，需要帮助用户提供回答，
 
 记住：准确性最重要！务必严格按照 scheduleRules 的数据来判断课程时间，不要臆测或编造信息。`
 
-// 将 OpenAI 的 tool_calls[].function.arguments (string) 解成 map[string]any
-func parseOpenAIToolArgs(argStr string) map[string]any {
-	if argStr == "" {
-		return map[string]any{}
+// 将 tool_calls[].function.arguments (string) 解成 map[string]any
+func parseToolArgs(argStr string) (map[string]any, error) {
+	if strings.TrimSpace(argStr) == "" {
+		return map[string]any{}, nil
 	}
 	var m map[string]any
-	if err := json.Unmarshal([]byte(argStr), &m); err == nil {
-		return m
+	if err := json.Unmarshal([]byte(argStr), &m); err != nil {
+		return map[string]any{}, err
+	}
+	if m == nil {
+		m = map[string]any{}
 	}
-	// 如果不是 JSON，就当成纯字符串包裹
-	return map[string]any{"_": argStr}
+	return m, nil
 }
 
-const maxToolRounds = 10 // 防御性上限，避免死循环
+// loadConversationHistory 从数据库加载该对话的历史消息，新对话则以系统提示词开头
+func (h *Host) loadConversationHistory(ctx context.Context, conversationID string) ([]ai_provider.ChatMessage, error) {
+	var hist []ai_provider.ChatMessage
+
+	conversation, err := h.templateRepository.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if conversation == nil {
+		// 新对话，添加系统提示词
+		return append(hist, ai_provider.SystemMessage(systemPrompt)), nil
+	}
+	if err := json.Unmarshal([]byte(conversation.Messages), &hist); err != nil {
+		logger.Errorf("failed to unmarshal conversation messages, conversationID=%s, err=%v", conversationID, err)
+		return nil, err
+	}
+	return hist, nil
+}
+
+// persistConversation 只持久化本轮“新增部分”
+func (h *Host) persistConversation(ctx context.Context, userID, conversationID string, newMessages []ai_provider.ChatMessage) error {
+	if len(newMessages) == 0 {
+		return nil
+	}
+	return h.templateRepository.UpsertConversation(ctx, userID, conversationID, newMessages)
+}
+
+// buildUserMessage 构建用户消息，有图片时附带 base64 data URL
+func buildUserMessage(text string, imageData []byte) ai_provider.ChatMessage {
+	if len(imageData) == 0 {
+		return ai_provider.UserMessage(text)
+	}
+	return ai_provider.UserMessage(text, ai_provider.ChatImage{
+		URL: "data:image/jpeg;base64," + base64.StdEncoding.EncodeToString(imageData),
+	})
+}
 
 func (h *Host) StreamChatOpenAI(
 	ctx context.Context,
 	userID string,
 	conversationID string,
-	userMsg string,
-	imageData []byte,
+	userMsg ai_provider.ChatMessage,
 	emit func(event string, v any) error, // SSE: event 名 + 任意 JSON 数据
 ) error {
-	// 历史（OpenAI）
-	var hist []openai.ChatCompletionMessageParamUnion
-
-	// 从数据库加载该对话的历史消息填充 hist（如果不存在就保持为空）
-	conversation, err := h.templateRepository.GetConversationByID(ctx, conversationID)
-	if err != nil {
+	// 上一轮还在等待确认时先将其了结，再开始新的一轮
+	if err := h.settlePendingApproval(ctx, userID, conversationID); err != nil {
 		return err
 	}
-	if conversation != nil {
-		if err := json.Unmarshal([]byte(conversation.Messages), &hist); err != nil {
-			logger.Errorf("failed to unmarshal conversation messages, conversationID=%s, err=%v", conversationID, err)
-			return err
-		}
-	} else {
-		// 新对话，添加系统提示词
-		hist = append(hist, openai.SystemMessage(systemPrompt))
+	hist, err := h.loadConversationHistory(ctx, conversationID)
+	if err != nil {
+		return err
 	}
+	hist = append(hist, userMsg)
 
-	// 记录当前历史长度，用于之后只持久化“新增部分”
-	baseLen := len(hist)
-
-	// 构建用户消息
-	if len(imageData) > 0 {
-		// 如果有图片，使用多模态消息格式
-		base64Image := base64.StdEncoding.EncodeToString(imageData)
-
-		// 创建文本部分
-		textPart := openai.ChatCompletionContentPartUnionParam{
-			OfText: &openai.ChatCompletionContentPartTextParam{
-				Type: "text",
-				Text: userMsg,
-			},
-		}
-
-		// 创建图片部分
-		imagePart := openai.ImageContentPart(openai.ChatCompletionContentPartImageImageURLParam{
-			URL: "data:image/jpeg;base64," + base64Image,
-		})
-
-		// 创建用户消息
-		hist = append(hist, openai.ChatCompletionMessageParamUnion{
-			OfUser: &openai.ChatCompletionUserMessageParam{
-				Role: "user",
-				Content: openai.ChatCompletionUserMessageParamContentUnion{
-					OfArrayOfContentParts: []openai.ChatCompletionContentPartUnionParam{
-						textPart,
-						imagePart,
-					},
-				},
-			},
-		})
-	} else {
-		// 纯文本消息
-		hist = append(hist, openai.UserMessage(userMsg))
+	route := constant.AiRouteChat
+	if len(userMsg.Images) > 0 {
+		route = constant.AiRouteVision
 	}
-
-	// 工具（OpenAI 版）
-	allTools := h.mcpCli.ConvertToolsToOpenAI()
-	// 屏蔽内部工具：get_todos 和 get_course（这些工具仅供专用接口使用）
-	tools := make([]openai.ChatCompletionToolUnionParam, 0, len(allTools))
-	for _, tool := range allTools {
-		if tool.OfFunction != nil {
-			name := tool.OfFunction.Function.Name
-			if name == "get_todos" || name == "get_course" {
-				continue
-			}
-		}
-		tools = append(tools, tool)
+	primary, err := h.aiProviderCli.PrimaryModel(route)
+	if err != nil {
+		return err
 	}
+	hist = h.fitContextWindow(ctx, userID, conversationID, primary, hist)
+	// 记录本轮用户消息之前的历史长度，用于之后只持久化“新增部分”
+	baseLen := len(hist) - 1
+	res, err := NewAgent(h, h.streamChatOptions(userID, conversationID, route, emit)...).Run(ctx, hist)
+	if err != nil {
+		return err
+	}
+	return h.finishStreamTurn(ctx, userID, conversationID, route, baseLen, res, emit)
+}
 
-	round := 0
-	for {
-		round++
-		if round > maxToolRounds {
-			// 每轮对话结束时持久化“新增历史”
-			newMessages := hist[baseLen:]
-			if len(newMessages) > 0 {
-				if err := h.templateRepository.UpsertConversation(ctx, userID, conversationID, newMessages); err != nil {
-					return err
-				}
-			}
-
-			_ = emit(constant.SSEEventDone, map[string]any{"reason": "tool_round_limit"})
-			return nil
-		}
-
-		// 一轮生成：边流边推，若需要工具则中断本轮
-		var assistantBuf string
-		var acc openai.ChatCompletionAccumulator
-		var needTools bool
-
-		params := openai.ChatCompletionNewParams{
-			Model:    openai.ChatModel(config.AiProvider.Model),
-			Messages: hist,
-		}
-		if len(imageData) > 0 {
-			params.Model = "qwen3-vl-flash"
-		}
-
-		// 只有在 tools 非空时才传递 Tools 参数，避免阿里云 API 报错
-		if len(tools) > 0 {
-			params.Tools = tools
-		}
+// streamChatOptions 流式对话（含确认后恢复）的 Agent 配置
+func (h *Host) streamChatOptions(userID, conversationID, route string, emit EventSink) []AgentOption {
+	return []AgentOption{
+		WithStreaming(),
+		WithRoute(route),
+		WithPolicyEndpoint(constant.ToolPolicyEndpointChat),
+		WithApproval(),
+		WithEventSink(emit),
+		WithUsageAccount(userID, conversationID),
+	}
+}
 
-		err := h.aiProviderCli.ChatStreamOpenAI(ctx, params, func(chunk *openai.ChatCompletionChunk) error {
-			acc.AddChunk(*chunk)
-			if len(chunk.Choices) > 0 {
-				if s := chunk.Choices[0].Delta.Content; s != "" {
-					assistantBuf += s
-					_ = emit(constant.SSEEventDelta, map[string]any{"text": s})
-				}
-				// 工具调用结束标志（OpenAI：最后一帧 finish_reason = "tool_calls"）
-				if chunk.Choices[0].FinishReason == "tool_calls" {
-					needTools = true
-					if len(acc.Choices) > 0 && len(acc.Choices[0].Message.ToolCalls) > 0 {
-						_ = emit(constant.SSEEventStartToolCall, map[string]any{
-							"tool_calls": acc.Choices[0].Message.ToolCalls,
-							"round":      round,
-						})
-					}
-					return errno.OllamaInternalStopStream
-				}
-			}
-			return nil
-		})
-		if err != nil {
+// finishStreamTurn 持久化本轮新增历史并发送 done；暂停等待确认时改为保存恢复状态，
+// 这一轮的历史在恢复或了结后一并持久化
+func (h *Host) finishStreamTurn(
+	ctx context.Context,
+	userID string,
+	conversationID string,
+	route string,
+	baseLen int,
+	res *AgentResult,
+	emit EventSink,
+) error {
+	if res.Reason == agentStopApproval {
+		if err := h.savePendingApproval(ctx, conversationID, &pendingApproval{
+			UserID:    userID,
+			Route:     route,
+			Round:     res.Round,
+			BaseLen:   baseLen,
+			Messages:  res.Messages,
+			ToolCalls: res.Pending,
+			Images:    res.PendingImages,
+			Deadline:  res.PendingDeadline,
+		}); err != nil {
 			return err
 		}
-
-		// 把已产生的 assistant 文本落历史
-		if assistantBuf != "" && !needTools {
-			hist = append(hist, openai.AssistantMessage(assistantBuf))
-		}
-
-		// 如果本轮不需要工具，说明模型已经给出最终答案
-		if !needTools {
-			// 对话结束，持久化“新增历史”
-			newMessages := hist[baseLen:]
-			if len(newMessages) > 0 {
-				if err := h.templateRepository.UpsertConversation(ctx, userID, conversationID, newMessages); err != nil {
-					return err
-				}
-			}
-
-			_ = emit(constant.SSEEventDone, map[string]any{"reason": "completed"})
-			return nil
-		}
-
-		// 执行（可能多个）工具调用，然后将每个工具结果以 ToolMessage 落历史
-		if len(acc.Choices) == 0 || len(acc.Choices[0].Message.ToolCalls) == 0 {
-			// 偶发兜底：标记需要工具但没聚合到（理论上不会发生）
-			// 对话结束，持久化“新增历史”
-			newMessages := hist[baseLen:]
-			if len(newMessages) > 0 {
-				if err := h.templateRepository.UpsertConversation(ctx, userID, conversationID, newMessages); err != nil {
-					return err
-				}
-			}
-
-			_ = emit(constant.SSEEventDone, map[string]any{"reason": "no_tool_details"})
-			return nil
-		}
-
-		toolCallsParam := make([]openai.ChatCompletionMessageToolCallUnionParam, 0, len(acc.Choices[0].Message.ToolCalls))
-		for _, tc := range acc.Choices[0].Message.ToolCalls {
-			toolCallsParam = append(toolCallsParam, openai.ChatCompletionMessageToolCallUnionParam{
-				OfFunction: &openai.ChatCompletionMessageFunctionToolCallParam{
-					ID:   tc.ID,
-					Type: "function",
-					Function: openai.ChatCompletionMessageFunctionToolCallFunctionParam{
-						Name:      tc.Function.Name,
-						Arguments: tc.Function.Arguments, // 注意：这里是字符串
-					},
-				},
-			})
-		}
-		// 根据openAI规范，tool_call前需要一条assistantMsg
-		assistantWithCalls := openai.ChatCompletionAssistantMessageParam{
-			Role:      "assistant",
-			ToolCalls: toolCallsParam,
-		}
-		hist = append(hist, openai.ChatCompletionMessageParamUnion{OfAssistant: &assistantWithCalls})
-
-		for _, tc := range acc.Choices[0].Message.ToolCalls {
-			name := tc.Function.Name
-
-			// OpenAI 的 arguments 是字符串，需要解成 map[string]any
-			var args map[string]any
-			if err := json.Unmarshal([]byte(tc.Function.Arguments), &args); err != nil {
-				args = map[string]any{"_parse_error": err.Error(), "_raw": tc.Function.Arguments}
-			}
-
-			_ = emit(constant.SSEEventToolCall, map[string]any{
-				"round": round,
-				"name":  name,
-				"args":  args,
-			})
-
-			var out string
-			if name == "login" {
-				loginData, ok := utils.ExtractLoginData(h.ctx)
-				if !ok {
-					out = "tool error: no login data in context"
-				} else {
-					out, _ = sonic.MarshalString(*loginData)
-				}
-			} else {
-				toolRes, callErr := h.mcpCli.CallTool(ctx, name, args)
-				if callErr != nil {
-					out = "tool error: " + callErr.Error()
-				} else {
-					out = toolRes
-				}
-			}
-			_ = emit(constant.SSEEventToolResult, map[string]any{
-				"round":  round,
-				"name":   name,
-				"result": out,
-			})
-
-			// 工具结果回模型（重要）：OpenAI 规范用 ToolMessage，必须带 tool_call_id
-			hist = append(hist, openai.ToolMessage(out, tc.ID))
-			//logger.Infof("[tool round %d] %s executed", round, name)
-		}
-
-		// 循环进入下一轮：模型会在新的上下文（含工具结果）上继续生成
+	} else if err := h.persistConversation(ctx, userID, conversationID, res.Messages[baseLen:]); err != nil {
+		return err
 	}
+	_ = emit(constant.SSEEventDone, &model.ChatStreamDoneEvent{
+		Version: constant.SSEPayloadVersion,
+		Reason:  res.Reason,
+	})
+	return nil
 }
 
 // ChatOpenAI 非流式OpenAI聊天，支持图片和工具调用
@@ -358,190 +236,54 @@ func (h *Host) ChatOpenAI(
 	conversationID string, // uuid
 	msg string,
 	imageData []byte,
+	resources []string, // 附加到本轮的资源 URI
 ) (string, error) {
-	// 历史（OpenAI）
-	var hist []openai.ChatCompletionMessageParamUnion
-
-	// 从数据库加载该对话的历史消息填充 hist（如果不存在就保持为空）
-	conversation, err := h.templateRepository.GetConversationByID(h.ctx, conversationID)
+	if err := h.settlePendingApproval(h.ctx, userID, conversationID); err != nil {
+		return "", err
+	}
+	hist, err := h.loadConversationHistory(h.ctx, conversationID)
 	if err != nil {
 		return "", err
 	}
-	if conversation != nil {
-		if err := json.Unmarshal([]byte(conversation.Messages), &hist); err != nil {
-			logger.Errorf("failed to unmarshal conversation messages, conversationID=%s, err=%v", conversationID, err)
-			return "", err
-		}
+	userMsg, err := h.buildTurnMessage(h.ctx, userID, msg, imageData, resources)
+	if err != nil {
+		return "", err
 	}
+	hist = append(hist, userMsg)
 
-	// 记录当前历史长度，用于之后只持久化“新增部分”
-	baseLen := len(hist)
-
-	// 构建用户消息
-	if len(imageData) > 0 {
-		// 如果有图片，使用多模态消息格式
-		base64Image := base64.StdEncoding.EncodeToString(imageData)
-
-		// 创建文本部分
-		textPart := openai.ChatCompletionContentPartUnionParam{
-			OfText: &openai.ChatCompletionContentPartTextParam{
-				Type: "text",
-				Text: msg,
-			},
-		}
-
-		// 创建图片部分
-		imagePart := openai.ImageContentPart(openai.ChatCompletionContentPartImageImageURLParam{
-			URL: "data:image/jpeg;base64," + base64Image,
-		})
-
-		// 创建用户消息
-		hist = append(hist, openai.ChatCompletionMessageParamUnion{
-			OfUser: &openai.ChatCompletionUserMessageParam{
-				Role: "user",
-				Content: openai.ChatCompletionUserMessageParamContentUnion{
-					OfArrayOfContentParts: []openai.ChatCompletionContentPartUnionParam{
-						textPart,
-						imagePart,
-					},
-				},
-			},
-		})
-	} else {
-		// 纯文本消息
-		hist = append(hist, openai.UserMessage(msg))
+	opts := []AgentOption{
+		WithPolicyEndpoint(constant.ToolPolicyEndpointChat),
+		WithUsageAccount(userID, conversationID),
 	}
-
-	// 工具（OpenAI 版）- 如果有图片则不使用工具（vision模型可能不支持）
-	var tools []openai.ChatCompletionToolUnionParam
-	if len(imageData) == 0 {
-		allTools := h.mcpCli.ConvertToolsToOpenAI()
-		// 屏蔽内部工具：get_todos 和 get_course（这些工具仅供专用接口使用）
-		tools = make([]openai.ChatCompletionToolUnionParam, 0, len(allTools))
-		for _, tool := range allTools {
-			if tool.OfFunction != nil {
-				name := tool.OfFunction.Function.Name
-				if name == "get_todos" || name == "get_course" {
-					continue
-				}
-			}
-			tools = append(tools, tool)
-		}
+	route := constant.AiRouteChat
+	// 如果有图片则不使用工具（vision模型可能不支持）
+	if len(userMsg.Images) > 0 {
+		opts = append(opts, WithToolPolicy(DenyAllTools))
+		route = constant.AiRouteVision
 	}
+	opts = append(opts, WithRoute(route))
+	primary, err := h.aiProviderCli.PrimaryModel(route)
+	if err != nil {
+		return "", err
+	}
+	hist = h.fitContextWindow(h.ctx, userID, conversationID, primary, hist)
+	baseLen := len(hist) - 1
 
-	round := 0
-	for {
-		round++
-		if round > maxToolRounds {
-			// 对话结束，持久化“新增历史”
-			newMessages := hist[baseLen:]
-			if len(newMessages) > 0 {
-				if err := h.templateRepository.UpsertConversation(h.ctx, userID, conversationID, newMessages); err != nil {
-					return "", err
-				}
-			}
-
-			return "已达到工具调用轮次上限", nil
-		}
-
-		// 调用OpenAI API
-		params := openai.ChatCompletionNewParams{
-			Model:    openai.ChatModel(config.AiProvider.Model),
-			Messages: hist,
-			Tools:    tools,
-		}
-		if config.AiProvider.Options.MaxTokens != nil {
-			params.MaxTokens = openai.Int(int64(*config.AiProvider.Options.MaxTokens))
-		}
-		if config.AiProvider.Options.Temperature != nil {
-			params.Temperature = openai.Float(*config.AiProvider.Options.Temperature)
-		}
-		if config.AiProvider.Options.TopP != nil {
-			params.TopP = openai.Float(*config.AiProvider.Options.TopP)
-		}
-
-		resp, err := h.aiProviderCli.ChatOpenAI(h.ctx, params)
-		if err != nil {
-			logger.Errorf("ChatOpenAI API error: %v", err)
-			return "", err
-		}
-
-		logger.Infof("ChatOpenAI response: choices=%d", len(resp.Choices))
-		if len(resp.Choices) == 0 {
-			logger.Errorf("ChatOpenAI: no choices in response")
-
-			// 对话结束，持久化“新增历史”（虽然没有新 assistant 内容，但有这轮 user 消息）
-			newMessages := hist[baseLen:]
-			if len(newMessages) > 0 {
-				if err := h.templateRepository.UpsertConversation(h.ctx, userID, conversationID, newMessages); err != nil {
-					return "", err
-				}
-			}
-
-			return "模型返回为空", nil
-		}
-
-		logger.Infof("ChatOpenAI finish_reason=%s, content=%s, tool_calls=%d",
-			resp.Choices[0].FinishReason, resp.Choices[0].Message.Content, len(resp.Choices[0].Message.ToolCalls))
-
-		// 检查是否需要工具调用
-		if resp.Choices[0].FinishReason != "tool_calls" || len(resp.Choices[0].Message.ToolCalls) == 0 {
-			// 无工具调用，返回模型回复
-			content := resp.Choices[0].Message.Content
-			hist = append(hist, openai.AssistantMessage(content))
-
-			// 对话结束，持久化“新增历史”
-			newMessages := hist[baseLen:]
-			if len(newMessages) > 0 {
-				if err := h.templateRepository.UpsertConversation(h.ctx, userID, conversationID, newMessages); err != nil {
-					return "", err
-				}
-			}
-
-			return content, nil
-		}
-
-		// 有工具调用，处理工具
-		toolCallsParam := make([]openai.ChatCompletionMessageToolCallUnionParam, 0, len(resp.Choices[0].Message.ToolCalls))
-		for _, tc := range resp.Choices[0].Message.ToolCalls {
-			toolCallsParam = append(toolCallsParam, openai.ChatCompletionMessageToolCallUnionParam{
-				OfFunction: &openai.ChatCompletionMessageFunctionToolCallParam{
-					ID:   tc.ID,
-					Type: "function",
-					Function: openai.ChatCompletionMessageFunctionToolCallFunctionParam{
-						Name:      tc.Function.Name,
-						Arguments: tc.Function.Arguments,
-					},
-				},
-			})
-		}
-
-		// 添加assistant消息（带工具调用）
-		assistantWithCalls := openai.ChatCompletionAssistantMessageParam{
-			Role:      "assistant",
-			ToolCalls: toolCallsParam,
-		}
-		hist = append(hist, openai.ChatCompletionMessageParamUnion{OfAssistant: &assistantWithCalls})
-
-		// 执行所有工具调用
-		for _, tc := range resp.Choices[0].Message.ToolCalls {
-			name := tc.Function.Name
-
-			// OpenAI 的 arguments 是字符串，需要解成 map[string]any
-			var args map[string]any
-			if err := json.Unmarshal([]byte(tc.Function.Arguments), &args); err != nil {
-				args = map[string]any{"_parse_error": err.Error(), "_raw": tc.Function.Arguments}
-			}
-
-			out, callErr := h.mcpCli.CallTool(h.ctx, name, args)
-			if callErr != nil {
-				out = "tool error: " + callErr.Error()
-			}
+	res, err := NewAgent(h, opts...).Run(h.ctx, hist)
+	if err != nil {
+		return "", err
+	}
 
-			// 工具结果回模型（重要）：OpenAI 规范用 ToolMessage，必须带 tool_call_id
-			hist = append(hist, openai.ToolMessage(out, tc.ID))
-		}
+	// 对话结束，持久化“新增历史”（即使没有新 assistant 内容，也有这轮 user 消息）
+	if err := h.persistConversation(h.ctx, userID, conversationID, res.Messages[baseLen:]); err != nil {
+		return "", err
+	}
 
-		// 循环进入下一轮：模型会在新的上下文（含工具结果）上继续生成
+	switch res.Reason {
+	case agentStopToolRoundLimit:
+		return "已达到工具调用轮次上限", nil
+	case agentStopEmptyChoices:
+		return "模型返回为空", nil
 	}
+	return res.Content, nil
 }
@@ -0,0 +1,219 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/FantasyRL/go-mcp-demo/api/model/model"
+	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/lifecycle"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// 续传连接的空闲超时与心跳间隔
+var (
+	chatStreamIdleTimeout       = constant.SSEChatIdleTimeout
+	chatStreamHeartbeatInterval = constant.SSEHeartbeatInterval
+)
+
+// StartChatStream 启动一轮与 HTTP 连接解耦的流式生成：事件写入 Redis 缓冲，
+// 客户端断线后生成仍会继续并持久化，重连时可通过 AttachChatStream 续传。
+// 斜杠命令的展开与资源读取在返回前完成，参数错误直接返回给调用方。
+func (h *Host) StartChatStream(userID, conversationID, userMsg string, imageData []byte, resources []string) error {
+	msg, err := h.buildTurnMessage(h.ctx, userID, strings.Clone(userMsg), imageData, resources)
+	if err != nil {
+		return err
+	}
+	return h.runChatStream(userID, conversationID, func(ctx context.Context, conversationID string, emit EventSink) error {
+		return h.StreamChatOpenAI(ctx, userID, conversationID, msg, emit)
+	})
+}
+
+// runChatStream 占用对话的生成权后在后台执行 run，run 产生的事件写入对话的事件缓冲
+func (h *Host) runChatStream(
+	userID string,
+	conversationID string,
+	run func(ctx context.Context, conversationID string, emit EventSink) error,
+) error {
+	// 后台生成登记为进行中的工作，进程关闭时等待其结束，超过排空时限后取消
+	base, release, err := h.trackInflight(context.WithoutCancel(h.ctx))
+	if err != nil {
+		return err
+	}
+	token, ok, err := h.templateRepository.ResetChatStream(h.ctx, conversationID, userID)
+	if err != nil {
+		release()
+		return err
+	}
+	if !ok {
+		release()
+		return errno.NewErrNo(errno.BizLimitCode, "该对话正在生成中，请稍后重试或续传")
+	}
+
+	// 请求结束后框架会复用请求内存，参数需拷贝后再交给后台生成
+	conversationID = strings.Clone(conversationID)
+	ctx, cancel := context.WithTimeout(base, constant.ChatStreamLockExpire)
+	go func() {
+		defer release()
+		defer cancel()
+		defer func() {
+			if err := h.templateRepository.ReleaseChatStream(context.WithoutCancel(ctx), conversationID, token); err != nil {
+				logger.Errorf("StartChatStream: release stream failed: %v", err)
+			}
+		}()
+
+		emit := func(event string, v any) error {
+			data, err := sonic.Marshal(v)
+			if err != nil {
+				return err
+			}
+			_, err = h.templateRepository.AppendChatStreamEvent(ctx, conversationID, event, data)
+			return err
+		}
+		// 生成可能因超时而结束，写入错误事件时不能再使用已取消的 ctx
+		fail := func(err error) {
+			data, _ := sonic.Marshal(NewChatStreamErrorEvent(err))
+			if _, appendErr := h.templateRepository.AppendChatStreamEvent(context.WithoutCancel(ctx), conversationID,
+				constant.SSEEventError, data); appendErr != nil {
+				logger.Errorf("StartChatStream: append error event failed: %v", appendErr)
+			}
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Errorf("StartChatStream: generation panic: %v", r)
+				fail(fmt.Errorf("%v", r))
+			}
+		}()
+
+		if err := run(ctx, conversationID, emit); err != nil {
+			logger.Errorf("StartChatStream: generation failed: %v", err)
+			fail(err)
+		}
+	}()
+	return nil
+}
+
+// trackInflight 登记进行中的工作，未注入生命周期（如测试、CLI）时不登记
+func (h *Host) trackInflight(ctx context.Context) (context.Context, func(), error) {
+	if h.lifecycle == nil {
+		return ctx, func() {}, nil
+	}
+	ctx, release, err := h.lifecycle.Track(ctx)
+	if errors.Is(err, lifecycle.ErrShuttingDown) {
+		return nil, nil, errno.NewErrNo(errno.BizLimitCode, "服务正在重启，请稍后重试")
+	}
+	return ctx, release, err
+}
+
+// AttachChatStream 将调用方接到对话的事件流上：先补发 lastEventID 之后的缓冲事件，
+// 再转发实时事件，直到收到 done/error 或连接写入失败。
+// 进程关闭时等待本轮生成结束后再断开，超过排空时限则直接断开，客户端可带 Last-Event-ID 到其他实例续传
+func (h *Host) AttachChatStream(
+	ctx context.Context,
+	userID string,
+	conversationID string,
+	lastEventID int64,
+	write func(ev *repository.ChatStreamEvent) error,
+) error {
+	ctx, release, err := h.trackInflight(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	turn, err := h.templateRepository.GetChatStreamTurn(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	if turn == nil {
+		return errno.NewErrNo(errno.BizNotExist, "没有可续传的对话流")
+	}
+	if turn.UserID != userID {
+		return errno.NewErrNo(errno.AuthInvalidCode, "无权访问该对话流")
+	}
+	// 续传位置必须落在当前一轮内，上一轮的 id 续传到本轮会丢失本轮开头的事件
+	if lastEventID != 0 && (lastEventID <= turn.StartID || lastEventID > turn.LastID) {
+		return errno.NewErrNo(errno.ParamInvalidCode, "Last-Event-ID 不属于当前一轮对话流")
+	}
+
+	// 先订阅再读缓冲，两者的重叠部分按 id 去重，保证不丢事件
+	live, unsubscribe, err := h.templateRepository.SubscribeChatStream(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = unsubscribe() }()
+
+	buffered, err := h.templateRepository.ListChatStreamEvents(ctx, conversationID, lastEventID)
+	if err != nil {
+		return err
+	}
+	last := lastEventID
+	for _, ev := range buffered {
+		if err = write(ev); err != nil {
+			return err
+		}
+		last = ev.ID
+		if isTerminalStreamEvent(ev.Event) {
+			return nil
+		}
+	}
+
+	idle := time.NewTimer(chatStreamIdleTimeout)
+	defer idle.Stop()
+	heartbeat := time.NewTicker(chatStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-heartbeat.C:
+			// heartbeat 只发给当前连接，不写入缓冲、不带 id，避免影响续传位置
+			data, _ := sonic.Marshal(&model.ChatStreamHeartbeatEvent{
+				Version:   constant.SSEPayloadVersion,
+				Timestamp: now.UnixMilli(),
+			})
+			if err = write(&repository.ChatStreamEvent{Event: constant.SSEEventHeartbeat, Data: data}); err != nil {
+				return err
+			}
+		case <-idle.C:
+			return errno.NewErrNo(errno.InternalTimeoutErrorCode, "等待对话事件超时")
+		case ev, ok := <-live:
+			if !ok {
+				return nil
+			}
+			if ev.ID <= last {
+				continue
+			}
+			if err = write(ev); err != nil {
+				return err
+			}
+			last = ev.ID
+			if isTerminalStreamEvent(ev.Event) {
+				return nil
+			}
+			idle.Reset(chatStreamIdleTimeout)
+			heartbeat.Reset(chatStreamHeartbeatInterval)
+		}
+	}
+}
+
+func isTerminalStreamEvent(event string) bool {
+	return event == constant.SSEEventDone || event == constant.SSEEventError
+}
+
+// NewChatStreamErrorEvent 将错误转成 error 事件负载，错误码与普通接口保持一致
+func NewChatStreamErrorEvent(err error) *model.ChatStreamErrorEvent {
+	e := errno.ConvertErr(err)
+	return &model.ChatStreamErrorEvent{
+		Version: constant.SSEPayloadVersion,
+		Code:    e.ErrorCode,
+		Message: e.ErrorMsg,
+	}
+}
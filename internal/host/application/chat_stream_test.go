@@ -0,0 +1,252 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/api/model/model"
+	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+)
+
+func (r *testRepository) ListChatStreamEvents(ctx context.Context, conversationID string, afterID int64) ([]*repository.ChatStreamEvent, error) {
+	r.mu.Lock()
+	hook := r.beforeList
+	r.beforeList = nil
+	r.mu.Unlock()
+	if hook != nil {
+		hook()
+	}
+	return r.TemplateRepository.ListChatStreamEvents(ctx, conversationID, afterID)
+}
+
+// scriptedRun 依次推送 n 条 delta 后推送 done；step 不为 nil 时每条 delta 之前等待放行，
+// 推送完成后把序号写入 emitted
+func scriptedRun(n int, step <-chan struct{}, emitted chan<- int) func(ctx context.Context, conversationID string, emit EventSink) error {
+	return func(ctx context.Context, _ string, emit EventSink) error {
+		for i := 1; i <= n; i++ {
+			if step != nil {
+				select {
+				case <-step:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			if err := emit(constant.SSEEventDelta, map[string]any{"text": fmt.Sprint(i)}); err != nil {
+				return err
+			}
+			if emitted != nil {
+				emitted <- i
+			}
+		}
+		return emit(constant.SSEEventDone, map[string]any{"reason": agentStopCompleted})
+	}
+}
+
+// waitStreamReleased 等待后台生成释放对话的生成锁
+func waitStreamReleased(mr *miniredis.Miniredis, conversationID string) {
+	deadline := time.Now().Add(5 * time.Second)
+	for mr.Exists("chat_stream:"+conversationID+":lock") && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func eventIDs(events []*repository.ChatStreamEvent) []int64 {
+	out := make([]int64, 0, len(events))
+	for _, ev := range events {
+		out = append(out, ev.ID)
+	}
+	return out
+}
+
+func idRange(from, to int64) []int64 {
+	var out []int64
+	for id := from; id <= to; id++ {
+		out = append(out, id)
+	}
+	return out
+}
+
+func Test_ChatStream(t *testing.T) {
+	Convey("Test resumable chat streams", t, func() {
+		const (
+			user = "u1"
+			conv = "conv-stream"
+		)
+		stub := newStubModel()
+		defer stub.Close()
+		h, repo, mr := newStreamHost(&fakeToolClient{}, stub)
+		defer mr.Close()
+
+		Convey("a finished stream replays everything after Last-Event-ID", func() {
+			emitted := make(chan int, 5)
+			So(h.runChatStream(user, conv, scriptedRun(5, nil, emitted)), ShouldBeNil)
+			events, err := attachAll(h, user, conv, 0)
+			So(err, ShouldBeNil)
+			So(eventIDs(events), ShouldResemble, idRange(1, 6))
+			So(events[5].Event, ShouldEqual, constant.SSEEventDone)
+
+			events, err = attachAll(h, user, conv, 3)
+			So(err, ShouldBeNil)
+			So(eventIDs(events), ShouldResemble, idRange(4, 6))
+			So(string(events[0].Data), ShouldContainSubstring, `"4"`)
+		})
+
+		Convey("only the owner can attach and only one generation runs at a time", func() {
+			step := make(chan struct{})
+			So(h.runChatStream(user, conv, scriptedRun(1, step, nil)), ShouldBeNil)
+
+			err := h.runChatStream(user, conv, scriptedRun(1, nil, nil))
+			So(errno.ConvertErr(err).ErrorCode, ShouldEqual, errno.BizLimitCode)
+			_, err = attachAll(h, "u2", conv, 0)
+			So(errno.ConvertErr(err).ErrorCode, ShouldEqual, errno.AuthInvalidCode)
+			_, err = attachAll(h, user, "conv-missing", 0)
+			So(errno.ConvertErr(err).ErrorCode, ShouldEqual, errno.BizNotExist)
+
+			close(step)
+			_, err = attachAll(h, user, conv, 0)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("events published between subscribing and reading the buffer are delivered once", func() {
+			step := make(chan struct{})
+			emitted := make(chan int)
+			So(h.runChatStream(user, conv, scriptedRun(4, step, emitted)), ShouldBeNil)
+			for range 2 {
+				step <- struct{}{}
+				<-emitted
+			}
+			// 第 3 条事件在订阅之后、读缓冲之前写入，缓冲与实时通道都会带上它
+			repo.mu.Lock()
+			repo.beforeList = func() {
+				step <- struct{}{}
+				<-emitted
+			}
+			repo.mu.Unlock()
+			go func() {
+				step <- struct{}{}
+				<-emitted
+			}()
+
+			events, err := attachAll(h, user, conv, 0)
+			So(err, ShouldBeNil)
+			So(eventIDs(events), ShouldResemble, idRange(1, 5))
+		})
+
+		Convey("reconnecting with the last received id loses and duplicates nothing", func() {
+			const n = 10
+			step := make(chan struct{})
+			So(h.runChatStream(user, conv, scriptedRun(n, step, nil)), ShouldBeNil)
+			go func() {
+				for range n {
+					step <- struct{}{}
+					time.Sleep(5 * time.Millisecond)
+				}
+			}()
+
+			// 第一次连接收到 4 条后断开
+			var got []*repository.ChatStreamEvent
+			errGone := errors.New("client gone")
+			err := h.AttachChatStream(context.Background(), user, conv, 0, func(ev *repository.ChatStreamEvent) error {
+				if ev.Event == constant.SSEEventHeartbeat {
+					return nil
+				}
+				if len(got) == 4 {
+					return errGone
+				}
+				got = append(got, ev)
+				return nil
+			})
+			So(errors.Is(err, errGone), ShouldBeTrue)
+
+			rest, err := attachAll(h, user, conv, got[len(got)-1].ID)
+			So(err, ShouldBeNil)
+			got = append(got, rest...)
+			So(eventIDs(got), ShouldResemble, idRange(1, n+1))
+			for i, ev := range got[:n] {
+				So(string(ev.Data), ShouldContainSubstring, fmt.Sprintf(`"%d"`, i+1))
+			}
+		})
+
+		Convey("ids keep increasing across turns and cursors from another turn are rejected", func() {
+			So(h.runChatStream(user, conv, scriptedRun(2, nil, nil)), ShouldBeNil)
+			events, err := attachAll(h, user, conv, 0)
+			So(err, ShouldBeNil)
+			So(eventIDs(events), ShouldResemble, idRange(1, 3))
+			waitStreamReleased(mr, conv)
+
+			step := make(chan struct{})
+			So(h.runChatStream(user, conv, scriptedRun(2, step, nil)), ShouldBeNil)
+			step <- struct{}{}
+			// 上一轮的 id 与尚未分配的 id 都不能用于续传本轮
+			for _, cursor := range []int64{2, 3, 5} {
+				_, err = attachAll(h, user, conv, cursor)
+				So(errno.ConvertErr(err).ErrorCode, ShouldEqual, errno.ParamInvalidCode)
+			}
+			close(step)
+			events, err = attachAll(h, user, conv, 0)
+			So(err, ShouldBeNil)
+			So(eventIDs(events), ShouldResemble, idRange(4, 6))
+			events, err = attachAll(h, user, conv, 4)
+			So(err, ShouldBeNil)
+			So(eventIDs(events), ShouldResemble, idRange(5, 6))
+		})
+
+		Convey("a late release does not free the lock taken by the next turn", func() {
+			ctx := context.Background()
+			stale, ok, err := repo.ResetChatStream(ctx, conv, user)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+			// 上一轮超过锁时限仍未结束，锁过期后被下一轮占用
+			mr.FastForward(constant.ChatStreamLockExpire + time.Second)
+			current, ok, err := repo.ResetChatStream(ctx, conv, user)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			So(repo.ReleaseChatStream(ctx, conv, stale), ShouldBeNil)
+			_, ok, err = repo.ResetChatStream(ctx, conv, user)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeFalse)
+
+			So(repo.ReleaseChatStream(ctx, conv, current), ShouldBeNil)
+			_, ok, err = repo.ResetChatStream(ctx, conv, user)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey("an idle attach sends heartbeats and gives up after the idle timeout", func() {
+			idle, heartbeat := chatStreamIdleTimeout, chatStreamHeartbeatInterval
+			chatStreamIdleTimeout, chatStreamHeartbeatInterval = 200*time.Millisecond, 50*time.Millisecond
+			defer func() { chatStreamIdleTimeout, chatStreamHeartbeatInterval = idle, heartbeat }()
+
+			step := make(chan struct{})
+			So(h.runChatStream(user, conv, scriptedRun(1, step, nil)), ShouldBeNil)
+			defer close(step)
+
+			heartbeats := 0
+			start := time.Now()
+			err := h.AttachChatStream(context.Background(), user, conv, 0, func(ev *repository.ChatStreamEvent) error {
+				if ev.Event == constant.SSEEventHeartbeat {
+					So(ev.ID, ShouldEqual, 0)
+					var hb model.ChatStreamHeartbeatEvent
+					So(json.Unmarshal(ev.Data, &hb), ShouldBeNil)
+					So(hb.Version, ShouldEqual, constant.SSEPayloadVersion)
+					So(hb.Timestamp, ShouldBeGreaterThanOrEqualTo, start.UnixMilli())
+					heartbeats++
+				}
+				return nil
+			})
+			So(errno.ConvertErr(err).ErrorCode, ShouldEqual, errno.InternalTimeoutErrorCode)
+			So(time.Since(start), ShouldBeGreaterThanOrEqualTo, 200*time.Millisecond)
+			So(heartbeats, ShouldBeGreaterThanOrEqualTo, 2)
+		})
+	})
+}
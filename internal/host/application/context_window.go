@@ -0,0 +1,262 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// compactSummaryPrefix 注入给模型的滚动摘要消息前缀
+const compactSummaryPrefix = "以下是本对话较早内容的摘要，较早的原始消息已省略：\n"
+
+// compactExistingSummaryPrefix 增量压缩时提供给 summarize 提示词的已有摘要
+const compactExistingSummaryPrefix = "本对话较早的内容已有摘要，请在此基础上合并新的内容：\n\n"
+
+// contextWindowBudget 模型可用于输入历史的 token 预算。
+// 窗口大小优先取 context.windows，其次取注册表中声明的 context_length
+func (h *Host) contextWindowBudget(modelName string) int {
+	cfg := config.AiProvider.Context
+	window := h.aiProviderCli.ContextLength(modelName)
+	if window <= 0 {
+		window = cfg.DefaultWindow
+	}
+	for _, w := range cfg.Windows {
+		if w.Model == modelName && w.Window > 0 {
+			window = w.Window
+			break
+		}
+	}
+	if window <= 0 {
+		window = constant.ContextDefaultWindow
+	}
+	reserve := cfg.ReserveTokens
+	if reserve <= 0 {
+		reserve = constant.ContextDefaultReserveTokens
+	}
+	return window - reserve
+}
+
+// toolResultMaxChars 单条工具结果进入上下文的最大字符数
+func toolResultMaxChars() int {
+	if n := config.AiProvider.Context.ToolResultMaxChars; n > 0 {
+		return n
+	}
+	return constant.ContextDefaultToolResultMaxChars
+}
+
+func historyToolResultMaxChars() int {
+	if n := config.AiProvider.Context.HistoryToolResultMaxChars; n > 0 {
+		return n
+	}
+	return constant.ContextDefaultHistoryToolResultMaxChars
+}
+
+// estimateTokens 粗略估算文本的 token 数：中日韩字符约 1 字 1 token，其余约 4 字符 1 token。
+// 各家分词器不同，这里只需要偏保守的估计来决定何时压缩
+func estimateTokens(s string) int {
+	cjk, other := 0, 0
+	for _, r := range s {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			cjk++
+		} else {
+			other++
+		}
+	}
+	return cjk + (other+3)/4
+}
+
+// estimateMessageTokens 按序列化后的内容估算，tool_calls 的参数也会计入
+func estimateMessageTokens(msg ai_provider.ChatMessage) int {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return constant.ContextMessageOverheadTokens
+	}
+	return estimateTokens(string(b)) + constant.ContextMessageOverheadTokens
+}
+
+func estimateHistoryTokens(hist []ai_provider.ChatMessage) int {
+	total := 0
+	for _, msg := range hist {
+		total += estimateMessageTokens(msg)
+	}
+	return total
+}
+
+// truncateToolResult 截断过长的工具结果，保留开头部分并注明省略的字符数
+func truncateToolResult(out string, maxChars int) string {
+	n := utf8.RuneCountInString(out)
+	if maxChars <= 0 || n <= maxChars {
+		return out
+	}
+	runes := []rune(out)
+	return fmt.Sprintf("%s\n...[工具结果过长，已省略 %d 个字符]", string(runes[:maxChars]), n-maxChars)
+}
+
+// fitContextWindow 让历史满足模型的上下文预算：
+//  1. 截断较早轮次中过长的工具结果（只改内容，tool_call_id 不变）
+//  2. 仍然超出预算时，按"轮"（以用户消息开头）整体丢弃较早的消息，用滚动摘要替代，
+//     这样 assistant 的 tool_calls 与对应的 tool 消息不会被拆开
+//
+// hist 最后一轮应为本次的用户消息，该轮始终保留
+func (h *Host) fitContextWindow(
+	ctx context.Context,
+	userID string,
+	conversationID string,
+	modelName string,
+	hist []ai_provider.ChatMessage,
+) []ai_provider.ChatMessage {
+	budget := h.contextWindowBudget(modelName)
+
+	// 开头的 system 消息（系统提示词）始终保留
+	prefix := 0
+	for prefix < len(hist) && hist[prefix].Role == ai_provider.RoleSystem {
+		prefix++
+	}
+	turnStarts := make([]int, 0)
+	for i := prefix; i < len(hist); i++ {
+		if hist[i].Role == ai_provider.RoleUser {
+			turnStarts = append(turnStarts, i)
+		}
+	}
+	if len(turnStarts) == 0 {
+		return hist
+	}
+	lastTurn := turnStarts[len(turnStarts)-1]
+
+	hist = elideHistoryToolResults(hist, lastTurn, historyToolResultMaxChars())
+	if estimateHistoryTokens(hist) <= budget || len(turnStarts) == 1 {
+		return hist
+	}
+
+	compacted, err := h.compactHistory(ctx, userID, conversationID, hist, prefix, turnStarts, budget)
+	if err != nil {
+		// 摘要失败时退化为直接丢弃较早的轮次，保证请求还能发出去
+		logger.Errorf("fitContextWindow: compact conversation %s failed: %v", conversationID, err)
+		cut := chooseCompactCut(hist, prefix, turnStarts, budget, 0)
+		return append(append([]ai_provider.ChatMessage{}, hist[:prefix]...), hist[cut:]...)
+	}
+	if tokens := estimateHistoryTokens(compacted); tokens > budget {
+		logger.Warnf("fitContextWindow: conversation %s still exceeds budget after compaction: %d > %d",
+			conversationID, tokens, budget)
+	}
+	return compacted
+}
+
+// elideHistoryToolResults 截断 end 之前的工具结果，返回新切片，不修改入参
+func elideHistoryToolResults(hist []ai_provider.ChatMessage, end int, maxChars int) []ai_provider.ChatMessage {
+	out := make([]ai_provider.ChatMessage, len(hist))
+	copy(out, hist)
+	for i := 0; i < end; i++ {
+		if out[i].Role != ai_provider.RoleTool {
+			continue
+		}
+		out[i].Content = truncateToolResult(out[i].Content, maxChars)
+	}
+	return out
+}
+
+// compactHistory 用滚动摘要替代 [prefix, cut) 范围内的消息。
+// 摘要存放在对话记录的 compact_summary 中，与用户手动生成的总结互不影响；
+// compacted_until 记录已覆盖到的位置，是持久化的 messages 中的下标。
+// 开头的 system 消息（新对话的系统提示词）不会持久化，换算时需减去 prefix。
+// 覆盖范围足够时直接复用，否则在现有摘要的基础上增量生成
+func (h *Host) compactHistory(
+	ctx context.Context,
+	userID string,
+	conversationID string,
+	hist []ai_provider.ChatMessage,
+	prefix int,
+	turnStarts []int,
+	budget int,
+) ([]ai_provider.ChatMessage, error) {
+	conv, err := h.templateRepository.GetConversationByID(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	covered, summary := prefix, ""
+	if conv != nil && conv.CompactedUntil > 0 {
+		// 只有落在轮次边界上的覆盖位置才可复用（历史只追加，之前的边界仍然有效）
+		if at := prefix + int(conv.CompactedUntil); slices.Contains(turnStarts, at) {
+			covered, summary = at, conv.CompactSummary
+			out := withCompactSummary(hist, prefix, covered, summary)
+			if estimateHistoryTokens(out) <= budget {
+				return out, nil
+			}
+		}
+	}
+
+	// 压缩到预算的一定比例，为之后几轮留出余量，避免每轮都重新生成摘要
+	target := int(float64(budget) * constant.ContextCompactTargetRatio)
+	cut := chooseCompactCut(hist, prefix, turnStarts, target, estimateTokens(compactSummaryPrefix)+512)
+	if cut <= covered {
+		// 已覆盖到最后一轮之前，无法再压缩，只能沿用现有摘要
+		return withCompactSummary(hist, prefix, covered, summary), nil
+	}
+
+	lines, err := historyLines(hist[covered:cut])
+	if err != nil {
+		return nil, err
+	}
+	existing := h.buildExistingSummaryInfo(nil)
+	if summary != "" {
+		existing = compactExistingSummaryPrefix + summary
+	}
+	prompt, err := h.renderSummarizePrompt(conversationID, lines, existing)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := h.invokeSummarizeModel(ctx, userID, conversationID, prompt)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := parseConversationSummary(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.templateRepository.UpdateConversationCompaction(ctx, conversationID, payload.Summary, cut-prefix); err != nil {
+		return nil, err
+	}
+	logger.Infof("compactHistory: conversation %s compacted messages [%d, %d)", conversationID, covered-prefix, cut-prefix)
+	return withCompactSummary(hist, prefix, cut, payload.Summary), nil
+}
+
+// chooseCompactCut 选择最靠前的、使剩余历史不超过 target 的轮次起点，最多压缩到最后一轮之前。
+// reserved 为摘要消息预留的 token
+func chooseCompactCut(hist []ai_provider.ChatMessage, prefix int, turnStarts []int, target int, reserved int) int {
+	fixed := estimateHistoryTokens(hist[:prefix]) + reserved
+	for _, start := range turnStarts[1:] {
+		if fixed+estimateHistoryTokens(hist[start:]) <= target {
+			return start
+		}
+	}
+	return turnStarts[len(turnStarts)-1]
+}
+
+func withCompactSummary(hist []ai_provider.ChatMessage, prefix, cut int, summary string) []ai_provider.ChatMessage {
+	out := make([]ai_provider.ChatMessage, 0, prefix+1+len(hist)-cut)
+	out = append(out, hist[:prefix]...)
+	out = append(out, ai_provider.SystemMessage(compactSummaryPrefix+summary))
+	return append(out, hist[cut:]...)
+}
+
+// historyLines 复用手动总结的历史格式
+func historyLines(hist []ai_provider.ChatMessage) ([]string, error) {
+	b, err := json.Marshal(hist)
+	if err != nil {
+		return nil, err
+	}
+	var messages []map[string]interface{}
+	if err = json.Unmarshal(b, &messages); err != nil {
+		return nil, err
+	}
+	return formatHistoryLines(messages), nil
+}
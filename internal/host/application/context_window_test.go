@@ -0,0 +1,232 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// compaction testRepository 中保存的滚动摘要
+type compaction struct {
+	summary string
+	until   int
+}
+
+func (r *testRepository) UpdateConversationCompaction(_ context.Context, id string, summary string, compactedUntil int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.compactions[id] = compaction{summary: summary, until: compactedUntil}
+	return nil
+}
+
+func (r *testRepository) compaction(id string) compaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.compactions[id]
+}
+
+// historyTurns 生成第 from 到 to 轮的问答，每条消息约 400 token，内容带轮次标记便于断言
+func historyTurns(from, to int) []ai_provider.ChatMessage {
+	pad := strings.Repeat("历史", 200)
+	var out []ai_provider.ChatMessage
+	for i := from; i <= to; i++ {
+		out = append(out,
+			ai_provider.UserMessage(fmt.Sprintf("第%d轮提问%s", i, pad)),
+			ai_provider.AssistantMessage(fmt.Sprintf("第%d轮回答%s", i, pad)),
+		)
+	}
+	return out
+}
+
+func summaryReply(summary string) stubReply {
+	return stubReply{content: fmt.Sprintf(`{"summary":%q,"tags":[],"tool_calls":[],"notes":{}}`, summary)}
+}
+
+// useContextWindow 为 stub-model 配置上下文窗口，返回恢复原配置的函数
+func useContextWindow(window, reserve int) func() {
+	saved := config.AiProvider.Context
+	config.AiProvider.Context = config.AiContextConfig{
+		Windows:       []config.ModelWindow{{Model: "stub-model", Window: window}},
+		ReserveTokens: reserve,
+	}
+	return func() { config.AiProvider.Context = saved }
+}
+
+func Test_contextWindowBudget(t *testing.T) {
+	Convey("Test context window budget", t, func() {
+		stub := newStubModel()
+		defer stub.Close()
+		h := newTestHost(&fakeToolClient{}, stub)
+		saved := config.AiProvider.Context
+		defer func() { config.AiProvider.Context = saved }()
+
+		cases := []struct {
+			name     string
+			declared int // 注册表中声明的 context_length
+			cfg      config.AiContextConfig
+			want     int
+		}{
+			{
+				name: "nothing configured falls back to the built-in window and reserve",
+				want: constant.ContextDefaultWindow - constant.ContextDefaultReserveTokens,
+			},
+			{
+				name: "a model without a declared length uses the default window",
+				cfg:  config.AiContextConfig{DefaultWindow: 8000, ReserveTokens: 1000},
+				want: 7000,
+			},
+			{
+				name:     "the registry context length wins over the default window",
+				declared: 32000,
+				cfg:      config.AiContextConfig{DefaultWindow: 8000, ReserveTokens: 1000},
+				want:     31000,
+			},
+			{
+				name:     "a per-model window wins over the registry",
+				declared: 32000,
+				cfg: config.AiContextConfig{
+					DefaultWindow: 8000,
+					Windows:       []config.ModelWindow{{Model: "other", Window: 4000}, {Model: "stub-model", Window: 16000}},
+					ReserveTokens: 1000,
+				},
+				want: 15000,
+			},
+		}
+		for _, c := range cases {
+			Convey(c.name, func() {
+				config.AiProvider.Providers[0].Models[0].ContextLength = c.declared
+				h.aiProviderCli = ai_provider.NewAiProviderClient()
+				config.AiProvider.Context = c.cfg
+				So(h.contextWindowBudget("stub-model"), ShouldEqual, c.want)
+			})
+		}
+	})
+}
+
+func Test_chooseCompactCut(t *testing.T) {
+	Convey("Test choosing where to cut the history", t, func() {
+		// 系统提示词 + 4 轮，轮次起点为 1、3、5、7，最后一轮只有用户消息
+		hist := append([]ai_provider.ChatMessage{ai_provider.SystemMessage("system")}, historyTurns(1, 3)...)
+		hist = append(hist, historyTurns(4, 4)[0])
+		turnStarts := []int{1, 3, 5, 7}
+		const reserved = 100
+		keep := func(from int) int {
+			return estimateHistoryTokens(hist[:1]) + reserved + estimateHistoryTokens(hist[from:])
+		}
+
+		cases := []struct {
+			name   string
+			target int
+			want   int
+		}{
+			{"a roomy target still drops the first turn", keep(1) * 2, 3},
+			{"the earliest turn whose remainder fits is chosen", keep(5), 5},
+			{"one token short of a boundary moves to the next turn", keep(5) - 1, 7},
+			{"a tiny target keeps only the last turn", 1, 7},
+		}
+		for _, c := range cases {
+			Convey(c.name, func() {
+				So(chooseCompactCut(hist, 1, turnStarts, c.target, reserved), ShouldEqual, c.want)
+			})
+		}
+	})
+}
+
+func Test_fitContextWindow(t *testing.T) {
+	Convey("Test fitting history into the context window", t, func() {
+		const (
+			user = "u1"
+			conv = "conv-window"
+		)
+		stub := newStubModel()
+		defer stub.Close()
+		h, repo, mr := newStreamHost(&fakeToolClient{}, stub)
+		defer mr.Close()
+
+		persisted := historyTurns(1, 6)
+		repo.conversations[conv] = persisted
+		// 开头的系统提示词不会持久化，压缩位置需按 messages 中的下标记录
+		hist := append([]ai_provider.ChatMessage{ai_provider.SystemMessage("system")}, persisted...)
+		hist = append(hist, historyTurns(7, 7)[0])
+		budget := estimateHistoryTokens(hist) * 2 / 3
+		defer useContextWindow(budget+1, 1)()
+
+		Convey("history within the budget is left untouched", func() {
+			defer useContextWindow(estimateHistoryTokens(hist)+1, 1)()
+			So(h.fitContextWindow(context.Background(), user, conv, "stub-model", hist), ShouldResemble, hist)
+			So(stub.received(), ShouldBeEmpty)
+			So(repo.compaction(conv), ShouldResemble, compaction{})
+		})
+
+		Convey("old turns are summarized and the cut is stored as a persisted index", func() {
+			stub.replies = []stubReply{summaryReply("摘要一")}
+			out := h.fitContextWindow(context.Background(), user, conv, "stub-model", hist)
+
+			c := repo.compaction(conv)
+			So(c.summary, ShouldEqual, "摘要一")
+			So(c.until, ShouldBeGreaterThan, 0)
+			So(persisted[c.until].Role, ShouldEqual, ai_provider.RoleUser)
+			So(out[0], ShouldResemble, hist[0])
+			So(out[1].Role, ShouldEqual, ai_provider.RoleSystem)
+			So(out[1].Content, ShouldEqual, compactSummaryPrefix+"摘要一")
+			So(out[2:], ShouldResemble, hist[1+c.until:])
+			So(estimateHistoryTokens(out), ShouldBeLessThanOrEqualTo, budget)
+
+			reqs := stub.received()
+			So(reqs, ShouldHaveLength, 1)
+			prompt := string(reqs[0].Messages[0].Content)
+			So(prompt, ShouldContainSubstring, "第1轮提问")
+			So(prompt, ShouldNotContainSubstring, fmt.Sprintf("第%d轮提问", c.until/2+1))
+
+			Convey("the next turn reuses the summary against the persisted messages", func() {
+				persisted = append(persisted, historyTurns(7, 7)...)
+				next := append(append([]ai_provider.ChatMessage{}, persisted...), historyTurns(8, 8)[0])
+				out := h.fitContextWindow(context.Background(), user, conv, "stub-model", next)
+
+				So(stub.received(), ShouldHaveLength, 1)
+				So(out[0].Content, ShouldEqual, compactSummaryPrefix+"摘要一")
+				So(out[1:], ShouldResemble, next[c.until:])
+			})
+
+			Convey("growing past the budget again extends the existing summary", func() {
+				persisted = append(persisted, historyTurns(7, 10)...)
+				next := append(append([]ai_provider.ChatMessage{}, persisted...), historyTurns(11, 11)[0])
+				stub.replies = []stubReply{summaryReply("摘要二")}
+				out := h.fitContextWindow(context.Background(), user, conv, "stub-model", next)
+
+				again := repo.compaction(conv)
+				So(again.summary, ShouldEqual, "摘要二")
+				So(again.until, ShouldBeGreaterThan, c.until)
+				So(next[again.until].Role, ShouldEqual, ai_provider.RoleUser)
+				So(out[0].Content, ShouldEqual, compactSummaryPrefix+"摘要二")
+				So(out[1:], ShouldResemble, next[again.until:])
+
+				reqs := stub.received()
+				So(reqs, ShouldHaveLength, 2)
+				prompt := string(reqs[1].Messages[0].Content)
+				// 只把新覆盖的消息交给模型，已有摘要作为上下文
+				So(prompt, ShouldContainSubstring, "摘要一")
+				So(prompt, ShouldContainSubstring, fmt.Sprintf("第%d轮提问", c.until/2+1))
+				So(prompt, ShouldNotContainSubstring, "第1轮提问")
+			})
+		})
+
+		Convey("a failed summary drops old turns without touching the stored state", func() {
+			stub.replies = []stubReply{{content: "not json"}}
+			out := h.fitContextWindow(context.Background(), user, conv, "stub-model", hist)
+
+			So(repo.compaction(conv), ShouldResemble, compaction{})
+			So(out[0], ShouldResemble, hist[0])
+			So(out[1].Role, ShouldEqual, ai_provider.RoleUser)
+			So(out[len(out)-1], ShouldResemble, hist[len(hist)-1])
+			So(estimateHistoryTokens(out), ShouldBeLessThanOrEqualTo, budget)
+		})
+	})
+}
@@ -1,15 +1,17 @@
 package application
 
 import (
-	"encoding/json"
 	"fmt"
 	"time"
 
-	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
-	openai "github.com/openai/openai-go/v2"
 )
 
+// dailyScheduleMaxRounds 限制最多5轮，避免死循环
+const dailyScheduleMaxRounds = 5
+
 // dailySchedulePrompt 专门用于生成每日日程的系统提示词
 const dailySchedulePrompt = `你是一个智能日程助手，需要根据用户的课表和待办事项，生成今日的完整日程安排。
 
@@ -20,8 +22,7 @@ const dailySchedulePrompt = `你是一个智能日程助手，需要根据用户
 4. 结合课程和待办事项，生成一份清晰的今日安排
 
 ## 学期代码规则
-- 当前是 2025年12月，所以当前学期是 202501（2025年秋季学期）
-- 2026年3月则是202502,2026年9月则是202601
+- 查询当前学期时 get_course_local 无需传 term，系统会自动填写
 - 学期代码格式：YYYYSS，01表示秋季学期，02表示春季学期
 
 ## 课程节次与时间对应关系
@@ -113,119 +114,29 @@ func (h *Host) generateDailySchedule(userID string) (string, error) {
 	dateInfo := fmt.Sprintf("今天是 %s，%s", now.Format("2006年01月02日"), weekdayName)
 
 	// 构建对话历史（只包含系统提示词和用户请求）
-	hist := []openai.ChatCompletionMessageParamUnion{
-		openai.SystemMessage(dailySchedulePrompt),
-		openai.UserMessage(fmt.Sprintf("%s。请帮我生成今天的日程安排。我的用户ID是：%s", dateInfo, userID)),
+	hist := []ai_provider.ChatMessage{
+		ai_provider.SystemMessage(dailySchedulePrompt),
+		ai_provider.UserMessage(fmt.Sprintf("%s。请帮我生成今天的日程安排。", dateInfo)),
 	}
 
-	// 只注册 get_todos 和 get_course 这两个工具
-	allTools := h.mcpCli.ConvertToolsToOpenAI()
-	tools := make([]openai.ChatCompletionToolUnionParam, 0, 2)
-	for _, tool := range allTools {
-		if tool.OfFunction != nil {
-			name := tool.OfFunction.Function.Name
-			if name == "get_todos" || name == "get_course" {
-				tools = append(tools, tool)
-			}
-		}
+	res, err := NewAgent(h,
+		WithRoute(constant.AiRouteSchedule),
+		WithMaxRounds(dailyScheduleMaxRounds),
+		// 可用工具由策略中 daily_schedule 接口的规则决定（默认只有 get_todos 和 get_course_local），
+		// user_id 与当前学期由 mcp.inject 规则按记账用户注入
+		WithPolicyEndpoint(constant.ToolPolicyEndpointDailySchedule),
+		WithFailOnToolError(),
+		WithUsageAccount(userID, ""),
+	).Run(ctx, hist)
+	if err != nil {
+		return "", err
 	}
 
-	// 工具调用循环
-	round := 0
-	maxRounds := 5 // 限制最多5轮，避免死循环
-
-	for {
-		round++
-		if round > maxRounds {
-			return "", fmt.Errorf("达到最大工具调用轮次(%d)", maxRounds)
-		}
-
-		// 调用 OpenAI API
-		params := openai.ChatCompletionNewParams{
-			Model:    openai.ChatModel(config.AiProvider.Model),
-			Messages: hist,
-		}
-		if len(tools) > 0 {
-			params.Tools = tools
-		}
-		if config.AiProvider.Options.MaxTokens != nil {
-			params.MaxTokens = openai.Int(int64(*config.AiProvider.Options.MaxTokens))
-		}
-		if config.AiProvider.Options.Temperature != nil {
-			params.Temperature = openai.Float(*config.AiProvider.Options.Temperature)
-		}
-
-		resp, err := h.aiProviderCli.ChatOpenAI(ctx, params)
-		if err != nil {
-			return "", fmt.Errorf("ChatOpenAI API error: %w", err)
-		}
-
-		if len(resp.Choices) == 0 {
-			return "", fmt.Errorf("模型返回为空")
-		}
-
-		// 检查是否需要工具调用
-		if resp.Choices[0].FinishReason != "tool_calls" || len(resp.Choices[0].Message.ToolCalls) == 0 {
-			// 无工具调用，返回最终结果
-			return resp.Choices[0].Message.Content, nil
-		}
-
-		// 有工具调用，构建 assistant 消息
-		toolCallsParam := make([]openai.ChatCompletionMessageToolCallUnionParam, 0, len(resp.Choices[0].Message.ToolCalls))
-		for _, tc := range resp.Choices[0].Message.ToolCalls {
-			toolCallsParam = append(toolCallsParam, openai.ChatCompletionMessageToolCallUnionParam{
-				OfFunction: &openai.ChatCompletionMessageFunctionToolCallParam{
-					ID:   tc.ID,
-					Type: "function",
-					Function: openai.ChatCompletionMessageFunctionToolCallFunctionParam{
-						Name:      tc.Function.Name,
-						Arguments: tc.Function.Arguments,
-					},
-				},
-			})
-		}
-
-		assistantWithCalls := openai.ChatCompletionAssistantMessageParam{
-			Role:      "assistant",
-			ToolCalls: toolCallsParam,
-		}
-		hist = append(hist, openai.ChatCompletionMessageParamUnion{OfAssistant: &assistantWithCalls})
-
-		// 执行所有工具调用
-		for _, tc := range resp.Choices[0].Message.ToolCalls {
-			name := tc.Function.Name
-
-			// 解析参数
-			var args map[string]any
-			if err := json.Unmarshal([]byte(tc.Function.Arguments), &args); err != nil {
-				args = map[string]any{"_parse_error": err.Error(), "_raw": tc.Function.Arguments}
-			}
-
-			// 特殊处理：自动注入 user_id
-			if name == "get_todos" || name == "get_course_local" {
-				args["user_id"] = userID
-			}
-			// 特殊处理：get_course 需要 term 参数
-			if name == "get_course_local" {
-				if _, ok := args["term"]; !ok {
-					args["term"] = "202501" // 默认当前学期
-				}
-			}
-
-			logger.Infof("DailySchedule: calling tool %s with args %v", name, args)
-
-			// 调用 MCP 工具
-			out, callErr := h.mcpCli.CallTool(ctx, name, args)
-			if callErr != nil {
-				out = fmt.Sprintf("tool error: %v", callErr)
-				return "", fmt.Errorf("调用工具 %s 失败: %w", name, callErr)
-
-			}
-
-			// 工具结果回模型
-			hist = append(hist, openai.ToolMessage(out, tc.ID))
-		}
-
-		// 继续下一轮
+	switch res.Reason {
+	case agentStopToolRoundLimit:
+		return "", fmt.Errorf("达到最大工具调用轮次(%d)", dailyScheduleMaxRounds)
+	case agentStopEmptyChoices:
+		return "", fmt.Errorf("模型返回为空")
 	}
+	return res.Content, nil
 }
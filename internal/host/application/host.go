@@ -9,19 +9,22 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/pkg/base"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/db"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/lifecycle"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_policy"
 	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/query"
-	"github.com/openai/openai-go/v2"
 )
 
 // 简单的内存存储用户对话历史
 var history = make(map[int64][]ai_provider.Message)
-var historyOpenAI = make(map[int64][]openai.ChatCompletionMessageParamUnion)
+var historyOpenAI = make(map[int64][]ai_provider.ChatMessage)
 
 type Host struct {
 	ctx           context.Context
 	mcpCli        mcp_client.ToolClient
 	aiProviderCli *ai_provider.Client
+	toolPolicy    *tool_policy.Engine
+	lifecycle     *lifecycle.Manager
 	// 添加需要的连接
 	templateRepository repository.TemplateRepository
 }
@@ -31,6 +34,8 @@ func NewHost(ctx context.Context, clientSet *base.ClientSet) *Host {
 		ctx:                ctx,
 		mcpCli:             clientSet.MCPCli,
 		aiProviderCli:      clientSet.AiProviderCli,
+		toolPolicy:         clientSet.ToolPolicy,
+		lifecycle:          clientSet.Lifecycle,
 		templateRepository: infra.NewTemplateRepository(db.NewDBWithQuery(clientSet.ActualDB, query.Use), clientSet.Cache),
 	}
 }
@@ -0,0 +1,206 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+)
+
+// ListMCPPrompts 列出可作为斜杠命令使用的提示词模板，由 Host 填写的参数不对用户展示
+func (h *Host) ListMCPPrompts() ([]mcp_client.Prompt, error) {
+	prompts, err := h.mcpCli.ListPrompts(h.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range prompts {
+		prompts[i].Arguments = slices.DeleteFunc(slices.Clone(prompts[i].Arguments), func(arg mcp_client.PromptArgument) bool {
+			return arg.Name == constant.MCPTrustedArgUserID
+		})
+	}
+	return prompts, nil
+}
+
+// ListMCPResources 列出用户可附加到对话的资源
+func (h *Host) ListMCPResources(userID string) ([]mcp_client.Resource, error) {
+	return h.userResources(h.ctx, userID)
+}
+
+// userResources 静态资源原样列出；资源模板以当前用户与当前学期展开，含其他变量的模板无法确定取值，不列出。
+// 附加资源时只允许这里列出的 URI，用户无法借模板读取他人的数据
+func (h *Host) userResources(ctx context.Context, userID string) ([]mcp_client.Resource, error) {
+	resources, err := h.mcpCli.ListResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vars := map[string]string{
+		constant.MCPTrustedArgUserID: userID,
+		constant.MCPTrustedArgTerm:   utils.CurrentTerm(time.Now()),
+	}
+	out := make([]mcp_client.Resource, 0, len(resources))
+	for _, r := range resources {
+		if r.Template {
+			uri, ok := expandURITemplate(r.URI, vars)
+			if !ok {
+				continue
+			}
+			r.URI, r.Template = uri, false
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+var uriTemplateVar = regexp.MustCompile(`\{([^}]*)\}`)
+
+// expandURITemplate 只支持 {name} 形式的简单展开，变量缺失或带有运算符时返回 false
+func expandURITemplate(raw string, vars map[string]string) (string, bool) {
+	ok := true
+	uri := uriTemplateVar.ReplaceAllStringFunc(raw, func(m string) string {
+		v, found := vars[m[1:len(m)-1]]
+		if !found {
+			ok = false
+			return m
+		}
+		return url.PathEscape(v)
+	})
+	return uri, ok
+}
+
+// buildTurnMessage 构建本轮的用户消息：以 /name 开头且命中提示词模板时展开为模板内容，
+// 随后追加用户选择的资源
+func (h *Host) buildTurnMessage(
+	ctx context.Context,
+	userID string,
+	text string,
+	imageData []byte,
+	resources []string,
+) (ai_provider.ChatMessage, error) {
+	msg, ok, err := h.expandSlashCommand(ctx, userID, text)
+	if err != nil {
+		return ai_provider.ChatMessage{}, err
+	}
+	if !ok {
+		msg = buildUserMessage(text, imageData)
+	} else {
+		msg.Images = append(msg.Images, buildUserMessage("", imageData).Images...)
+	}
+	if err = h.attachResources(ctx, userID, &msg, resources); err != nil {
+		return ai_provider.ChatMessage{}, err
+	}
+	return msg, nil
+}
+
+// expandSlashCommand 解析 "/name key=value ... 其余文本"：key=value 填写同名参数，其余文本交给第一个未填写的参数。
+// 名称不是提示词模板（或列出模板失败）时返回 false，消息按普通文本处理
+func (h *Host) expandSlashCommand(ctx context.Context, userID, text string) (ai_provider.ChatMessage, bool, error) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "/") {
+		return ai_provider.ChatMessage{}, false, nil
+	}
+	prompts, err := h.mcpCli.ListPrompts(ctx)
+	if err != nil {
+		logger.Warnf("expandSlashCommand: list prompts failed, treat %q as plain text: %v", fields[0], err)
+		return ai_provider.ChatMessage{}, false, nil
+	}
+	idx := slices.IndexFunc(prompts, func(p mcp_client.Prompt) bool { return "/"+p.Name == fields[0] })
+	if idx < 0 {
+		return ai_provider.ChatMessage{}, false, nil
+	}
+	prompt := prompts[idx]
+
+	args, err := parsePromptArgs(prompt, fields[1:])
+	if err != nil {
+		return ai_provider.ChatMessage{}, false, err
+	}
+	for _, arg := range prompt.Arguments {
+		switch {
+		case arg.Name == constant.MCPTrustedArgUserID:
+			args[arg.Name] = userID
+		case arg.Name == constant.MCPTrustedArgTerm && args[arg.Name] == "":
+			args[arg.Name] = utils.CurrentTerm(time.Now())
+		}
+		if arg.Required && args[arg.Name] == "" {
+			return ai_provider.ChatMessage{}, false, errno.NewErrNo(errno.ParamInvalidCode,
+				fmt.Sprintf("/%s 缺少参数 %s", prompt.Name, arg.Name))
+		}
+	}
+
+	res, err := h.mcpCli.GetPrompt(ctx, prompt.Name, args)
+	if err != nil {
+		return ai_provider.ChatMessage{}, false, err
+	}
+	return foldPromptMessages(res), true, nil
+}
+
+// parsePromptArgs 用户不能填写 user_id
+func parsePromptArgs(prompt mcp_client.Prompt, fields []string) (map[string]string, error) {
+	known := func(name string) bool {
+		return name != constant.MCPTrustedArgUserID && slices.ContainsFunc(prompt.Arguments, func(arg mcp_client.PromptArgument) bool {
+			return arg.Name == name
+		})
+	}
+	args := make(map[string]string)
+	var free []string
+	for _, f := range fields {
+		if k, v, ok := strings.Cut(f, "="); ok && known(k) {
+			args[k] = v
+			continue
+		}
+		free = append(free, f)
+	}
+	if len(free) == 0 {
+		return args, nil
+	}
+	for _, arg := range prompt.Arguments {
+		if known(arg.Name) && args[arg.Name] == "" {
+			args[arg.Name] = strings.Join(free, " ")
+			return args, nil
+		}
+	}
+	return nil, errno.NewErrNo(errno.ParamInvalidCode, fmt.Sprintf("/%s 无法识别的参数: %s", prompt.Name, strings.Join(free, " ")))
+}
+
+// foldPromptMessages 模板可能由多条消息组成，本轮只记一条用户消息，按顺序合并为一条
+func foldPromptMessages(res *mcp_client.PromptResult) ai_provider.ChatMessage {
+	parts := make([]string, 0, len(res.Messages))
+	var images []ai_provider.ChatImage
+	for _, m := range res.Messages {
+		parts = append(parts, m.Content)
+		images = append(images, m.Images...)
+	}
+	return ai_provider.UserMessage(strings.Join(parts, "\n\n"), images...)
+}
+
+// attachResources 读取资源并追加到消息末尾，只允许 userResources 列出的 URI
+func (h *Host) attachResources(ctx context.Context, userID string, msg *ai_provider.ChatMessage, uris []string) error {
+	if len(uris) == 0 {
+		return nil
+	}
+	available, err := h.userResources(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, uri := range uris {
+		if !slices.ContainsFunc(available, func(r mcp_client.Resource) bool { return r.URI == uri }) {
+			return errno.NewErrNo(errno.ParamInvalidCode, "不可用的资源: "+uri)
+		}
+		res, err := h.mcpCli.ReadResource(ctx, uri)
+		if err != nil {
+			return err
+		}
+		msg.Content += "\n\n" + res.Text
+		msg.Images = append(msg.Images, res.Images...)
+	}
+	return nil
+}
@@ -10,11 +10,11 @@ import (
 	"strings"
 	"time"
 
-	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/internal/host/infra"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
-	openai "github.com/openai/openai-go/v2"
 )
 
 const (
@@ -119,11 +119,6 @@ func (p *conversationSummaryPayload) UnmarshalJSON(data []byte) error {
 }
 
 func (h *Host) buildSummarizePrompt(ctx context.Context, conversationID string, userID string) (string, error) {
-	tpl, err := infra.LoadPrompt(summarizePromptName)
-	if err != nil {
-		return "", fmt.Errorf("load summarize prompt: %w", err)
-	}
-
 	history, err := h.selectConversationHistory(ctx, conversationID)
 	if err != nil {
 		return "", fmt.Errorf("get conversation history: %w", err)
@@ -135,16 +130,24 @@ func (h *Host) buildSummarizePrompt(ctx context.Context, conversationID string,
 		logger.Warnf("get existing summary failed: %v", err)
 		existingSummary = nil
 	}
-
-	// 构建现有summary的信息
-	summaryInfo := h.buildExistingSummaryInfo(existingSummary)
 	logger.Infof("buildSummarizePrompt: conversationID=%s, has existing summary=%v",
 		conversationID, existingSummary != nil)
 
+	return h.renderSummarizePrompt(conversationID, history, h.buildExistingSummaryInfo(existingSummary))
+}
+
+// renderSummarizePrompt 用给定的历史与现有总结信息渲染 summarize 提示词，
+// 手动总结与上下文压缩共用
+func (h *Host) renderSummarizePrompt(conversationID string, history []string, existingSummaryInfo string) (string, error) {
+	tpl, err := infra.LoadPrompt(summarizePromptName)
+	if err != nil {
+		return "", fmt.Errorf("load summarize prompt: %w", err)
+	}
+
 	templateData := map[string]any{
 		"conversation_id":      conversationID,
 		"conversation_history": strings.Join(history, "\n"),
-		"existing_summary":     summaryInfo,
+		"existing_summary":     existingSummaryInfo,
 		"generated_at":         time.Now().Format(time.RFC3339),
 	}
 
@@ -155,34 +158,25 @@ func (h *Host) buildSummarizePrompt(ctx context.Context, conversationID string,
 	return rendered, nil
 }
 
-func (h *Host) invokeSummarizeModel(ctx context.Context, prompt string) (string, error) {
-	params := openai.ChatCompletionNewParams{
-		Model: openai.ChatModel(config.AiProvider.Model),
-		Messages: []openai.ChatCompletionMessageParamUnion{
-			openai.SystemMessage(prompt),
-			openai.UserMessage("请严格输出 JSON，字段：summary、tags、tool_calls、notes。"),
+func (h *Host) invokeSummarizeModel(ctx context.Context, userID, conversationID, prompt string) (string, error) {
+	params := &ai_provider.ChatParams{
+		Messages: []ai_provider.ChatMessage{
+			ai_provider.SystemMessage(prompt),
+			ai_provider.UserMessage("请严格输出 JSON，字段：summary、tags、tool_calls、notes。"),
 		},
 	}
+	applySamplingOptions(params)
 
-	if config.AiProvider.Options.MaxTokens != nil {
-		params.MaxTokens = openai.Int(int64(*config.AiProvider.Options.MaxTokens))
-	}
-	if config.AiProvider.Options.Temperature != nil {
-		params.Temperature = openai.Float(*config.AiProvider.Options.Temperature)
-	}
-	if config.AiProvider.Options.TopP != nil {
-		params.TopP = openai.Float(*config.AiProvider.Options.TopP)
-	}
-
-	resp, err := h.aiProviderCli.ChatOpenAI(ctx, params)
+	resp, err := h.aiProviderCli.Complete(ctx, constant.AiRouteSummarize, params)
 	if err != nil {
-		return "", fmt.Errorf("call openai summarize: %w", err)
+		return "", fmt.Errorf("call summarize model: %w", err)
 	}
-	if len(resp.Choices) == 0 {
-		return "", errors.New("openai summarize: empty choices")
+	h.recordUsage(ctx, userID, conversationID, resp.Usage)
+	if resp.Content == "" {
+		return "", errors.New("summarize model: empty response")
 	}
 
-	return strings.TrimSpace(resp.Choices[0].Message.Content), nil
+	return strings.TrimSpace(resp.Content), nil
 }
 
 // buildExistingSummaryInfo 构建现有summary的信息字符串
@@ -231,7 +225,11 @@ func (h *Host) selectConversationHistory(ctx context.Context, conversationID str
 		return nil, fmt.Errorf("parse conversation messages failed: %w", err)
 	}
 
-	// 转换为字符串数组格式
+	return formatHistoryLines(messages), nil
+}
+
+// formatHistoryLines 将消息转换为 "[Role] content" 形式的文本行
+func formatHistoryLines(messages []map[string]interface{}) []string {
 	history := make([]string, 0, len(messages))
 	for _, msg := range messages {
 		role, _ := msg["role"].(string)
@@ -254,8 +252,7 @@ func (h *Host) selectConversationHistory(ctx context.Context, conversationID str
 
 		history = append(history, fmt.Sprintf("[%s] %s", roleLabel, content))
 	}
-
-	return history, nil
+	return history
 }
 
 func parseConversationSummary(raw string) (*conversationSummaryPayload, error) {
@@ -360,7 +357,7 @@ func (h *Host) summarizeConversation(conversationID string, userID string) (*Sum
 		return nil, err
 	}
 
-	raw, err := h.invokeSummarizeModel(h.ctx, prompt)
+	raw, err := h.invokeSummarizeModel(h.ctx, userID, conversationID, prompt)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,153 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/go-viper/mapstructure/v2"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_policy"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+)
+
+// defaultToolPolicyRules 配置文件未声明任何规则时使用，与引入策略之前的硬编码行为一致
+var defaultToolPolicyRules = []config.ToolPolicyRule{
+	{
+		Name:      "chat_internal_tools", // 内部工具仅供专用接口使用，通用对话不暴露
+		Endpoints: []string{constant.ToolPolicyEndpointChat},
+		Deny:      []string{"get_todos", "get_course*"},
+	},
+	{
+		Name:      "daily_schedule",
+		Endpoints: []string{constant.ToolPolicyEndpointDailySchedule},
+		Allow:     []string{"get_todos", "get_course*"},
+	},
+}
+
+// defaultArgInjections 配置文件未声明 mcp.inject 时使用：用户只能查询自己的待办与课表
+var defaultArgInjections = []config.MCPArgInjection{
+	{Tools: []string{"get_todos", "get_course_local"}, Arg: "user_id", Source: constant.ToolArgSourceUserID},
+	{Tools: []string{"get_course_local"}, Arg: "term", Source: constant.ToolArgSourceTerm, Mode: constant.ToolArgInjectDefault},
+}
+
+// argInjector 参数注入规则只来自配置文件，进程内构建一次
+var argInjector = sync.OnceValue(func() *tool_policy.Injector {
+	rules := config.MCP.Inject
+	if len(rules) == 0 {
+		rules = defaultArgInjections
+	}
+	inj, err := tool_policy.NewInjector(rules)
+	if err != nil {
+		logger.Errorf("%v", err)
+	}
+	return inj
+})
+
+// WatchToolPolicies 载入工具策略，并按 mcp.policy.reload_interval 定期重新载入数据库中的规则
+func (h *Host) WatchToolPolicies() {
+	if h.toolPolicy == nil {
+		return
+	}
+	if err := h.loadToolPolicies(h.ctx); err != nil {
+		logger.Errorf("tool_policy: %v", err)
+	}
+	interval := config.MCP.Policy.ReloadInterval
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-h.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := h.loadToolPolicies(h.ctx); err != nil {
+					logger.Errorf("tool_policy: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// loadToolPolicies 合并配置文件与数据库中启用的规则后整体替换。
+// 数据库不可用时保留配置文件中的规则，不影响已有限流计数
+func (h *Host) loadToolPolicies(ctx context.Context) error {
+	cfg := config.MCP.Policy
+	if len(cfg.Rules) == 0 {
+		cfg.Rules = defaultToolPolicyRules
+	}
+	cfg.Rules = append([]config.ToolPolicyRule(nil), cfg.Rules...)
+
+	var loadErr error
+	policies, err := h.templateRepository.ListEnabledToolPolicies(ctx)
+	if err != nil {
+		loadErr = err
+	}
+	for _, p := range policies {
+		rule, err := decodeToolPolicyRule(p.Rule)
+		if err != nil {
+			logger.Errorf("tool_policy: skip db rule %s: %v", p.Name, err)
+			continue
+		}
+		if rule.Name == "" {
+			rule.Name = p.Name
+		}
+		cfg.Rules = append(cfg.Rules, rule)
+	}
+
+	if err := h.toolPolicy.Load(cfg); err != nil {
+		logger.Errorf("%v", err)
+	}
+	return loadErr
+}
+
+// decodeToolPolicyRule 数据库中的规则与配置文件使用相同的字段名，window 等时长字段写作 "1m"
+func decodeToolPolicyRule(raw string) (config.ToolPolicyRule, error) {
+	var rule config.ToolPolicyRule
+	var m map[string]any
+	if err := sonic.UnmarshalString(raw, &m); err != nil {
+		return rule, fmt.Errorf("unmarshal rule: %w", err)
+	}
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+		Result:     &rule,
+	})
+	if err != nil {
+		return rule, err
+	}
+	if err := dec.Decode(m); err != nil {
+		return rule, fmt.Errorf("decode rule: %w", err)
+	}
+	return rule, nil
+}
+
+// toolSubject 当前对话的策略主体：优先使用记账用户，其次取请求上下文中的登录信息
+func (a *Agent) toolSubject(ctx context.Context) tool_policy.Subject {
+	user := a.usageUserID
+	if user == "" {
+		if ld, ok := utils.ExtractLoginData(ctx); ok && ld != nil {
+			user = ld.ID
+		}
+	}
+	return tool_policy.Subject{User: user, Endpoint: a.endpoint}
+}
+
+// trustedArgs 可注入工具参数的各来源取值，均来自已认证的请求上下文而非模型输出
+func (a *Agent) trustedArgs(ctx context.Context, subject tool_policy.Subject) map[string]string {
+	values := map[string]string{
+		constant.ToolArgSourceUserID: subject.User,
+		constant.ToolArgSourceTerm:   utils.CurrentTerm(time.Now()),
+	}
+	if ld, ok := utils.ExtractLoginData(ctx); ok && ld != nil {
+		values[constant.ToolArgSourceCookie] = ld.Cookie
+	}
+	return values
+}
@@ -0,0 +1,115 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/api/model/model"
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	dbmodel "github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+const usageDateLayout = "2006-01-02"
+
+// usageNow 用量统计使用的当前时间
+var usageNow = time.Now
+
+// recordUsage 记录一次模型调用的 token 用量，conversationID 为空表示非对话场景。
+// 记账失败只打日志，不影响本次对话
+func (h *Host) recordUsage(ctx context.Context, userID, conversationID string, u ai_provider.ChatUsage) {
+	if userID == "" {
+		return
+	}
+	err := h.templateRepository.AddTokenUsage(context.WithoutCancel(ctx), &dbmodel.TokenUsages{
+		UserID:           userID,
+		ConversationID:   conversationID,
+		UsageDate:        startOfDay(usageNow()),
+		PromptTokens:     u.PromptTokens,
+		CompletionTokens: u.CompletionTokens,
+		TotalTokens:      u.TotalTokens,
+		RequestCount:     1,
+	})
+	if err != nil {
+		logger.Errorf("recordUsage: user_id=%s conversation_id=%s: %v", userID, conversationID, err)
+	}
+}
+
+// GetUserUsage 汇总用户今日、本月及本月每日的 token 用量
+func (h *Host) GetUserUsage(userID string) (*model.UserTokenUsage, error) {
+	now := usageNow()
+	rows, err := h.templateRepository.ListTokenUsagesByUserID(h.ctx, userID, startOfMonth(now))
+	if err != nil {
+		return nil, err
+	}
+
+	today := now.Format(usageDateLayout)
+	res := &model.UserTokenUsage{
+		Today: new(model.TokenUsageStat),
+		Month: new(model.TokenUsageStat),
+		Days:  make([]*model.DailyTokenUsage, 0),
+	}
+	if config.Quota != nil {
+		res.DailyLimit = config.Quota.Daily
+		res.MonthlyLimit = config.Quota.Monthly
+	}
+	// rows 已按日期升序，同一天可能有多个对话的记录
+	for _, row := range rows {
+		date := row.UsageDate.Format(usageDateLayout)
+		if n := len(res.Days); n == 0 || res.Days[n-1].Date != date {
+			res.Days = append(res.Days, &model.DailyTokenUsage{Date: date, Usage: new(model.TokenUsageStat)})
+		}
+		addUsageStat(res.Days[len(res.Days)-1].Usage, row)
+		addUsageStat(res.Month, row)
+		if date == today {
+			addUsageStat(res.Today, row)
+		}
+	}
+	return res, nil
+}
+
+// CheckQuota 检查用户是否已超出每日/每月 token 配额，用量在数据库中汇总，不加载明细
+func (h *Host) CheckQuota(userID string) error {
+	if config.Quota == nil {
+		return nil
+	}
+	now := usageNow()
+	if config.Quota.Daily > 0 {
+		used, err := h.templateRepository.SumTokenUsage(h.ctx, userID, startOfDay(now))
+		if err != nil {
+			return err
+		}
+		if used >= config.Quota.Daily {
+			return errno.NewErrNo(errno.BizLimitCode, "今日 token 用量已达上限")
+		}
+	}
+	if config.Quota.Monthly > 0 {
+		used, err := h.templateRepository.SumTokenUsage(h.ctx, userID, startOfMonth(now))
+		if err != nil {
+			return err
+		}
+		if used >= config.Quota.Monthly {
+			return errno.NewErrNo(errno.BizLimitCode, "本月 token 用量已达上限")
+		}
+	}
+	return nil
+}
+
+func addUsageStat(stat *model.TokenUsageStat, row *dbmodel.TokenUsages) {
+	stat.PromptTokens += row.PromptTokens
+	stat.CompletionTokens += row.CompletionTokens
+	stat.TotalTokens += row.TotalTokens
+	stat.RequestCount += int64(row.RequestCount)
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func startOfMonth(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+}
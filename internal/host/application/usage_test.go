@@ -0,0 +1,149 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	dbmodel "github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+)
+
+// usageRepository 在内存中按 (用户, 对话, 日期) 累加用量，与数据库中的唯一索引一致
+type usageRepository struct {
+	repository.TemplateRepository
+
+	mu   sync.Mutex
+	rows []*dbmodel.TokenUsages
+	sums int // SumTokenUsage 的调用次数
+}
+
+func (r *usageRepository) AddTokenUsage(_ context.Context, u *dbmodel.TokenUsages) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, row := range r.rows {
+		if row.UserID == u.UserID && row.ConversationID == u.ConversationID && row.UsageDate.Equal(u.UsageDate) {
+			row.PromptTokens += u.PromptTokens
+			row.CompletionTokens += u.CompletionTokens
+			row.TotalTokens += u.TotalTokens
+			row.RequestCount += u.RequestCount
+			return nil
+		}
+	}
+	row := *u
+	r.rows = append(r.rows, &row)
+	return nil
+}
+
+func (r *usageRepository) ListTokenUsagesByUserID(_ context.Context, userID string, since time.Time) ([]*dbmodel.TokenUsages, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*dbmodel.TokenUsages
+	for _, row := range r.rows {
+		if row.UserID == userID && !row.UsageDate.Before(since) {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+func (r *usageRepository) SumTokenUsage(_ context.Context, userID string, since time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sums++
+	var total int64
+	for _, row := range r.rows {
+		if row.UserID == userID && !row.UsageDate.Before(since) {
+			total += row.TotalTokens
+		}
+	}
+	return total, nil
+}
+
+func Test_Quota(t *testing.T) {
+	Convey("Test token quota", t, func() {
+		const user = "u1"
+		repo := &usageRepository{}
+		h := &Host{ctx: context.Background(), templateRepository: repo}
+
+		now := time.Date(2026, 3, 31, 23, 59, 0, 0, time.Local)
+		savedNow, savedQuota := usageNow, config.Quota
+		usageNow = func() time.Time { return now }
+		defer func() { usageNow, config.Quota = savedNow, savedQuota }()
+		config.Quota = &config.QuotaConfig{Daily: 100, Monthly: 250}
+
+		spend := func(conversationID string, tokens int64) {
+			h.recordUsage(context.Background(), user, conversationID, ai_provider.ChatUsage{
+				PromptTokens: tokens, TotalTokens: tokens,
+			})
+		}
+		quotaCode := func() int64 {
+			if err := h.CheckQuota(user); err != nil {
+				return errno.ConvertErr(err).ErrorCode
+			}
+			return 0
+		}
+
+		Convey("calls on the same conversation and day are added to one row", func() {
+			spend("c1", 30)
+			spend("c1", 20)
+			spend("c2", 5)
+			So(repo.rows, ShouldHaveLength, 2)
+			So(repo.rows[0].TotalTokens, ShouldEqual, 50)
+			So(repo.rows[0].RequestCount, ShouldEqual, 2)
+
+			usage, err := h.GetUserUsage(user)
+			So(err, ShouldBeNil)
+			So(usage.Today.TotalTokens, ShouldEqual, 55)
+			So(usage.Today.RequestCount, ShouldEqual, 3)
+			So(usage.Days, ShouldHaveLength, 1)
+		})
+
+		Convey("usage below the daily limit passes", func() {
+			spend("c1", 99)
+			So(quotaCode(), ShouldEqual, 0)
+		})
+
+		Convey("usage exactly at the daily limit is rejected", func() {
+			spend("c1", 60)
+			spend("c2", 40)
+			So(quotaCode(), ShouldEqual, errno.BizLimitCode)
+			So(h.CheckQuota(user).Error(), ShouldContainSubstring, "今日")
+		})
+
+		Convey("the daily limit resets at midnight while the month keeps counting", func() {
+			spend("c1", 100)
+			So(quotaCode(), ShouldEqual, errno.BizLimitCode)
+
+			now = now.Add(2 * time.Minute) // 4 月 1 日 00:01，同时也是新的一月
+			So(quotaCode(), ShouldEqual, 0)
+			spend("c1", 10)
+			usage, err := h.GetUserUsage(user)
+			So(err, ShouldBeNil)
+			So(usage.Today.TotalTokens, ShouldEqual, 10)
+			So(usage.Month.TotalTokens, ShouldEqual, 10)
+		})
+
+		Convey("over the monthly limit is rejected even on a fresh day", func() {
+			for day := 1; day <= 3; day++ {
+				now = time.Date(2026, 3, day, 12, 0, 0, 0, time.Local)
+				spend("c1", 90)
+			}
+			So(quotaCode(), ShouldEqual, errno.BizLimitCode)
+			So(h.CheckQuota(user).Error(), ShouldContainSubstring, "本月")
+		})
+
+		Convey("no limits configured skips the lookup", func() {
+			config.Quota = &config.QuotaConfig{}
+			spend("c1", 1000)
+			So(quotaCode(), ShouldEqual, 0)
+			So(repo.sums, ShouldEqual, 0)
+		})
+	})
+}
@@ -0,0 +1,43 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func chatApprovalKey(conversationID string) string {
+	return fmt.Sprintf("chat_approval:%s", conversationID)
+}
+
+func (r *TemplateRepository) SaveChatApproval(ctx context.Context, conversationID string, state []byte, expire time.Duration) error {
+	if err := r.cache.Set(ctx, chatApprovalKey(conversationID), state, expire).Err(); err != nil {
+		return fmt.Errorf("dal.SaveChatApproval: cache failed: %w", err)
+	}
+	return nil
+}
+
+func (r *TemplateRepository) GetChatApproval(ctx context.Context, conversationID string) ([]byte, error) {
+	state, err := r.cache.Get(ctx, chatApprovalKey(conversationID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dal.GetChatApproval: cache failed: %w", err)
+	}
+	return state, nil
+}
+
+func (r *TemplateRepository) TakeChatApproval(ctx context.Context, conversationID string) ([]byte, error) {
+	state, err := r.cache.GetDel(ctx, chatApprovalKey(conversationID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dal.TakeChatApproval: cache failed: %w", err)
+	}
+	return state, nil
+}
@@ -0,0 +1,227 @@
+package infra
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/bytedance/sonic"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+func chatStreamEventsKey(conversationID string) string {
+	return fmt.Sprintf("chat_stream:%s:events", conversationID)
+}
+
+func chatStreamTurnKey(conversationID string) string {
+	return fmt.Sprintf("chat_stream:%s:turn", conversationID)
+}
+
+func chatStreamSeqKey(conversationID string) string {
+	return fmt.Sprintf("chat_stream:%s:seq", conversationID)
+}
+
+func chatStreamLockKey(conversationID string) string {
+	return fmt.Sprintf("chat_stream:%s:lock", conversationID)
+}
+
+func chatStreamChannel(conversationID string) string {
+	return fmt.Sprintf("chat_stream:%s:notify", conversationID)
+}
+
+// chatStreamEntry 列表中实际存储的内容，id 由本轮起始 id 加列表下标推导，不重复存储
+type chatStreamEntry struct {
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data"`
+}
+
+var (
+	// resetChatStreamScript 加锁成功后清空上一轮缓冲，并把对话当前的 id 记为本轮起点
+	// KEYS: lock, events, turn, seq  ARGV: token, user_id, lock_ttl_ms, stream_ttl_ms
+	resetChatStreamScript = redis.NewScript(`
+if not redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[3]) then
+	return 0
+end
+local start = redis.call('GET', KEYS[4]) or '0'
+redis.call('DEL', KEYS[2], KEYS[3])
+redis.call('HSET', KEYS[3], 'user_id', ARGV[2], 'start_id', start)
+redis.call('PEXPIRE', KEYS[3], ARGV[4])
+return 1
+`)
+	// releaseChatStreamScript 只删除自己持有的锁，锁已过期并被下一轮占用时不做任何事
+	// KEYS: lock  ARGV: token
+	releaseChatStreamScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+	// appendChatStreamScript 分配 id 与追加缓冲在同一脚本内完成，保证列表下标与 id 一一对应
+	// KEYS: seq, events, turn  ARGV: entry, stream_ttl_ms, seq_ttl_ms
+	appendChatStreamScript = redis.NewScript(`
+local id = redis.call('INCR', KEYS[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+redis.call('RPUSH', KEYS[2], ARGV[1])
+redis.call('PEXPIRE', KEYS[2], ARGV[2])
+redis.call('PEXPIRE', KEYS[3], ARGV[2])
+return id
+`)
+	// listChatStreamScript 同时读取本轮起点与起点之后的事件，返回 {start_id, entries}
+	// KEYS: turn, events  ARGV: after_id
+	listChatStreamScript = redis.NewScript(`
+local start = tonumber(redis.call('HGET', KEYS[1], 'start_id') or '0')
+local from = tonumber(ARGV[1]) - start
+if from < 0 then
+	from = 0
+end
+return {start, redis.call('LRANGE', KEYS[2], from, -1)}
+`)
+)
+
+func (r *TemplateRepository) ResetChatStream(ctx context.Context, conversationID string, userID string) (string, bool, error) {
+	token := uuid.New().String()
+	ok, err := resetChatStreamScript.Run(ctx, r.cache,
+		[]string{
+			chatStreamLockKey(conversationID),
+			chatStreamEventsKey(conversationID),
+			chatStreamTurnKey(conversationID),
+			chatStreamSeqKey(conversationID),
+		},
+		token, userID, constant.ChatStreamLockExpire.Milliseconds(), constant.ChatStreamExpire.Milliseconds(),
+	).Int()
+	if err != nil {
+		return "", false, fmt.Errorf("dal.ResetChatStream: reset failed: %w", err)
+	}
+	if ok == 0 {
+		return "", false, nil
+	}
+	return token, true, nil
+}
+
+func (r *TemplateRepository) ReleaseChatStream(ctx context.Context, conversationID string, token string) error {
+	if err := releaseChatStreamScript.Run(ctx, r.cache, []string{chatStreamLockKey(conversationID)}, token).Err(); err != nil {
+		return fmt.Errorf("dal.ReleaseChatStream: unlock failed: %w", err)
+	}
+	return nil
+}
+
+func (r *TemplateRepository) GetChatStreamTurn(ctx context.Context, conversationID string) (*repository.ChatStreamTurn, error) {
+	pipe := r.cache.Pipeline()
+	turnCmd := pipe.HMGet(ctx, chatStreamTurnKey(conversationID), "user_id", "start_id")
+	seqCmd := pipe.Get(ctx, chatStreamSeqKey(conversationID))
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("dal.GetChatStreamTurn: cache failed: %w", err)
+	}
+	fields := turnCmd.Val()
+	userID, _ := fields[0].(string)
+	if userID == "" {
+		return nil, nil
+	}
+	startRaw, _ := fields[1].(string)
+	startID, err := strconv.ParseInt(startRaw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("dal.GetChatStreamTurn: invalid start_id %q: %w", startRaw, err)
+	}
+	lastID, err := seqCmd.Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("dal.GetChatStreamTurn: invalid seq: %w", err)
+	}
+	return &repository.ChatStreamTurn{UserID: userID, StartID: startID, LastID: max(lastID, startID)}, nil
+}
+
+func (r *TemplateRepository) AppendChatStreamEvent(ctx context.Context, conversationID string, event string, data []byte) (int64, error) {
+	entry, err := sonic.Marshal(&chatStreamEntry{Event: event, Data: data})
+	if err != nil {
+		return 0, fmt.Errorf("dal.AppendChatStreamEvent: Marshal failed: %w", err)
+	}
+	// id 来自对话级别的计数器，跨轮次持续递增，旧一轮的续传位置不会与本轮混淆
+	id, err := appendChatStreamScript.Run(ctx, r.cache,
+		[]string{
+			chatStreamSeqKey(conversationID),
+			chatStreamEventsKey(conversationID),
+			chatStreamTurnKey(conversationID),
+		},
+		entry, constant.ChatStreamExpire.Milliseconds(), constant.ChatStreamSeqExpire.Milliseconds(),
+	).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("dal.AppendChatStreamEvent: push failed: %w", err)
+	}
+
+	msg, err := sonic.Marshal(&repository.ChatStreamEvent{ID: id, Event: event, Data: data})
+	if err != nil {
+		return 0, fmt.Errorf("dal.AppendChatStreamEvent: Marshal failed: %w", err)
+	}
+	if err = r.cache.Publish(ctx, chatStreamChannel(conversationID), msg).Err(); err != nil {
+		// 事件已落入缓冲，订阅者可通过续传补齐，不视为失败
+		logger.Errorf("dal.AppendChatStreamEvent: publish failed: %v", err)
+	}
+	return id, nil
+}
+
+func (r *TemplateRepository) ListChatStreamEvents(ctx context.Context, conversationID string, afterID int64) ([]*repository.ChatStreamEvent, error) {
+	res, err := listChatStreamScript.Run(ctx, r.cache,
+		[]string{chatStreamTurnKey(conversationID), chatStreamEventsKey(conversationID)},
+		max(afterID, 0),
+	).Slice()
+	if err != nil {
+		return nil, fmt.Errorf("dal.ListChatStreamEvents: cache failed: %w", err)
+	}
+	startID, _ := res[0].(int64)
+	entries, _ := res[1].([]any)
+	// 第 i 个元素(从 0 开始)的 id 为 max(afterID, startID)+i+1
+	from := max(afterID, startID)
+	events := make([]*repository.ChatStreamEvent, 0, len(entries))
+	for i, raw := range entries {
+		s, _ := raw.(string)
+		var entry chatStreamEntry
+		if err = sonic.UnmarshalString(s, &entry); err != nil {
+			return nil, fmt.Errorf("dal.ListChatStreamEvents: Unmarshal failed: %w", err)
+		}
+		events = append(events, &repository.ChatStreamEvent{
+			ID:    from + int64(i) + 1,
+			Event: entry.Event,
+			Data:  entry.Data,
+		})
+	}
+	return events, nil
+}
+
+func (r *TemplateRepository) SubscribeChatStream(ctx context.Context, conversationID string) (<-chan *repository.ChatStreamEvent, func() error, error) {
+	ps := r.cache.Subscribe(ctx, chatStreamChannel(conversationID))
+	// 等待订阅确认，保证之后追加的事件都不会漏掉
+	if _, err := ps.Receive(ctx); err != nil {
+		_ = ps.Close()
+		return nil, nil, fmt.Errorf("dal.SubscribeChatStream: subscribe failed: %w", err)
+	}
+	out := make(chan *repository.ChatStreamEvent, 64)
+	done := make(chan struct{})
+	go func() {
+		defer close(out)
+		for msg := range ps.Channel() {
+			var ev repository.ChatStreamEvent
+			if err := sonic.UnmarshalString(msg.Payload, &ev); err != nil {
+				logger.Errorf("dal.SubscribeChatStream: Unmarshal failed: %v", err)
+				continue
+			}
+			select {
+			case out <- &ev:
+			case <-done:
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	unsubscribe := func() error {
+		once.Do(func() { close(done) })
+		return ps.Close()
+	}
+	return out, unsubscribe, nil
+}
@@ -14,10 +14,10 @@ import (
 	"github.com/west2-online/jwch"
 
 	"github.com/FantasyRL/go-mcp-demo/internal/host/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/db"
 	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
 	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/query"
-	"github.com/openai/openai-go/v2"
 	"gorm.io/gorm"
 )
 
@@ -143,12 +143,12 @@ func (r *TemplateRepository) UpsertConversation(
 	ctx context.Context,
 	userID string,
 	conversationID string,
-	openaiMessages []openai.ChatCompletionMessageParamUnion,
+	messages []ai_provider.ChatMessage,
 ) error {
 	d := r.db.Get(ctx)
 
 	// 先把本次要新增的消息序列化成 JSON
-	newBytes, err := json.Marshal(openaiMessages)
+	newBytes, err := json.Marshal(messages)
 	if err != nil {
 		return err
 	}
@@ -235,6 +235,18 @@ func (r *TemplateRepository) ListConversationsByUserID(ctx context.Context, user
 	return conversations, nil
 }
 
+// UpdateConversationCompaction 更新对话的滚动摘要，不修改 updated_at，避免压缩改变对话列表的排序
+func (r *TemplateRepository) UpdateConversationCompaction(ctx context.Context, conversationID string, summary string, compactedUntil int) error {
+	d := r.db.Get(ctx)
+	_, err := d.WithContext(ctx).Conversations.
+		Where(d.Conversations.ID.Eq(conversationID)).
+		UpdateSimple(
+			d.Conversations.CompactSummary.Value(summary),
+			d.Conversations.CompactedUntil.Value(int32(compactedUntil)),
+		)
+	return err
+}
+
 // CreateTodo 创建待办事项
 func (r *TemplateRepository) CreateTodo(ctx context.Context, todo *model.Todolists) error {
 	d := r.db.Get(ctx)
@@ -0,0 +1,62 @@
+package infra
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"gorm.io/gen/field"
+	"gorm.io/gorm/clause"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+)
+
+func (r *TemplateRepository) AddTokenUsage(ctx context.Context, usage *model.TokenUsages) error {
+	d := r.db.Get(ctx)
+	t := d.TokenUsages
+	// 同一用户同一对话同一天只保留一行，冲突时在原值上累加。
+	// gen 禁止在 OnConflict 中使用 clause.Expr，累加表达式用字段拼出
+	excluded := func(column string) field.Expr { return field.NewField("excluded", column) }
+	err := d.WithContext(ctx).TokenUsages.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "conversation_id"}, {Name: "usage_date"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "prompt_tokens"}, Value: t.PromptTokens.AddCol(excluded("prompt_tokens"))},
+			{Column: clause.Column{Name: "completion_tokens"}, Value: t.CompletionTokens.AddCol(excluded("completion_tokens"))},
+			{Column: clause.Column{Name: "total_tokens"}, Value: t.TotalTokens.AddCol(excluded("total_tokens"))},
+			{Column: clause.Column{Name: "request_count"}, Value: t.RequestCount.AddCol(excluded("request_count"))},
+			{Column: clause.Column{Name: "updated_at"}, Value: time.Now()},
+		},
+	}).Create(usage)
+	if err != nil {
+		return fmt.Errorf("dal.AddTokenUsage: upsert failed: %w", err)
+	}
+	return nil
+}
+
+func (r *TemplateRepository) ListTokenUsagesByUserID(ctx context.Context, userID string, since time.Time) ([]*model.TokenUsages, error) {
+	d := r.db.Get(ctx)
+	usages, err := d.WithContext(ctx).TokenUsages.
+		Where(d.TokenUsages.UserID.Eq(userID)).
+		Where(d.TokenUsages.UsageDate.Gte(since)).
+		Order(d.TokenUsages.UsageDate).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("dal.ListTokenUsagesByUserID: query failed: %w", err)
+	}
+	return usages, nil
+}
+
+func (r *TemplateRepository) SumTokenUsage(ctx context.Context, userID string, since time.Time) (int64, error) {
+	d := r.db.Get(ctx)
+	var total sql.NullInt64
+	err := d.WithContext(ctx).TokenUsages.
+		Select(d.TokenUsages.TotalTokens.Sum()).
+		Where(d.TokenUsages.UserID.Eq(userID)).
+		Where(d.TokenUsages.UsageDate.Gte(since)).
+		Scan(&total)
+	if err != nil {
+		return 0, fmt.Errorf("dal.SumTokenUsage: query failed: %w", err)
+	}
+	return total.Int64, nil
+}
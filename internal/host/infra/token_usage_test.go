@@ -0,0 +1,112 @@
+package infra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/db"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/query"
+)
+
+// tokenUsagesDDL docker/sql/init.sql 中 token_usages 表的 SQLite 版本
+const tokenUsagesDDL = `
+create table token_usages(
+    id                text    primary key default (lower(hex(randomblob(16)))),
+    user_id           text    not null,
+    conversation_id   text    not null default '',
+    usage_date        date    not null,
+    prompt_tokens     integer not null default 0,
+    completion_tokens integer not null default 0,
+    total_tokens      integer not null default 0,
+    request_count     integer not null default 0,
+    created_at        datetime not null default current_timestamp,
+    updated_at        datetime not null default current_timestamp
+);
+create unique index uk_token_usages_user_conversation_date
+    on token_usages (user_id, conversation_id, usage_date);
+`
+
+func newUsageRepository() *TemplateRepository {
+	gormDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Discard})
+	if err != nil {
+		panic(err)
+	}
+	// 内存库每个连接各自独立，只保留一个连接
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		panic(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	if err = gormDB.Exec(tokenUsagesDDL).Error; err != nil {
+		panic(err)
+	}
+	return NewTemplateRepository(db.NewDBWithQuery(gormDB, query.Use), nil)
+}
+
+func Test_TokenUsage(t *testing.T) {
+	Convey("Test token usage accounting", t, func() {
+		ctx := context.Background()
+		r := newUsageRepository()
+		day := time.Date(2026, 3, 31, 0, 0, 0, 0, time.Local)
+		usage := func(conversationID string, date time.Time, prompt, completion int64) *model.TokenUsages {
+			return &model.TokenUsages{
+				UserID:           "u1",
+				ConversationID:   conversationID,
+				UsageDate:        date,
+				PromptTokens:     prompt,
+				CompletionTokens: completion,
+				TotalTokens:      prompt + completion,
+				RequestCount:     1,
+			}
+		}
+
+		Convey("calls on the same conversation and day are added to one row", func() {
+			So(r.AddTokenUsage(ctx, usage("c1", day, 10, 5)), ShouldBeNil)
+			So(r.AddTokenUsage(ctx, usage("c1", day, 20, 7)), ShouldBeNil)
+
+			rows, err := r.ListTokenUsagesByUserID(ctx, "u1", day)
+			So(err, ShouldBeNil)
+			So(rows, ShouldHaveLength, 1)
+			So(rows[0].PromptTokens, ShouldEqual, 30)
+			So(rows[0].CompletionTokens, ShouldEqual, 12)
+			So(rows[0].TotalTokens, ShouldEqual, 42)
+			So(rows[0].RequestCount, ShouldEqual, 2)
+		})
+
+		Convey("another conversation or another day gets its own row", func() {
+			So(r.AddTokenUsage(ctx, usage("c1", day, 10, 5)), ShouldBeNil)
+			So(r.AddTokenUsage(ctx, usage("c2", day, 10, 5)), ShouldBeNil)
+			So(r.AddTokenUsage(ctx, usage("c1", day.AddDate(0, 0, 1), 10, 5)), ShouldBeNil)
+
+			rows, err := r.ListTokenUsagesByUserID(ctx, "u1", day)
+			So(err, ShouldBeNil)
+			So(rows, ShouldHaveLength, 3)
+		})
+
+		Convey("sums count only the user's rows from the given day on", func() {
+			So(r.AddTokenUsage(ctx, usage("c1", day.AddDate(0, 0, -1), 100, 0)), ShouldBeNil)
+			So(r.AddTokenUsage(ctx, usage("c1", day, 10, 5)), ShouldBeNil)
+			So(r.AddTokenUsage(ctx, usage("", day, 20, 0)), ShouldBeNil)
+			other := usage("c1", day, 1000, 0)
+			other.UserID = "u2"
+			So(r.AddTokenUsage(ctx, other), ShouldBeNil)
+
+			total, err := r.SumTokenUsage(ctx, "u1", day)
+			So(err, ShouldBeNil)
+			So(total, ShouldEqual, 35)
+			total, err = r.SumTokenUsage(ctx, "u1", day.AddDate(0, 0, -1))
+			So(err, ShouldBeNil)
+			So(total, ShouldEqual, 135)
+			total, err = r.SumTokenUsage(ctx, "u1", day.AddDate(0, 0, 1))
+			So(err, ShouldBeNil)
+			So(total, ShouldEqual, 0)
+		})
+	})
+}
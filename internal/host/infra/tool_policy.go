@@ -0,0 +1,20 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
+)
+
+func (r *TemplateRepository) ListEnabledToolPolicies(ctx context.Context) ([]*model.ToolPolicies, error) {
+	d := r.db.Get(ctx)
+	policies, err := d.WithContext(ctx).ToolPolicies.
+		Where(d.ToolPolicies.Enabled.Is(true)).
+		Order(d.ToolPolicies.Name).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("dal.ListEnabledToolPolicies: query failed: %w", err)
+	}
+	return policies, nil
+}
@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/west2-online/jwch"
 
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 	"github.com/FantasyRL/go-mcp-demo/pkg/gorm-gen/model"
-	"github.com/openai/openai-go/v2"
 )
 
 // TemplateRepository 根据实际需求定义上层访问的接口，在下层infra做具体方法的实现
@@ -22,11 +24,13 @@ type TemplateRepository interface {
 	// UpdateUserSetting 更新用户设置JSON
 	UpdateUserSetting(ctx context.Context, userID string, settingJSON string) error
 	// UpsertConversation 插入或更新对话记录
-	UpsertConversation(ctx context.Context, userID string, conversationID string, openaiMessages []openai.ChatCompletionMessageParamUnion) error
+	UpsertConversation(ctx context.Context, userID string, conversationID string, messages []ai_provider.ChatMessage) error
 	// GetConversationByID 通过ID获取对话记录
 	GetConversationByID(ctx context.Context, id string) (*model.Conversations, error)
 	// ListConversationsByUserID 获取用户的所有对话列表
 	ListConversationsByUserID(ctx context.Context, userID string) ([]*model.Conversations, error)
+	// UpdateConversationCompaction 更新对话的滚动摘要及其覆盖到的消息下标（messages 中的下标，不含）
+	UpdateConversationCompaction(ctx context.Context, conversationID string, summary string, compactedUntil int) error
 	// DeleteConversation 删除会话
 	DeleteConversation(ctx context.Context, id string) error
 
@@ -62,6 +66,16 @@ type TemplateRepository interface {
 	// DeleteSummary 删除摘要
 	DeleteSummary(ctx context.Context, id string) error
 
+	// AddTokenUsage 将一次模型调用的用量累加到 (用户, 对话, 日期) 对应的记录上
+	AddTokenUsage(ctx context.Context, usage *model.TokenUsages) error
+	// ListTokenUsagesByUserID 获取用户自 since 当天起的用量记录
+	ListTokenUsagesByUserID(ctx context.Context, userID string, since time.Time) ([]*model.TokenUsages, error)
+	// SumTokenUsage 汇总用户自 since 当天起的总 token 数
+	SumTokenUsage(ctx context.Context, userID string, since time.Time) (int64, error)
+
+	// ListEnabledToolPolicies 获取所有启用的工具策略，按名称排序
+	ListEnabledToolPolicies(ctx context.Context) ([]*model.ToolPolicies, error)
+
 	/*
 		redis related methods
 	*/
@@ -79,4 +93,37 @@ type TemplateRepository interface {
 	GetDailyScheduleCache(ctx context.Context, key string) (string, error)
 	// SetDailyScheduleCache 设置每日日程缓存
 	SetDailyScheduleCache(ctx context.Context, key string, schedule string) error
+
+	// ResetChatStream 占用对话的生成权并清空上一轮的事件缓冲，返回释放时使用的令牌；已有生成在进行时返回 false
+	ResetChatStream(ctx context.Context, conversationID string, userID string) (string, bool, error)
+	// ReleaseChatStream 释放对话的生成权，锁已不属于 token 时不做任何事
+	ReleaseChatStream(ctx context.Context, conversationID string, token string) error
+	// GetChatStreamTurn 获取对话当前一轮事件缓冲的信息，缓冲不存在时返回 nil
+	GetChatStreamTurn(ctx context.Context, conversationID string) (*ChatStreamTurn, error)
+	// AppendChatStreamEvent 追加一条事件并通知订阅者，返回分配到的 id，同一对话内跨轮次单调递增
+	AppendChatStreamEvent(ctx context.Context, conversationID string, event string, data []byte) (int64, error)
+	// ListChatStreamEvents 获取 id 大于 afterID 的已缓冲事件
+	ListChatStreamEvents(ctx context.Context, conversationID string, afterID int64) ([]*ChatStreamEvent, error)
+	// SubscribeChatStream 订阅对话的实时事件，返回的函数用于取消订阅
+	SubscribeChatStream(ctx context.Context, conversationID string) (<-chan *ChatStreamEvent, func() error, error)
+	// SaveChatApproval 保存暂停在待确认工具调用处的对话状态，expire 后自动删除
+	SaveChatApproval(ctx context.Context, conversationID string, state []byte, expire time.Duration) error
+	// GetChatApproval 获取对话的待确认状态，不存在时返回 nil
+	GetChatApproval(ctx context.Context, conversationID string) ([]byte, error)
+	// TakeChatApproval 获取并删除对话的待确认状态，保证同一状态只被恢复一次，不存在时返回 nil
+	TakeChatApproval(ctx context.Context, conversationID string) ([]byte, error)
+}
+
+// ChatStreamTurn 对话当前一轮的事件缓冲，本轮事件 id 位于 (StartID, LastID] 区间
+type ChatStreamTurn struct {
+	UserID  string
+	StartID int64
+	LastID  int64
+}
+
+// ChatStreamEvent 缓冲在 Redis 中的一条 SSE 事件
+type ChatStreamEvent struct {
+	ID    int64           `json:"id"`
+	Event string          `json:"event"`
+	Data  json.RawMessage `json:"data"`
 }
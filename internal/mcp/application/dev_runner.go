@@ -8,7 +8,6 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
-	"github.com/mark3labs/mcp-go/mcp"
 	"os/exec"
 	"strings"
 	"syscall"
@@ -22,126 +21,102 @@ import (
 // - code_run：在给定根目录下自动/按命令运行项目或单文件，返回 stdout/stderr/exit code，并给出基于错误输出的建议。
 func WithDevRunnerTools() tool_set.Option {
 	return func(toolSet *tool_set.ToolSet) {
-
 		// fs_tree 目录树查看，让AI感知在哪个目录下运行代码
-		toolTree := mcp.NewTool("fs_tree",
-			mcp.WithDescription("List a directory as a plain text tree to understand project layout."),
-			mcp.WithString("path", mcp.Required(), mcp.Description("Directory path to list")),
-			// depth 最大遍历深度
-			mcp.WithNumber("depth", mcp.Description("Max depth to traverse (default 4)")),
-			// ignore 如 node_modules, *.log
-			mcp.WithString("ignore", mcp.Description("Comma-separated glob patterns to ignore (optional)")),
-		)
-		toolSet.Tools = append(toolSet.Tools, &toolTree)
-		toolSet.HandlerFunc[toolTree.Name] = HandleFsTree
-
+		tool_set.AddTool(toolSet, "fs_tree", "List a directory as a plain text tree to understand project layout.", HandleFsTree)
 		// fs_cat 读取文件里的内容
-		toolCat := mcp.NewTool("fs_cat",
-			mcp.WithDescription("Read a file content to inspect code that was not provided in the prompt."),
-			// 文件路径
-			mcp.WithString("path", mcp.Required(), mcp.Description("File path to read")),
-			// 最大读取字节数
-			mcp.WithNumber("max_bytes", mcp.Description("Max bytes to read (default 65536)")),
-		)
-		toolSet.Tools = append(toolSet.Tools, &toolCat)
-		toolSet.HandlerFunc[toolCat.Name] = HandleFsCat
-
+		tool_set.AddTool(toolSet, "fs_cat", "Read a file content to inspect code that was not provided in the prompt.", HandleFsCat)
 		// code_run 运行命令行
-		toolRun := mcp.NewTool("code_run",
-			// 工具用途：在本地命令行运行项目/脚本，返回 stdout/stderr/exit code，并基于错误输出给建议
-			mcp.WithDescription("Run a code file/project locally in the given root directory with the EXACT command provided by the AI,return stdout"),
-			// required ：工作目录（项目根目录）
-			mcp.WithString("root", mcp.Required(), mcp.Description("Working directory of the project")),
-			// 可选参数：运行前将 content 写入到 root 下的 file（相对路径）
-			//mcp.WithString("file", mcp.Description("Optional file path relative to root to write/update before running")),
-			//mcp.WithString("content", mcp.Description("Optional content to write to `file` before running")),
-			// required ：显式运行命令
-			mcp.WithString("command", mcp.Description("Explicit shell command to run under the root directory(eg `python main.py`,`go run cmd/host`,`npm run dev`)")),
-			// optional ：超时（秒），默认 120s
-			mcp.WithNumber("timeout_sec", mcp.Description("Timeout in seconds (default 120)")),
-			// optional ：传给程序的标准输入
-			mcp.WithString("stdin", mcp.Description("Optional STDIN to pass to the program")),
-		)
-		toolSet.Tools = append(toolSet.Tools, &toolRun)
-		toolSet.HandlerFunc[toolRun.Name] = HandleCodeRun
+		// 工具用途：在本地命令行运行项目/脚本，返回 stdout/stderr/exit code，并基于错误输出给建议
+		tool_set.AddTool(toolSet, "code_run",
+			"Run a code file/project locally in the given root directory with the EXACT command provided by the AI,return stdout",
+			HandleCodeRun)
 	}
 }
 
-func HandleFsCat(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-	p, _ := args["path"].(string)
-	if p == "" {
-		return mcp.NewToolResultError("missing required arg: path"), nil
+// FsTreeInput fs_tree 的参数
+type FsTreeInput struct {
+	Path string `json:"path" jsonschema:"minLength=1" jsonschema_description:"Directory path to list"`
+	// depth 最大遍历深度
+	Depth int `json:"depth,omitempty" jsonschema:"minimum=0" jsonschema_description:"Max depth to traverse (default 4)"`
+	// ignore 如 node_modules, *.log
+	Ignore string `json:"ignore,omitempty" jsonschema_description:"Comma-separated glob patterns to ignore (optional)"`
+}
+
+// FsCatInput fs_cat 的参数
+type FsCatInput struct {
+	// 文件路径
+	Path string `json:"path" jsonschema:"minLength=1" jsonschema_description:"File path to read"`
+	// 最大读取字节数
+	MaxBytes int `json:"max_bytes,omitempty" jsonschema:"minimum=0" jsonschema_description:"Max bytes to read (default 65536)"`
+}
+
+// CodeRunInput code_run 的参数
+type CodeRunInput struct {
+	// required ：工作目录（项目根目录）
+	Root string `json:"root" jsonschema:"minLength=1" jsonschema_description:"Working directory of the project"`
+	// 可选参数：运行前将 content 写入到 root 下的 file（相对路径）
+	//File    string `json:"file,omitempty" jsonschema_description:"Optional file path relative to root to write/update before running"`
+	//Content string `json:"content,omitempty" jsonschema_description:"Optional content to write to file before running"`
+	// required ：显式运行命令
+	Command string `json:"command" jsonschema_description:"Explicit shell command to run under the root directory(eg python main.py, go run cmd/host, npm run dev)"`
+	// optional ：超时（秒），默认 120s
+	TimeoutSec int `json:"timeout_sec,omitempty" jsonschema:"minimum=0" jsonschema_description:"Timeout in seconds (default 120)"`
+	// optional ：传给程序的标准输入
+	Stdin string `json:"stdin,omitempty" jsonschema_description:"Optional STDIN to pass to the program"`
+}
+
+func (in *CodeRunInput) Validate() error {
+	if strings.TrimSpace(in.Command) == "" {
+		return errors.New("missing required arg: command")
 	}
-	maxF, _ := args["max_bytes"].(float64)
+	return nil
+}
+
+func HandleFsCat(ctx context.Context, in FsCatInput) (string, error) {
 	maxBytes := 64 * 1024
-	if maxF > 0 {
-		maxBytes = int(maxF)
+	if in.MaxBytes > 0 {
+		maxBytes = in.MaxBytes
 	}
-	content, truncated, err := utils.ReadFileMax(p, maxBytes)
+	content, truncated, err := utils.ReadFileMax(in.Path, maxBytes)
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return "", err
 	}
-	header := fmt.Sprintf("### fs_cat: %s (max_bytes=%d, truncated=%v)\n\n", p, maxBytes, truncated)
-	return mcp.NewToolResultText(header + content), nil
+	header := fmt.Sprintf("### fs_cat: %s (max_bytes=%d, truncated=%v)\n\n", in.Path, maxBytes, truncated)
+	return header + content, nil
 }
 
-func HandleFsTree(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-	root, _ := args["path"].(string)
-	if root == "" {
-		return mcp.NewToolResultError("missing required arg: path"), nil
-	}
-	depthF, _ := args["depth"].(float64)
+func HandleFsTree(ctx context.Context, in FsTreeInput) (string, error) {
 	depth := 4
-	if depthF > 0 {
-		depth = int(depthF)
+	if in.Depth > 0 {
+		depth = in.Depth
 	}
-	ignoreStr, _ := args["ignore"].(string)
 	var ignores []string
-	if ignoreStr != "" {
-		for _, s := range strings.Split(ignoreStr, ",") {
+	if in.Ignore != "" {
+		for _, s := range strings.Split(in.Ignore, ",") {
 			s = strings.TrimSpace(s)
 			if s != "" {
 				ignores = append(ignores, s)
 			}
 		}
 	}
-	out, err := buildTreeText(root, depth, ignores)
-	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
-	}
-	return mcp.NewToolResultText(out), nil
+	return buildTreeText(in.Path, depth, ignores)
 }
 
-func HandleCodeRun(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// 获取参数
-	args := req.GetArguments()
-	root, _ := args["root"].(string)
-	if root == "" {
-		return mcp.NewToolResultError("missing required arg: root"), nil
-	}
-	//fileRel, _ := args["file"].(string)
-	//content, _ := args["content"].(string)
-	cmdStr, _ := args["command"].(string)
-	if strings.TrimSpace(cmdStr) == "" {
-		return mcp.NewToolResultError("missing required arg: command"), nil
-	}
-	stdin, _ := args["stdin"].(string)
-	timeoutF, _ := args["timeout_sec"].(float64)
+func HandleCodeRun(ctx context.Context, in CodeRunInput) (string, error) {
+	root, cmdStr, stdin := in.Root, in.Command, in.Stdin
 	timeout := 120 * time.Second
-	if timeoutF > 0 {
-		timeout = time.Duration(timeoutF) * time.Second
+	if in.TimeoutSec > 0 {
+		timeout = time.Duration(in.TimeoutSec) * time.Second
 	}
 
 	// 可选：写入文件
-	//if fileRel != "" && content != "" {
-	//	abs := filepath.Join(root, filepath.Clean(fileRel))
+	//if in.File != "" && in.Content != "" {
+	//	abs := filepath.Join(root, filepath.Clean(in.File))
 	//	if err := os.MkdirAll(filepath.Dir(abs), 0o755); err != nil {
-	//		return mcp.NewToolResultError("mkdir: " + err.Error()), nil
+	//		return "", fmt.Errorf("mkdir: %w", err)
 	//	}
-	//	if err := os.WriteFile(abs, []byte(content), 0o644); err != nil {
-	//		return mcp.NewToolResultError("write file: " + err.Error()), nil
+	//	if err := os.WriteFile(abs, []byte(in.Content), 0o644); err != nil {
+	//		return "", fmt.Errorf("write file: %w", err)
 	//	}
 	//}
 
@@ -168,7 +143,7 @@ func HandleCodeRun(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolR
 	if runErr != nil && !errors.Is(runErr, context.DeadlineExceeded) {
 		logger.Warnf("code_run: %v", runErr)
 	}
-	return mcp.NewToolResultText(buf.String()), nil
+	return buf.String(), nil
 }
 
 func tail(s string, max int) string {
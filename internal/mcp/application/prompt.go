@@ -0,0 +1,129 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/FantasyRL/go-mcp-demo/internal/mcp/infra"
+	"github.com/FantasyRL/go-mcp-demo/internal/mcp/repository"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/prompt_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+)
+
+// dailySchedulePromptText daily_schedule 提示词正文，课表与待办以资源形式附在其后
+const dailySchedulePromptText = `请根据下面附带的课表和待办事项，为我生成 %s（%s）的日程安排。
+
+## 课程节次与时间对应关系
+- 第 1-2 节：08:20 - 10:00
+- 第 3-4 节：10:20 - 12:00
+- 第 5-6 节：14:00 - 15:40
+- 第 7-8 节：15:50 - 17:30
+- 第 9-11 节：19:00 - 21:35
+课程的 schedule_rules 中：weekday 为星期几（1=周一，7=周日），start_week/end_week 为起止周次，
+single/double 表示单周/双周是否上课，adjust 为 true 表示调课。
+
+## 输出格式
+📅 课程安排
+- 08:20-10:00 课程名称（教师）@ 地点
+
+📝 待办事项
+- [优先级1] 标题 (截止时间)
+
+💡 温馨提示
+- 提醒重要事项，给出合理的时间规划建议
+
+注意：只列出当天的课程并考虑单双周；待办按优先级排序（1最高，4最低），只列出未完成的（status=0）；
+没有课程或待办时友好地告知。`
+
+var weekdayNames = [...]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"}
+
+// WithPrompts 注册提示词模板，Host 将其作为斜杠命令提供给用户
+func WithPrompts() prompt_set.Option {
+	return func(ps *prompt_set.PromptSet) {
+		repo := infra.NewMCPRepository()
+
+		addPrompt(ps, mcp.NewPrompt("summarize",
+			mcp.WithPromptDescription("总结当前对话，或总结给出的一段文本"),
+			mcp.WithArgument("text", mcp.ArgumentDescription("要总结的文本，留空则总结当前对话")),
+			mcp.WithArgument("focus", mcp.ArgumentDescription("希望侧重的方面，如结论、待办、技术细节")),
+		), func(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			args := req.Params.Arguments
+			var b strings.Builder
+			if text := strings.TrimSpace(args["text"]); text != "" {
+				b.WriteString("请用简洁的中文总结以下内容，先给出一句话结论，再分点列出要点：\n\n")
+				b.WriteString(text)
+			} else {
+				b.WriteString("请用简洁的中文总结我们到目前为止的对话，先给出一句话结论，再分点列出讨论过的要点、已得出的结论和尚未解决的问题。")
+			}
+			if focus := strings.TrimSpace(args["focus"]); focus != "" {
+				b.WriteString("\n\n请重点关注：" + focus)
+			}
+			return mcp.NewGetPromptResult("总结", []mcp.PromptMessage{
+				mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(b.String())),
+			}), nil
+		})
+
+		addPrompt(ps, mcp.NewPrompt("daily_schedule",
+			mcp.WithPromptDescription("根据课表和待办事项生成某一天的日程安排"),
+			mcp.WithArgument(constant.MCPTrustedArgUserID, mcp.ArgumentDescription("用户ID"), mcp.RequiredArgument()),
+			mcp.WithArgument(constant.MCPTrustedArgTerm, mcp.ArgumentDescription("学期代码，如 202501，默认当前学期")),
+			mcp.WithArgument("date", mcp.ArgumentDescription("日期，格式 2006-01-02，默认今天")),
+		), func(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			return dailySchedulePrompt(ctx, repo, req.Params.Arguments)
+		})
+	}
+}
+
+func addPrompt(ps *prompt_set.PromptSet, p mcp.Prompt, h func(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error)) {
+	ps.Prompts = append(ps.Prompts, &p)
+	ps.HandlerFunc[p.Name] = h
+}
+
+// dailySchedulePrompt 课表与待办作为内嵌资源附在提示词后面，模型无需再调用工具；
+// 某项数据取不到时附上说明而不是让整个提示词失败
+func dailySchedulePrompt(ctx context.Context, repo repository.MCPRepository, args map[string]string) (*mcp.GetPromptResult, error) {
+	userID := args[constant.MCPTrustedArgUserID]
+	if userID == "" {
+		return nil, fmt.Errorf("argument %q is required", constant.MCPTrustedArgUserID)
+	}
+	day := time.Now()
+	if date := strings.TrimSpace(args["date"]); date != "" {
+		parsed, err := time.ParseInLocation(time.DateOnly, date, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q, want YYYY-MM-DD", date)
+		}
+		day = parsed
+	}
+	term := args[constant.MCPTrustedArgTerm]
+	if term == "" {
+		term = utils.CurrentTerm(day)
+	}
+
+	messages := []mcp.PromptMessage{mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(
+		fmt.Sprintf(dailySchedulePromptText, day.Format(time.DateOnly), weekdayNames[day.Weekday()])))}
+	attach := func(uri string, v any, err error) {
+		var contents []mcp.ResourceContents
+		if err == nil {
+			contents, err = jsonContents(uri, v)
+		}
+		if err != nil {
+			messages = append(messages, mcp.NewPromptMessage(mcp.RoleUser,
+				mcp.NewTextContent(fmt.Sprintf("（%s 暂时无法获取：%v）", uri, err))))
+			return
+		}
+		for _, c := range contents {
+			messages = append(messages, mcp.NewPromptMessage(mcp.RoleUser, mcp.NewEmbeddedResource(c)))
+		}
+	}
+
+	todos, err := listTodos(ctx, repo, userID)
+	attach("todo://"+userID, &getTodosOutput{Todos: todos}, err)
+	courses, err := loadCachedCourses(ctx, userID, term)
+	attach("course://"+userID+"/"+term, &getCourseOutput{Courses: courses}, err)
+	return mcp.NewGetPromptResult(fmt.Sprintf("%s 的日程安排", day.Format(time.DateOnly)), messages), nil
+}
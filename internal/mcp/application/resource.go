@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+
+	"github.com/bytedance/sonic"
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/FantasyRL/go-mcp-demo/internal/mcp/infra"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/resource_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// WithUserResources 以资源形式发布用户数据，Host 可将其作为附件加入对话：
+//   - todo://{user_id}：待办事项；
+//   - course://{user_id}/{term}：host 缓存的课表，未缓存时读取失败
+func WithUserResources() resource_set.Option {
+	return func(rs *resource_set.ResourceSet) {
+		repo := infra.NewMCPRepository()
+
+		addTemplate(rs, mcp.NewResourceTemplate(constant.MCPResourceTodos, "todos",
+			mcp.WithTemplateDescription("我的待办事项列表"),
+			mcp.WithTemplateMIMEType("application/json"),
+		), func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			todos, err := listTodos(ctx, repo, resource_set.TemplateArg(req, constant.MCPTrustedArgUserID))
+			if err != nil {
+				return nil, err
+			}
+			return jsonContents(req.Params.URI, &getTodosOutput{Todos: todos})
+		})
+
+		addTemplate(rs, mcp.NewResourceTemplate(constant.MCPResourceTimetable, "timetable",
+			mcp.WithTemplateDescription("我的本学期课表（缓存）"),
+			mcp.WithTemplateMIMEType("application/json"),
+		), func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			courses, err := loadCachedCourses(ctx,
+				resource_set.TemplateArg(req, constant.MCPTrustedArgUserID),
+				resource_set.TemplateArg(req, constant.MCPTrustedArgTerm))
+			if err != nil {
+				return nil, err
+			}
+			return jsonContents(req.Params.URI, &getCourseOutput{Courses: courses})
+		})
+	}
+}
+
+func addTemplate(rs *resource_set.ResourceSet, t mcp.ResourceTemplate, h func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error)) {
+	rs.Templates = append(rs.Templates, &t)
+	rs.TemplateHandlerFunc[t.URITemplate.Raw()] = h
+}
+
+func jsonContents(uri string, v any) ([]mcp.ResourceContents, error) {
+	text, err := sonic.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return []mcp.ResourceContents{mcp.TextResourceContents{URI: uri, MIMEType: "application/json", Text: string(text)}}, nil
+}
@@ -2,55 +2,44 @@ package application
 
 import (
 	"context"
+	"errors"
 
-	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
-	"github.com/mark3labs/mcp-go/mcp"
-	"github.com/openai/openai-go/v2"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 )
 
+// SEQuestionInput build_html_to_solve_science_and_engineering_problem 的参数
+type SEQuestionInput struct {
+	Question string `json:"question" jsonschema:"minLength=1" jsonschema_description:"用户提出的科学或工程相关的问题"`
+}
+
 func WithAIScienceAndEngineeringBuildHtmlTool() tool_set.Option {
 	return func(toolSet *tool_set.ToolSet) {
-		newTool := mcp.NewTool(
-			"build_html_to_solve_science_and_engineering_problem",
-			mcp.WithDescription("当用户遇到学习问题上的困难时，通过构造可交互的网页来帮助用户理解数学概念和绘制图像, 可以在调用后加上对问题的辅助解析"),
-			mcp.WithString("question", mcp.Required(), mcp.Description("用户提出的科学或工程相关的问题")),
-		)
-		toolSet.Tools = append(toolSet.Tools, &newTool)
-		toolSet.HandlerFunc[newTool.Name] = AIScienceAndEngineeringBuildHtml
+		tool_set.AddTool(toolSet, "build_html_to_solve_science_and_engineering_problem",
+			"当用户遇到学习问题上的困难时，通过构造可交互的网页来帮助用户理解数学概念和绘制图像, 可以在调用后加上对问题的辅助解析",
+			AIScienceAndEngineeringBuildHtml)
 	}
 }
 
-func AIScienceAndEngineeringBuildHtml(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-	rawQuestion, ok := args["question"]
-	if !ok {
-		return mcp.NewToolResultError("missing required arg: question"), nil
-	}
-
-	question, ok := rawQuestion.(string)
-	if !ok || question == "" {
-		return mcp.NewToolResultError("question must be a non-empty string"), nil
-	}
-
+func AIScienceAndEngineeringBuildHtml(ctx context.Context, in SEQuestionInput) (string, error) {
 	// 从 global ClientSet 获取 AI provider
 	clientSet := base.GetGlobalClientSet()
 	if clientSet == nil || clientSet.AiProviderCli == nil {
-		return mcp.NewToolResultError("AI provider not initialized"), nil
+		return "", errors.New("AI provider not initialized")
 	}
 
-	resp, err := clientSet.AiProviderCli.ChatOpenAI(ctx, openai.ChatCompletionNewParams{
-		Model: openai.ChatModel(config.AiProvider.Model),
-		Messages: []openai.ChatCompletionMessageParamUnion{
-			openai.SystemMessage(systemPromptHTMLPrinter),
-			openai.UserMessage(question),
+	resp, err := clientSet.AiProviderCli.Complete(ctx, constant.AiRouteChat, &ai_provider.ChatParams{
+		Messages: []ai_provider.ChatMessage{
+			ai_provider.SystemMessage(systemPromptHTMLPrinter),
+			ai_provider.UserMessage(in.Question),
 		},
 	})
 	if err != nil {
-		return mcp.NewToolResultError(err.Error()), nil
+		return "", err
 	}
-	return mcp.NewToolResultText(resp.Choices[0].Message.Content), nil
+	return resp.Content, nil
 }
 
 const systemPromptHTMLPrinter = `
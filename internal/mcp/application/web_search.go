@@ -3,6 +3,7 @@ package application
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,7 +12,6 @@ import (
 	"time"
 
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
-	"github.com/mark3labs/mcp-go/mcp"
 )
 
 // DuckDuckGo Instant Answer API 结果部分结构
@@ -25,84 +25,85 @@ type ddgInstant struct {
 	} `json:"RelatedTopics"`
 }
 
+// WebSearchInput web.search 的参数
+type WebSearchInput struct {
+	Query string `json:"query" jsonschema_description:"Search query keywords"`
+}
+
+func (in *WebSearchInput) Validate() error {
+	in.Query = strings.TrimSpace(in.Query)
+	if in.Query == "" {
+		return errors.New("query is empty")
+	}
+	return nil
+}
+
+// WebSearchItem 单条搜索结果
+type WebSearchItem struct {
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+	Snippet string `json:"snippet"`
+}
+
+// WebSearchOutput web.search 的结果，没有结果时 Results 为空
+type WebSearchOutput struct {
+	Query   string          `json:"query"`
+	Results []WebSearchItem `json:"results"`
+}
+
 // Option：注册 web.search 工具
 func WithWebSearchTool() tool_set.Option {
 	return func(ts *tool_set.ToolSet) {
-		tool := mcp.NewTool(
-			"web.search",
-			mcp.WithDescription("Use DuckDuckGo Instant Answer API to fetch public information. Required arg: query"),
-			mcp.WithString("query", mcp.Required(), mcp.Description("Search query keywords")),
-		)
-		ts.Tools = append(ts.Tools, &tool)
-		ts.HandlerFunc[tool.Name] = WebSearchHandler
+		tool_set.AddTool(ts, "web.search",
+			"Use DuckDuckGo Instant Answer API to fetch public information. Required arg: query",
+			WebSearchHandler)
 	}
 }
 
 // 处理函数
-func WebSearchHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	args := req.GetArguments()
-	rawQuery, ok := args["query"]
-	if !ok {
-		return mcp.NewToolResultError("missing required arg: query"), nil
-	}
-	query, _ := rawQuery.(string)
-	query = strings.TrimSpace(query)
-	if query == "" {
-		return mcp.NewToolResultError("query is empty"), nil
-	}
-
+func WebSearchHandler(ctx context.Context, in WebSearchInput) (*WebSearchOutput, error) {
 	// 请求 DuckDuckGo
 	api := "https://api.duckduckgo.com/"
 	params := url.Values{}
-	params.Set("q", query)
+	params.Set("q", in.Query)
 	params.Set("format", "json")
 	params.Set("no_html", "1")
 	params.Set("skip_disambig", "1")
 
 	client := &http.Client{Timeout: 8 * time.Second}
-	resp, err := client.Get(api + "?" + params.Encode())
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, api+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("request error: %w", err)
+	}
+	resp, err := client.Do(httpReq)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("request error: %v", err)), nil
+		return nil, fmt.Errorf("request error: %w", err)
 	}
 	defer resp.Body.Close()
 	body, _ := io.ReadAll(resp.Body)
 
 	var data ddgInstant
 	if err := json.Unmarshal(body, &data); err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("decode error: %v", err)), nil
+		return nil, fmt.Errorf("decode error: %w", err)
 	}
 
-	type item struct {
-		Title   string `json:"title"`
-		URL     string `json:"url"`
-		Snippet string `json:"snippet"`
-	}
-
-	results := make([]item, 0, 5)
+	out := &WebSearchOutput{Query: in.Query, Results: make([]WebSearchItem, 0, 5)}
 	for _, rt := range data.RelatedTopics {
-		if len(results) >= 5 {
+		if len(out.Results) >= 5 {
 			break
 		}
-		results = append(results, item{
+		out.Results = append(out.Results, WebSearchItem{
 			Title:   rt.Text,
 			URL:     rt.FirstURL,
 			Snippet: rt.Text,
 		})
 	}
-	if len(results) == 0 && (data.AbstractURL != "" || data.AbstractText != "") {
-		results = append(results, item{
+	if len(out.Results) == 0 && (data.AbstractURL != "" || data.AbstractText != "") {
+		out.Results = append(out.Results, WebSearchItem{
 			Title:   data.Heading,
 			URL:     data.AbstractURL,
 			Snippet: data.AbstractText,
 		})
 	}
-	if len(results) == 0 {
-		return mcp.NewToolResultText("no results"), nil
-	}
-
-	out, _ := json.Marshal(map[string]any{
-		"query":   query,
-		"results": results,
-	})
-	return mcp.NewToolResultText(string(out)), nil
+	return out, nil
 }
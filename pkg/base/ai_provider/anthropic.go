@@ -0,0 +1,384 @@
+package ai_provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+)
+
+// anthropicBackend Anthropic Messages API（/v1/messages）
+type anthropicBackend struct {
+	baseURL    string
+	apiKey     string
+	timeout    time.Duration // 非流式请求超时
+	httpClient *http.Client
+}
+
+func newAnthropicBackend(baseURL, apiKey string, timeout time.Duration) *anthropicBackend {
+	if baseURL == "" {
+		baseURL = constant.AnthropicDefaultBaseURL
+	}
+	return &anthropicBackend{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		timeout:    timeout,
+		httpClient: &http.Client{},
+	}
+}
+
+type anthropicRequest struct {
+	Model       string             `json:"model"`
+	MaxTokens   int64              `json:"max_tokens"`
+	System      string             `json:"system,omitempty"`
+	Messages    []anthropicMessage `json:"messages"`
+	Tools       []anthropicTool    `json:"tools,omitempty"`
+	Temperature *float64           `json:"temperature,omitempty"`
+	TopP        *float64           `json:"top_p,omitempty"`
+	Stream      bool               `json:"stream,omitempty"`
+}
+
+type anthropicMessage struct {
+	Role    string           `json:"role"` // "user" | "assistant"
+	Content []anthropicBlock `json:"content"`
+}
+
+// anthropicBlock 内容块：text | image | tool_use | tool_result
+type anthropicBlock struct {
+	Type      string                `json:"type"`
+	Text      string                `json:"text,omitempty"`
+	Source    *anthropicImageSource `json:"source,omitempty"`
+	ID        string                `json:"id,omitempty"`
+	Name      string                `json:"name,omitempty"`
+	Input     json.RawMessage       `json:"input,omitempty"`
+	ToolUseID string                `json:"tool_use_id,omitempty"`
+	Content   string                `json:"content,omitempty"`
+}
+
+type anthropicImageSource struct {
+	Type      string `json:"type"` // "base64" | "url"
+	MediaType string `json:"media_type,omitempty"`
+	Data      string `json:"data,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+type anthropicTool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	InputSchema map[string]any `json:"input_schema"`
+}
+
+type anthropicUsage struct {
+	InputTokens  int64 `json:"input_tokens"`
+	OutputTokens int64 `json:"output_tokens"`
+}
+
+type anthropicResponse struct {
+	Content    []anthropicBlock `json:"content"`
+	StopReason string           `json:"stop_reason"`
+	Usage      anthropicUsage   `json:"usage"`
+}
+
+type anthropicStreamEvent struct {
+	Type         string             `json:"type"`
+	Index        int                `json:"index"`
+	Message      *anthropicResponse `json:"message"`
+	ContentBlock *anthropicBlock    `json:"content_block"`
+	Delta        *struct {
+		Type        string `json:"type"`
+		Text        string `json:"text"`
+		PartialJSON string `json:"partial_json"`
+		StopReason  string `json:"stop_reason"`
+	} `json:"delta"`
+	Usage *anthropicUsage `json:"usage"`
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (b *anthropicBackend) Complete(ctx context.Context, model string, req *ChatParams) (*ChatCompletion, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.timeout)
+	defer cancel()
+
+	resp, err := b.do(ctx, toAnthropicRequest(model, req, false))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var ar anthropicResponse
+	if err = json.NewDecoder(resp.Body).Decode(&ar); err != nil {
+		return nil, err
+	}
+	out := &ChatCompletion{
+		FinishReason: fromAnthropicStopReason(ar.StopReason),
+		Usage:        fromAnthropicUsage(ar.Usage),
+	}
+	for _, block := range ar.Content {
+		switch block.Type {
+		case "text":
+			out.Content += block.Text
+		case "tool_use":
+			out.ToolCalls = append(out.ToolCalls, ChatToolCall{
+				ID:        block.ID,
+				Name:      block.Name,
+				Arguments: string(block.Input),
+			})
+		}
+	}
+	return out, nil
+}
+
+func (b *anthropicBackend) Stream(ctx context.Context, model string, req *ChatParams, onChunk func(*ChatChunk) error) error {
+	resp, err := b.do(ctx, toAnthropicRequest(model, req, true))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var usage anthropicUsage
+	tools := make(map[int]*ChatToolCall) // content block index -> 聚合中的 tool_use
+	args := make(map[int]*strings.Builder)
+
+	sc := bufio.NewScanner(resp.Body)
+	sc.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for sc.Scan() {
+		line := sc.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		var ev anthropicStreamEvent
+		if err = json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data:"))), &ev); err != nil {
+			return err
+		}
+
+		var chunk *ChatChunk
+		switch ev.Type {
+		case "message_start":
+			if ev.Message != nil {
+				usage = ev.Message.Usage
+			}
+		case "content_block_start":
+			if ev.ContentBlock != nil && ev.ContentBlock.Type == "tool_use" {
+				tools[ev.Index] = &ChatToolCall{ID: ev.ContentBlock.ID, Name: ev.ContentBlock.Name}
+				args[ev.Index] = new(strings.Builder)
+			}
+		case "content_block_delta":
+			if ev.Delta == nil {
+				continue
+			}
+			switch ev.Delta.Type {
+			case "text_delta":
+				chunk = &ChatChunk{Content: ev.Delta.Text}
+			case "input_json_delta":
+				if sb, ok := args[ev.Index]; ok {
+					sb.WriteString(ev.Delta.PartialJSON)
+				}
+			}
+		case "message_delta":
+			// 结束帧：stop_reason + 输出 token 数
+			if ev.Usage != nil {
+				usage.OutputTokens = ev.Usage.OutputTokens
+			}
+			u := fromAnthropicUsage(usage)
+			chunk = &ChatChunk{Usage: &u}
+			if ev.Delta != nil {
+				chunk.FinishReason = fromAnthropicStopReason(ev.Delta.StopReason)
+			}
+			if chunk.FinishReason == FinishReasonToolCalls {
+				chunk.ToolCalls = collectAnthropicToolCalls(tools, args)
+			}
+		case "message_stop":
+			return nil
+		case "error":
+			if ev.Error != nil {
+				return anthropicStreamError(ev.Error.Type, ev.Error.Message)
+			}
+			return errors.New("anthropic stream error")
+		}
+
+		if chunk == nil {
+			continue
+		}
+		if err = onChunk(chunk); err != nil {
+			if errors.Is(err, errno.OllamaInternalStopStream) {
+				return nil
+			}
+			return err
+		}
+	}
+	if err = sc.Err(); err != nil {
+		return err
+	}
+	return io.ErrUnexpectedEOF
+}
+
+func (b *anthropicBackend) do(ctx context.Context, ar *anthropicRequest) (*http.Response, error) {
+	body, err := json.Marshal(ar)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, b.baseURL+"/v1/messages", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", b.apiKey)
+	httpReq.Header.Set("anthropic-version", constant.AnthropicAPIVersion)
+
+	resp, err := b.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, &StatusError{StatusCode: resp.StatusCode, Header: resp.Header, Body: string(msg)}
+	}
+	return resp, nil
+}
+
+func toAnthropicRequest(model string, req *ChatParams, stream bool) *anthropicRequest {
+	ar := &anthropicRequest{
+		Model:       model,
+		MaxTokens:   req.MaxTokens,
+		Temperature: req.Temperature,
+		TopP:        req.TopP,
+		Stream:      stream,
+	}
+	if ar.MaxTokens <= 0 {
+		ar.MaxTokens = constant.AnthropicDefaultMaxTokens
+	}
+	for _, t := range req.Tools {
+		schema := t.Parameters
+		if schema == nil {
+			schema = map[string]any{"type": "object"}
+		}
+		ar.Tools = append(ar.Tools, anthropicTool{Name: t.Name, Description: t.Description, InputSchema: schema})
+	}
+
+	var system []string
+	for _, m := range req.Messages {
+		var role string
+		var blocks []anthropicBlock
+		switch m.Role {
+		case RoleSystem:
+			// system 不属于 messages，统一合并到顶层 system 字段
+			system = append(system, m.Content)
+			continue
+		case RoleUser:
+			role = RoleUser
+			if m.Content != "" {
+				blocks = append(blocks, anthropicBlock{Type: "text", Text: m.Content})
+			}
+			for _, img := range m.Images {
+				blocks = append(blocks, anthropicBlock{Type: "image", Source: anthropicImage(img.URL)})
+			}
+		case RoleAssistant:
+			role = RoleAssistant
+			if m.Content != "" {
+				blocks = append(blocks, anthropicBlock{Type: "text", Text: m.Content})
+			}
+			for _, tc := range m.ToolCalls {
+				input := json.RawMessage(tc.Arguments)
+				if !json.Valid(input) {
+					input = json.RawMessage("{}")
+				}
+				blocks = append(blocks, anthropicBlock{Type: "tool_use", ID: tc.ID, Name: tc.Name, Input: input})
+			}
+		case RoleTool:
+			// 工具结果以 user 消息中的 tool_result 块回填
+			role = RoleUser
+			blocks = append(blocks, anthropicBlock{Type: "tool_result", ToolUseID: m.ToolCallID, Content: m.Content})
+		default:
+			continue
+		}
+		if len(blocks) == 0 {
+			continue
+		}
+		// 要求 user/assistant 交替出现，相邻同角色的消息（如多个工具结果）合并为一条
+		if n := len(ar.Messages); n > 0 && ar.Messages[n-1].Role == role {
+			ar.Messages[n-1].Content = append(ar.Messages[n-1].Content, blocks...)
+			continue
+		}
+		ar.Messages = append(ar.Messages, anthropicMessage{Role: role, Content: blocks})
+	}
+	ar.System = strings.Join(system, "\n\n")
+	return ar
+}
+
+// anthropicImage data URL 转为 base64 图片源，其余按 URL 引用
+func anthropicImage(u string) *anthropicImageSource {
+	if rest, ok := strings.CutPrefix(u, "data:"); ok {
+		if meta, data, ok := strings.Cut(rest, ","); ok {
+			if mediaType, ok := strings.CutSuffix(meta, ";base64"); ok {
+				return &anthropicImageSource{Type: "base64", MediaType: mediaType, Data: data}
+			}
+		}
+	}
+	return &anthropicImageSource{Type: "url", URL: u}
+}
+
+func collectAnthropicToolCalls(tools map[int]*ChatToolCall, args map[int]*strings.Builder) []ChatToolCall {
+	indexes := make([]int, 0, len(tools))
+	for i := range tools {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	out := make([]ChatToolCall, 0, len(indexes))
+	for _, i := range indexes {
+		tc := *tools[i]
+		tc.Arguments = args[i].String()
+		if tc.Arguments == "" {
+			tc.Arguments = "{}"
+		}
+		out = append(out, tc)
+	}
+	return out
+}
+
+func fromAnthropicStopReason(reason string) string {
+	switch reason {
+	case "tool_use":
+		return FinishReasonToolCalls
+	case "max_tokens":
+		return FinishReasonLength
+	case "":
+		return ""
+	default:
+		return FinishReasonStop
+	}
+}
+
+func fromAnthropicUsage(u anthropicUsage) ChatUsage {
+	return ChatUsage{
+		PromptTokens:     u.InputTokens,
+		CompletionTokens: u.OutputTokens,
+		TotalTokens:      u.InputTokens + u.OutputTokens,
+	}
+}
+
+// anthropicStreamError 流中途的 error 事件没有 HTTP 状态码，按错误类型映射，便于统一判断是否回退
+func anthropicStreamError(typ, msg string) error {
+	code := http.StatusBadRequest
+	switch typ {
+	case "overloaded_error":
+		code = constant.AnthropicStatusOverloaded
+	case "rate_limit_error":
+		code = http.StatusTooManyRequests
+	case "api_error":
+		code = http.StatusInternalServerError
+	}
+	return &StatusError{StatusCode: code, Body: fmt.Sprintf("%s: %s", typ, msg)}
+}
@@ -0,0 +1,220 @@
+package ai_provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// newAnthropicStub 本地模拟 /v1/messages，handler 收到解析后的请求体
+func newAnthropicStub(handler func(w http.ResponseWriter, req *anthropicRequest)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/messages" || r.Header.Get("x-api-key") != "test-key" ||
+			r.Header.Get("anthropic-version") != constant.AnthropicAPIVersion {
+			http.Error(w, `{"type":"error","error":{"type":"invalid_request_error"}}`, http.StatusBadRequest)
+			return
+		}
+		var req anthropicRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		handler(w, &req)
+	}))
+}
+
+func writeSSE(w http.ResponseWriter, events ...string) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	for _, ev := range events {
+		var typ struct {
+			Type string `json:"type"`
+		}
+		_ = json.Unmarshal([]byte(ev), &typ)
+		_, _ = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", typ.Type, ev)
+	}
+}
+
+func toolHistory() *ChatParams {
+	return &ChatParams{
+		Messages: []ChatMessage{
+			SystemMessage("you are a bot"),
+			UserMessage("what is in the picture", ChatImage{URL: "data:image/png;base64,aGVsbG8="}),
+			AssistantMessage("let me check", ChatToolCall{ID: "call_1", Name: "a", Arguments: `{"x":1}`},
+				ChatToolCall{ID: "call_2", Name: "b", Arguments: ``}),
+			ToolMessage("result a", "call_1"),
+			ToolMessage("result b", "call_2"),
+		},
+		Tools: []ChatTool{{Name: "a", Description: "tool a", Parameters: map[string]any{"type": "object"}}},
+	}
+}
+
+func Test_anthropicBackend_Complete(t *testing.T) {
+	Convey("Test anthropicBackend Complete", t, func() {
+		var got *anthropicRequest
+		srv := newAnthropicStub(func(w http.ResponseWriter, req *anthropicRequest) {
+			got = req
+			_, _ = w.Write([]byte(`{
+				"content": [
+					{"type": "text", "text": "calling"},
+					{"type": "tool_use", "id": "toolu_1", "name": "a", "input": {"x": 2}}
+				],
+				"stop_reason": "tool_use",
+				"usage": {"input_tokens": 10, "output_tokens": 5}
+			}`))
+		})
+		defer srv.Close()
+
+		b := newAnthropicBackend(srv.URL, "test-key", time.Second)
+		resp, err := b.Complete(context.Background(), "claude-test", toolHistory())
+		So(err, ShouldBeNil)
+
+		Convey("request is converted to Messages API format", func() {
+			So(got.Model, ShouldEqual, "claude-test")
+			So(got.MaxTokens, ShouldEqual, constant.AnthropicDefaultMaxTokens)
+			So(got.System, ShouldEqual, "you are a bot")
+			So(got.Tools, ShouldHaveLength, 1)
+			So(got.Tools[0].InputSchema["type"], ShouldEqual, "object")
+			So(got.Messages, ShouldHaveLength, 3)
+
+			user := got.Messages[0]
+			So(user.Role, ShouldEqual, RoleUser)
+			So(user.Content[1].Type, ShouldEqual, "image")
+			So(*user.Content[1].Source, ShouldResemble, anthropicImageSource{Type: "base64", MediaType: "image/png", Data: "aGVsbG8="})
+
+			assistant := got.Messages[1]
+			So(assistant.Content, ShouldHaveLength, 3)
+			So(assistant.Content[1].Type, ShouldEqual, "tool_use")
+			So(string(assistant.Content[1].Input), ShouldEqual, `{"x":1}`)
+			So(string(assistant.Content[2].Input), ShouldEqual, `{}`)
+
+			// 两条工具结果合并为同一条 user 消息
+			results := got.Messages[2]
+			So(results.Role, ShouldEqual, RoleUser)
+			So(results.Content, ShouldHaveLength, 2)
+			So(results.Content[1].Type, ShouldEqual, "tool_result")
+			So(results.Content[1].ToolUseID, ShouldEqual, "call_2")
+			So(results.Content[1].Content, ShouldEqual, "result b")
+		})
+
+		Convey("response is converted to neutral completion", func() {
+			So(resp.Content, ShouldEqual, "calling")
+			So(resp.FinishReason, ShouldEqual, FinishReasonToolCalls)
+			So(resp.ToolCalls, ShouldResemble, []ChatToolCall{{ID: "toolu_1", Name: "a", Arguments: `{"x": 2}`}})
+			So(resp.Usage, ShouldResemble, ChatUsage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15})
+		})
+	})
+}
+
+func Test_anthropicBackend_Stream(t *testing.T) {
+	Convey("Test anthropicBackend Stream", t, func() {
+		srv := newAnthropicStub(func(w http.ResponseWriter, req *anthropicRequest) {
+			if !req.Stream {
+				http.Error(w, "stream expected", http.StatusBadRequest)
+				return
+			}
+			writeSSE(w,
+				`{"type":"message_start","message":{"content":[],"usage":{"input_tokens":7,"output_tokens":1}}}`,
+				`{"type":"content_block_start","index":0,"content_block":{"type":"text","text":""}}`,
+				`{"type":"ping"}`,
+				`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hel"}}`,
+				`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"lo"}}`,
+				`{"type":"content_block_stop","index":0}`,
+				`{"type":"content_block_start","index":1,"content_block":{"type":"tool_use","id":"toolu_1","name":"a","input":{}}}`,
+				`{"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"{\"x\":"}}`,
+				`{"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"3}"}}`,
+				`{"type":"content_block_stop","index":1}`,
+				`{"type":"message_delta","delta":{"stop_reason":"tool_use"},"usage":{"output_tokens":12}}`,
+				`{"type":"message_stop"}`,
+			)
+		})
+		defer srv.Close()
+
+		b := newAnthropicBackend(srv.URL, "test-key", time.Second)
+		var content string
+		var last *ChatChunk
+		err := b.Stream(context.Background(), "claude-test", toolHistory(), func(chunk *ChatChunk) error {
+			content += chunk.Content
+			last = chunk
+			return nil
+		})
+		So(err, ShouldBeNil)
+		So(content, ShouldEqual, "Hello")
+		So(last.FinishReason, ShouldEqual, FinishReasonToolCalls)
+		So(last.ToolCalls, ShouldResemble, []ChatToolCall{{ID: "toolu_1", Name: "a", Arguments: `{"x":3}`}})
+		So(*last.Usage, ShouldResemble, ChatUsage{PromptTokens: 7, CompletionTokens: 12, TotalTokens: 19})
+	})
+}
+
+func Test_Client_Fallback(t *testing.T) {
+	Convey("Test Client falls back on overloaded provider", t, func() {
+		overloaded := newAnthropicStub(func(w http.ResponseWriter, _ *anthropicRequest) {
+			http.Error(w, `{"type":"error","error":{"type":"overloaded_error"}}`, constant.AnthropicStatusOverloaded)
+		})
+		defer overloaded.Close()
+		healthy := newAnthropicStub(func(w http.ResponseWriter, req *anthropicRequest) {
+			_, _ = w.Write([]byte(`{"content":[{"type":"text","text":"from ` + req.Model + `"}],"stop_reason":"end_turn"}`))
+		})
+		defer healthy.Close()
+
+		registry, err := NewRegistry(&config.AiProviderConfig{
+			Providers: []config.AiProviderEntry{
+				{Name: "p1", Type: constant.AiProviderTypeAnthropic, BaseURL: overloaded.URL, APIKey: "test-key",
+					Models: []config.AiModelConfig{{Name: "m1", Tools: true, Vision: true}}},
+				{Name: "p2", Type: constant.AiProviderTypeAnthropic, BaseURL: healthy.URL, APIKey: "test-key",
+					Models: []config.AiModelConfig{{Name: "m2", Tools: true, Vision: true}, {Name: "m3", Vision: true}}},
+			},
+			Routes: []config.AiRouteConfig{{
+				Route: constant.AiRouteChat,
+				Chain: []config.AiRouteTarget{{Provider: "p1", Model: "m1"}, {Provider: "p2", Model: "m3"}, {Provider: "p2", Model: "m2"}},
+			}},
+		})
+		So(err, ShouldBeNil)
+		c := &Client{registry: registry}
+
+		Convey("5xx falls back to the next capable model", func() {
+			// m3 不支持工具，会被跳过
+			resp, err := c.Complete(context.Background(), constant.AiRouteChat, toolHistory())
+			So(err, ShouldBeNil)
+			So(resp.Content, ShouldEqual, "from m2")
+			So(resp.FinishReason, ShouldEqual, FinishReasonStop)
+		})
+
+		Convey("pinned model does not fall back", func() {
+			params := toolHistory()
+			params.Model = "m1"
+			_, err := c.Complete(context.Background(), constant.AiRouteChat, params)
+			So(statusCodeOf(err), ShouldEqual, constant.AnthropicStatusOverloaded)
+		})
+	})
+}
+
+func Test_ChatMessage_JSON(t *testing.T) {
+	Convey("Test ChatMessage keeps the OpenAI message format", t, func() {
+		stored := `[
+			{"role":"system","content":"sys"},
+			{"role":"user","content":[{"type":"text","text":"hi"},{"type":"image_url","image_url":{"url":"https://x/y.png"}}]},
+			{"role":"assistant","tool_calls":[{"id":"c1","type":"function","function":{"name":"a","arguments":"{}"}}]},
+			{"role":"tool","content":"ok","tool_call_id":"c1"}
+		]`
+		var msgs []ChatMessage
+		So(json.Unmarshal([]byte(stored), &msgs), ShouldBeNil)
+		So(msgs[1], ShouldResemble, UserMessage("hi", ChatImage{URL: "https://x/y.png"}))
+		So(msgs[2], ShouldResemble, AssistantMessage("", ChatToolCall{ID: "c1", Name: "a", Arguments: "{}"}))
+		So(msgs[3], ShouldResemble, ToolMessage("ok", "c1"))
+
+		b, err := json.Marshal(msgs)
+		So(err, ShouldBeNil)
+		var again []ChatMessage
+		So(json.Unmarshal(b, &again), ShouldBeNil)
+		So(again, ShouldResemble, msgs)
+	})
+}
@@ -0,0 +1,110 @@
+package ai_provider
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// errCircuitOpen 提供方熔断中，请求没有发出，可以直接回退到下一个模型
+var errCircuitOpen = errors.New("ai provider circuit open")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitHalfOpen
+	circuitOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitHalfOpen:
+		return "half_open"
+	case circuitOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// breaker 每个提供方一个的熔断器：连续 threshold 次瞬时失败后熔断，
+// openTimeout 之后进入半开状态，只放行一个探测请求，成功则恢复，失败则重新熔断
+type breaker struct {
+	name        string
+	threshold   int
+	openTimeout time.Duration
+
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+	probing  bool // 半开状态下是否已有探测请求在途
+}
+
+func newBreaker(name string, threshold int, openTimeout time.Duration) *breaker {
+	b := &breaker{name: name, threshold: threshold, openTimeout: openTimeout}
+	circuitStateGauge.WithLabelValues(name).Set(float64(circuitClosed))
+	return b
+}
+
+// allow 判断是否放行本次请求；放行后必须调用 done 汇报结果。nil 表示不熔断
+func (b *breaker) allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case circuitOpen:
+		if time.Since(b.openedAt) < b.openTimeout {
+			return errCircuitOpen
+		}
+		b.transition(circuitHalfOpen)
+		fallthrough
+	case circuitHalfOpen:
+		if b.probing {
+			return errCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// done 汇报一次放行请求的结果。healthy 为 false 表示瞬时失败（5xx/限流/超时）；
+// counted 为 false 表示结果与提供方健康无关（如调用方取消），只释放探测名额
+func (b *breaker) done(healthy, counted bool) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	wasProbe := b.state == circuitHalfOpen
+	if wasProbe {
+		b.probing = false
+	}
+	if !counted {
+		return
+	}
+	if healthy {
+		b.failures = 0
+		if wasProbe {
+			b.transition(circuitClosed)
+		}
+		return
+	}
+	b.failures++
+	if wasProbe || (b.state == circuitClosed && b.failures >= b.threshold) {
+		b.openedAt = time.Now()
+		b.transition(circuitOpen)
+	}
+}
+
+func (b *breaker) transition(to circuitState) {
+	if b.state == to {
+		return
+	}
+	b.state = to
+	circuitStateGauge.WithLabelValues(b.name).Set(float64(to))
+	circuitTransitions.WithLabelValues(b.name, to.String()).Inc()
+}
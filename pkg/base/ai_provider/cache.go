@@ -0,0 +1,138 @@
+package ai_provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// responseCache 非流式调用的精确匹配缓存，相同模型、消息、工具与采样参数直接复用上次的结果
+type responseCache struct {
+	rdb             *redis.Client
+	ttl             time.Duration
+	routes          map[string]bool
+	zeroTemperature bool
+}
+
+func newResponseCache(rdb *redis.Client, cfg config.AiCacheConfig) *responseCache {
+	c := &responseCache{
+		rdb:             rdb,
+		ttl:             cfg.TTL,
+		routes:          make(map[string]bool, len(cfg.Routes)),
+		zeroTemperature: cfg.ZeroTemperature,
+	}
+	if c.ttl <= 0 {
+		c.ttl = constant.AiResponseExpire
+	}
+	for _, r := range cfg.Routes {
+		c.routes[r] = true
+	}
+	return c
+}
+
+// UseCache 接入 Redis 响应缓存，未配置任何路由且未开启 zero_temperature 时不生效
+func (c *Client) UseCache(rdb *redis.Client) {
+	cfg := config.AiProvider.Cache
+	if rdb == nil || (len(cfg.Routes) == 0 && !cfg.ZeroTemperature) {
+		return
+	}
+	c.cache = newResponseCache(rdb, cfg)
+}
+
+// enabled 路由开启了缓存，或开启了 zero_temperature 且本次 temperature 为 0
+func (rc *responseCache) enabled(route string, req *ChatParams) bool {
+	if rc == nil {
+		return false
+	}
+	return rc.routes[route] || (rc.zeroTemperature && req.Temperature != nil && *req.Temperature == 0)
+}
+
+// cacheKey 对模型与请求内容做规范化哈希；json 编码 map 时按 key 排序，工具参数的顺序不影响结果
+func cacheKey(model string, req *ChatParams) (string, error) {
+	b, err := json.Marshal(struct {
+		Model       string        `json:"model"`
+		Messages    []ChatMessage `json:"messages"`
+		Tools       []ChatTool    `json:"tools,omitempty"`
+		MaxTokens   int64         `json:"max_tokens,omitempty"`
+		Temperature *float64      `json:"temperature,omitempty"`
+		TopP        *float64      `json:"top_p,omitempty"`
+		JSONMode    bool          `json:"json_mode,omitempty"`
+	}{model, req.Messages, req.Tools, req.MaxTokens, req.Temperature, req.TopP, req.JSONMode})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return "ai_response:" + hex.EncodeToString(sum[:]), nil
+}
+
+// get 按路由链顺序查找缓存，返回第一个命中的结果；Redis 出错视为未命中
+func (rc *responseCache) get(ctx context.Context, route string, chain []Target, req *ChatParams) *ChatCompletion {
+	keys := make([]string, 0, len(chain))
+	for _, t := range chain {
+		key, err := cacheKey(t.Model.Name, req)
+		if err != nil {
+			logger.Errorf("ai_provider.cache: build key failed: %v", err)
+			return nil
+		}
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	vals, err := rc.rdb.MGet(ctx, keys...).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		logger.Errorf("ai_provider.cache: MGet failed: %v", err)
+		cacheRequests.WithLabelValues(route, "error").Inc()
+		return nil
+	}
+	for i, v := range vals {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		var resp ChatCompletion
+		if err := json.Unmarshal([]byte(s), &resp); err != nil {
+			logger.Errorf("ai_provider.cache: unmarshal %s failed: %v", keys[i], err)
+			continue
+		}
+		logger.Infof("ai_provider.cache: hit route=%s key=%s", route, keys[i])
+		cacheRequests.WithLabelValues(route, "hit").Inc()
+		// 命中缓存没有消耗 token，不计入用量
+		resp.Usage = ChatUsage{}
+		return &resp
+	}
+	logger.Infof("ai_provider.cache: miss route=%s", route)
+	cacheRequests.WithLabelValues(route, "miss").Inc()
+	return nil
+}
+
+// set 以实际作答的模型为 key 写入缓存。被截断、为空的结果不缓存；
+// 含工具调用的结果也不缓存，重放会让调用方以相同的调用 ID 再执行一遍有副作用的工具
+func (rc *responseCache) set(ctx context.Context, model string, req *ChatParams, resp *ChatCompletion) {
+	if resp.FinishReason == FinishReasonLength || resp.Content == "" || len(resp.ToolCalls) > 0 {
+		return
+	}
+	key, err := cacheKey(model, req)
+	if err != nil {
+		logger.Errorf("ai_provider.cache: build key failed: %v", err)
+		return
+	}
+	b, err := json.Marshal(resp)
+	if err != nil {
+		logger.Errorf("ai_provider.cache: marshal response failed: %v", err)
+		return
+	}
+	if err := rc.rdb.Set(ctx, key, b, rc.ttl).Err(); err != nil {
+		logger.Errorf("ai_provider.cache: Set %s failed: %v", key, err)
+	}
+}
@@ -0,0 +1,158 @@
+package ai_provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+func Test_cacheKey(t *testing.T) {
+	Convey("Test cacheKey is canonical", t, func() {
+		a := toolHistory()
+		a.Tools[0].Parameters = map[string]any{"type": "object", "properties": map[string]any{"x": 1, "y": 2}}
+		b := toolHistory()
+		b.Tools[0].Parameters = map[string]any{"properties": map[string]any{"y": 2, "x": 1}, "type": "object"}
+
+		ka, err := cacheKey("m", a)
+		So(err, ShouldBeNil)
+		kb, _ := cacheKey("m", b)
+		So(ka, ShouldEqual, kb)
+
+		other, _ := cacheKey("m2", a)
+		So(other, ShouldNotEqual, ka)
+
+		zero := 0.0
+		b.Temperature = &zero
+		kt, _ := cacheKey("m", b)
+		So(kt, ShouldNotEqual, ka)
+	})
+
+	Convey("Test responseCache enabled per route", t, func() {
+		rc := newResponseCache(nil, config.AiCacheConfig{Routes: []string{constant.AiRouteSummarize}, ZeroTemperature: true})
+		zero, warm := 0.0, 0.7
+		So(rc.ttl, ShouldEqual, constant.AiResponseExpire)
+		So(rc.enabled(constant.AiRouteSummarize, &ChatParams{}), ShouldBeTrue)
+		So(rc.enabled(constant.AiRouteChat, &ChatParams{}), ShouldBeFalse)
+		So(rc.enabled(constant.AiRouteChat, &ChatParams{Temperature: &zero}), ShouldBeTrue)
+		So(rc.enabled(constant.AiRouteChat, &ChatParams{Temperature: &warm}), ShouldBeFalse)
+
+		var disabled *responseCache
+		So(disabled.enabled(constant.AiRouteSummarize, &ChatParams{}), ShouldBeFalse)
+	})
+}
+
+func Test_responseCache(t *testing.T) {
+	Convey("Test responseCache with Redis", t, func() {
+		mr := miniredis.NewMiniRedis()
+		So(mr.Start(), ShouldBeNil)
+		defer mr.Close()
+
+		var calls atomic.Int32
+		var toolUse atomic.Bool
+		srv := newAnthropicStub(func(w http.ResponseWriter, req *anthropicRequest) {
+			n := calls.Add(1)
+			if req.Stream {
+				writeSSE(w,
+					`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"streamed"}}`,
+					`{"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"output_tokens":1}}`,
+					`{"type":"message_stop"}`,
+				)
+				return
+			}
+			if toolUse.Load() {
+				_, _ = fmt.Fprintf(w, `{"content":[{"type":"tool_use","id":"toolu_%d","name":"a","input":{}}],"stop_reason":"tool_use"}`, n)
+				return
+			}
+			_, _ = fmt.Fprintf(w, `{"content":[{"type":"text","text":"answer %d"}],"stop_reason":"end_turn","usage":{"input_tokens":10,"output_tokens":2}}`, n)
+		})
+		defer srv.Close()
+
+		registry, err := NewRegistry(&config.AiProviderConfig{
+			Providers: []config.AiProviderEntry{{Name: "p", Type: constant.AiProviderTypeAnthropic, BaseURL: srv.URL,
+				APIKey: "test-key", Models: []config.AiModelConfig{{Name: "m", Tools: true, Vision: true}}}},
+		})
+		So(err, ShouldBeNil)
+		c := &Client{
+			registry: registry,
+			retry:    retryPolicy{maxAttempts: 1},
+			cache: newResponseCache(redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+				config.AiCacheConfig{Routes: []string{constant.AiRouteSummarize}, TTL: time.Minute}),
+		}
+		ctx := context.Background()
+		summarize := func() *ChatCompletion {
+			resp, err := c.Complete(ctx, constant.AiRouteSummarize, toolHistory())
+			So(err, ShouldBeNil)
+			return resp
+		}
+
+		Convey("the first call misses and the same request then hits without usage", func() {
+			first := summarize()
+			So(first.Content, ShouldEqual, "answer 1")
+			So(first.Usage.PromptTokens, ShouldEqual, 10)
+			So(mr.Keys(), ShouldHaveLength, 1)
+			So(mr.TTL(mr.Keys()[0]), ShouldEqual, time.Minute)
+
+			second := summarize()
+			So(second.Content, ShouldEqual, "answer 1")
+			So(second.Usage, ShouldResemble, ChatUsage{})
+			So(calls.Load(), ShouldEqual, 1)
+
+			Convey("a different request misses", func() {
+				req := toolHistory()
+				req.Messages = append(req.Messages, UserMessage("and now?"))
+				resp, err := c.Complete(ctx, constant.AiRouteSummarize, req)
+				So(err, ShouldBeNil)
+				So(resp.Content, ShouldEqual, "answer 2")
+				So(mr.Keys(), ShouldHaveLength, 2)
+			})
+
+			Convey("an expired entry is fetched again", func() {
+				mr.FastForward(time.Minute + time.Second)
+				So(summarize().Content, ShouldEqual, "answer 2")
+				So(calls.Load(), ShouldEqual, 2)
+			})
+		})
+
+		Convey("routes without cache neither read nor write Redis", func() {
+			summarize()
+			resp, err := c.Complete(ctx, constant.AiRouteChat, toolHistory())
+			So(err, ShouldBeNil)
+			So(resp.Content, ShouldEqual, "answer 2")
+			So(mr.Keys(), ShouldHaveLength, 1)
+		})
+
+		Convey("responses with tool calls are not cached", func() {
+			toolUse.Store(true)
+			first := summarize()
+			So(first.ToolCalls, ShouldHaveLength, 1)
+			So(mr.Keys(), ShouldBeEmpty)
+
+			second := summarize()
+			So(second.ToolCalls[0].ID, ShouldNotEqual, first.ToolCalls[0].ID)
+			So(calls.Load(), ShouldEqual, 2)
+		})
+
+		Convey("streaming bypasses the cache", func() {
+			summarize()
+			var content string
+			err := c.CompleteStream(ctx, constant.AiRouteSummarize, toolHistory(), func(chunk *ChatChunk) error {
+				content += chunk.Content
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(content, ShouldEqual, "streamed")
+			So(calls.Load(), ShouldEqual, 2)
+			So(mr.Keys(), ShouldHaveLength, 1)
+		})
+	})
+}
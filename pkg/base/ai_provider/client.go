@@ -11,20 +11,19 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
-	"github.com/openai/openai-go/v2"
-	"github.com/openai/openai-go/v2/option"
 	"io"
 	"net"
 	"net/http"
-	"strings"
 	"time"
 )
 
 type Client struct {
-	mode         string
-	baseURL      string
-	httpClient   *http.Client
-	openaiClient *openai.Client
+	mode       string
+	baseURL    string // Ollama 原生接口地址，仅 Chat/ChatStream 使用
+	httpClient *http.Client
+	registry   *Registry
+	retry      retryPolicy
+	cache      *responseCache // 为 nil 时不缓存
 }
 
 type ClientOptions struct {
@@ -36,51 +35,34 @@ type ClientOptions struct {
 func NewAiProviderClient() *Client {
 	to := config.AiProvider.Options.RequestTimout
 	if to <= 0 {
-		to = 60 * time.Second
+		to = constant.AiProviderDefaultRequestTimeout
 	}
-	switch config.AiProvider.Mode {
-	case constant.AiProviderModeLocal:
-		// 本地 AiProvider
-		base := strings.TrimRight(config.AiProvider.BaseURL, "/") + "/v1" // AiProvider 的 OpenAI 兼容层
-		openaiCli := openai.NewClient(
-			option.WithAPIKey("ollama"),
-			option.WithBaseURL(base),
-		)
-		return &Client{
-			mode:    constant.AiProviderModeLocal,
-			baseURL: config.AiProvider.BaseURL,
-			httpClient: &http.Client{
-				Timeout: to,
-				Transport: &http.Transport{
-					Proxy: http.ProxyFromEnvironment,
-					DialContext: (&net.Dialer{
-						Timeout:   10 * time.Second,
-						KeepAlive: 30 * time.Second,
-					}).DialContext,
-					ForceAttemptHTTP2:     true,
-					MaxIdleConns:          100,
-					IdleConnTimeout:       90 * time.Second,
-					TLSHandshakeTimeout:   10 * time.Second,
-					ExpectContinueTimeout: 1 * time.Second,
-				},
-			},
-			openaiClient: &openaiCli,
-		}
-	case constant.AiProviderModeRemote:
-		// 远程 openAI-API
-		openaiCli := openai.NewClient(
-			option.WithAPIKey(config.AiProvider.Remote.APIKey),
-			option.WithBaseURL(config.AiProvider.Remote.BaseURL))
-		return &Client{
-			mode:         constant.AiProviderModeRemote,
-			baseURL:      config.AiProvider.Remote.BaseURL,
-			openaiClient: &openaiCli,
-		}
-	default:
-		logger.Errorf("unsupported mode: %s", config.AiProvider.Mode)
+	registry, err := NewRegistry(config.AiProvider)
+	if err != nil {
+		logger.Errorf("ai_provider: build registry failed: %v", err)
 		return nil
 	}
-
+	return &Client{
+		mode:    config.AiProvider.Mode,
+		baseURL: config.AiProvider.BaseURL,
+		httpClient: &http.Client{
+			Timeout: to,
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				DialContext: (&net.Dialer{
+					Timeout:   10 * time.Second,
+					KeepAlive: 30 * time.Second,
+				}).DialContext,
+				ForceAttemptHTTP2:     true,
+				MaxIdleConns:          100,
+				IdleConnTimeout:       90 * time.Second,
+				TLSHandshakeTimeout:   10 * time.Second,
+				ExpectContinueTimeout: 1 * time.Second,
+			},
+		},
+		registry: registry,
+		retry:    newRetryPolicy(config.AiProvider.Retry),
+	}
 }
 
 // Chat 调用 /api/chat，非流式
@@ -168,39 +150,3 @@ func (c *Client) ChatStream(ctx context.Context, req ChatRequest, onChunk func(*
 	}
 	return sc.Err()
 }
-
-// ChatStreamOpenAI 使用 OpenAI 兼容层流式聊天
-func (c *Client) ChatStreamOpenAI(
-	ctx context.Context,
-	req openai.ChatCompletionNewParams,
-	onChunk func(*openai.ChatCompletionChunk) error,
-) error {
-	stream := c.openaiClient.Chat.Completions.NewStreaming(ctx, req)
-	defer stream.Close()
-	for stream.Next() {
-		chunk := stream.Current()
-		if err := onChunk(&chunk); err != nil {
-			if errors.Is(err, errno.OllamaInternalStopStream) {
-				return nil
-			}
-			return err
-		}
-	}
-	if err := stream.Err(); err != nil {
-		logger.Errorf("openai.ChatStreamOpenAI stream error: %v", err)
-		return err
-	}
-	return nil
-}
-
-func (c *Client) ChatOpenAI(
-	ctx context.Context,
-	req openai.ChatCompletionNewParams,
-) (*openai.ChatCompletion, error) {
-	resp, err := c.openaiClient.Chat.Completions.New(ctx, req)
-	if err != nil {
-		logger.Errorf("openai.ChatOpenAI error: %v", err)
-		return nil, err
-	}
-	return resp, nil
-}
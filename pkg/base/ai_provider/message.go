@@ -0,0 +1,201 @@
+package ai_provider
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// 与提供方无关的对话类型，Host 只依赖这些类型，由各 Backend 负责转换成具体协议
+
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+	RoleTool      = "tool"
+)
+
+// 结束原因，沿用 OpenAI 的取值
+const (
+	FinishReasonStop      = "stop"
+	FinishReasonToolCalls = "tool_calls"
+	FinishReasonLength    = "length"
+)
+
+// ChatMessage 对话消息。JSON 格式与 OpenAI Chat Completions 的消息一致，
+// 已持久化的历史无论来自哪个提供方都可以直接读回
+type ChatMessage struct {
+	Role       string
+	Content    string
+	Images     []ChatImage    // 仅 user 消息
+	ToolCalls  []ChatToolCall // 仅 assistant 消息
+	ToolCallID string         // 仅 tool 消息
+}
+
+// ChatImage 图片，URL 可以是 http(s) 地址或 data:image/...;base64,... 形式
+type ChatImage struct {
+	URL string
+}
+
+// ChatToolCall 模型发起的一次工具调用，Arguments 为 JSON 字符串
+type ChatToolCall struct {
+	ID        string
+	Name      string
+	Arguments string
+}
+
+// ChatTool 声明给模型的工具，Parameters 为 JSON Schema
+type ChatTool struct {
+	Name        string
+	Description string
+	Parameters  map[string]any
+}
+
+// ChatParams 一次模型调用的参数，Model 为空时由路由决定
+type ChatParams struct {
+	Model       string
+	Messages    []ChatMessage
+	Tools       []ChatTool
+	MaxTokens   int64 // 0 表示使用提供方默认值
+	Temperature *float64
+	TopP        *float64
+	JSONMode    bool // 要求输出 JSON 对象
+}
+
+type ChatUsage struct {
+	PromptTokens     int64
+	CompletionTokens int64
+	TotalTokens      int64
+}
+
+// ChatCompletion 非流式调用的结果
+type ChatCompletion struct {
+	Content      string
+	ToolCalls    []ChatToolCall
+	FinishReason string
+	Usage        ChatUsage
+}
+
+// ChatChunk 流式调用的一帧：Content 为增量文本；
+// 结束帧带 FinishReason，需要工具时同时带上聚合好的完整 ToolCalls；Usage 非空的帧携带本次用量
+type ChatChunk struct {
+	Content      string
+	ToolCalls    []ChatToolCall
+	FinishReason string
+	Usage        *ChatUsage
+}
+
+func SystemMessage(content string) ChatMessage {
+	return ChatMessage{Role: RoleSystem, Content: content}
+}
+
+func UserMessage(content string, images ...ChatImage) ChatMessage {
+	return ChatMessage{Role: RoleUser, Content: content, Images: images}
+}
+
+func AssistantMessage(content string, toolCalls ...ChatToolCall) ChatMessage {
+	return ChatMessage{Role: RoleAssistant, Content: content, ToolCalls: toolCalls}
+}
+
+func ToolMessage(content, toolCallID string) ChatMessage {
+	return ChatMessage{Role: RoleTool, Content: content, ToolCallID: toolCallID}
+}
+
+/************ OpenAI 兼容的 JSON 编解码 ************/
+
+type jsonMessage struct {
+	Role       string          `json:"role"`
+	Content    json.RawMessage `json:"content,omitempty"`
+	ToolCalls  []jsonToolCall  `json:"tool_calls,omitempty"`
+	ToolCallID string          `json:"tool_call_id,omitempty"`
+}
+
+type jsonContentPart struct {
+	Type     string `json:"type"`
+	Text     string `json:"text,omitempty"`
+	ImageURL *struct {
+		URL string `json:"url"`
+	} `json:"image_url,omitempty"`
+}
+
+type jsonToolCall struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Function struct {
+		Name      string `json:"name"`
+		Arguments string `json:"arguments"`
+	} `json:"function"`
+}
+
+func (m ChatMessage) MarshalJSON() ([]byte, error) {
+	out := jsonMessage{Role: m.Role, ToolCallID: m.ToolCallID}
+
+	var content any = m.Content
+	if len(m.Images) > 0 {
+		parts := []jsonContentPart{{Type: "text", Text: m.Content}}
+		for _, img := range m.Images {
+			part := jsonContentPart{Type: "image_url"}
+			part.ImageURL = &struct {
+				URL string `json:"url"`
+			}{URL: img.URL}
+			parts = append(parts, part)
+		}
+		content = parts
+	}
+	// assistant 只有 tool_calls 时不带 content
+	if m.Content != "" || len(m.Images) > 0 || m.Role != RoleAssistant {
+		b, err := json.Marshal(content)
+		if err != nil {
+			return nil, err
+		}
+		out.Content = b
+	}
+
+	for _, tc := range m.ToolCalls {
+		jtc := jsonToolCall{ID: tc.ID, Type: "function"}
+		jtc.Function.Name = tc.Name
+		jtc.Function.Arguments = tc.Arguments
+		out.ToolCalls = append(out.ToolCalls, jtc)
+	}
+	return json.Marshal(out)
+}
+
+func (m *ChatMessage) UnmarshalJSON(data []byte) error {
+	var in jsonMessage
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	*m = ChatMessage{Role: in.Role, ToolCallID: in.ToolCallID}
+
+	if len(in.Content) > 0 && string(in.Content) != "null" {
+		switch in.Content[0] {
+		case '"':
+			if err := json.Unmarshal(in.Content, &m.Content); err != nil {
+				return err
+			}
+		case '[':
+			var parts []jsonContentPart
+			if err := json.Unmarshal(in.Content, &parts); err != nil {
+				return err
+			}
+			for _, p := range parts {
+				switch {
+				case p.Type == "text":
+					m.Content += p.Text
+				case p.Type == "image_url" && p.ImageURL != nil:
+					m.Images = append(m.Images, ChatImage{URL: p.ImageURL.URL})
+				}
+			}
+		default:
+			return fmt.Errorf("unsupported message content: %s", in.Content)
+		}
+	}
+
+	for _, tc := range in.ToolCalls {
+		m.ToolCalls = append(m.ToolCalls, ChatToolCall{
+			ID:        tc.ID,
+			Name:      tc.Function.Name,
+			Arguments: tc.Function.Arguments,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package ai_provider
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// circuitStateGauge 熔断器状态：0 closed，1 half-open，2 open
+	circuitStateGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ai_provider",
+		Name:      "circuit_state",
+		Help:      "Circuit breaker state per provider (0=closed, 1=half-open, 2=open).",
+	}, []string{"provider"})
+
+	circuitTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ai_provider",
+		Name:      "circuit_transitions_total",
+		Help:      "Circuit breaker state transitions per provider.",
+	}, []string{"provider", "state"})
+
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ai_provider",
+		Name:      "requests_total",
+		Help:      "Model call attempts per provider and model, by result.",
+	}, []string{"provider", "model", "result"})
+
+	retriesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ai_provider",
+		Name:      "retries_total",
+		Help:      "Retries of model calls per provider and model.",
+	}, []string{"provider", "model"})
+
+	cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ai_provider",
+		Name:      "cache_requests_total",
+		Help:      "Response cache lookups per route, by result (hit/miss/error).",
+	}, []string{"route", "result"})
+)
+
+// 调用结果标签
+const (
+	resultSuccess     = "success"
+	resultError       = "error"        // 非瞬时错误（4xx 等），不计入熔断
+	resultTransient   = "transient"    // 5xx/限流/超时，计入熔断
+	resultCircuitOpen = "circuit_open" // 熔断中，未实际发出请求
+)
@@ -0,0 +1,162 @@
+package ai_provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/openai/openai-go/v2"
+	"github.com/openai/openai-go/v2/option"
+	"github.com/openai/openai-go/v2/packages/param"
+	"github.com/openai/openai-go/v2/shared"
+)
+
+// openaiBackend OpenAI Chat Completions 兼容接口（DashScope、DeepSeek、Ollama 兼容层...）
+type openaiBackend struct {
+	client  *openai.Client
+	timeout time.Duration // 非流式请求超时；流式请求的超时会覆盖整个响应体，因此不设置
+}
+
+func newOpenAIBackend(baseURL, apiKey string, timeout time.Duration) *openaiBackend {
+	cli := openai.NewClient(
+		option.WithAPIKey(apiKey),
+		option.WithBaseURL(baseURL),
+		option.WithMaxRetries(0), // 重试由 Client 统一负责，SDK 自带的重试会与之叠加
+	)
+	return &openaiBackend{client: &cli, timeout: timeout}
+}
+
+func (b *openaiBackend) Complete(ctx context.Context, model string, req *ChatParams) (*ChatCompletion, error) {
+	resp, err := b.client.Chat.Completions.New(ctx, toOpenAIParams(model, req), option.WithRequestTimeout(b.timeout))
+	if err != nil {
+		return nil, err
+	}
+	out := &ChatCompletion{Usage: fromOpenAIUsage(resp.Usage)}
+	if len(resp.Choices) == 0 {
+		return out, nil
+	}
+	choice := resp.Choices[0]
+	out.Content = choice.Message.Content
+	out.FinishReason = choice.FinishReason
+	out.ToolCalls = fromOpenAIToolCalls(choice.Message.ToolCalls)
+	return out, nil
+}
+
+func (b *openaiBackend) Stream(ctx context.Context, model string, req *ChatParams, onChunk func(*ChatChunk) error) error {
+	params := toOpenAIParams(model, req)
+	// 用量在 finish_reason 之后的最后一帧下发
+	params.StreamOptions = openai.ChatCompletionStreamOptionsParam{IncludeUsage: openai.Bool(true)}
+
+	stream := b.client.Chat.Completions.NewStreaming(ctx, params)
+	defer stream.Close()
+	var acc openai.ChatCompletionAccumulator
+	for stream.Next() {
+		chunk := stream.Current()
+		acc.AddChunk(chunk)
+
+		var out ChatChunk
+		if chunk.Usage.TotalTokens > 0 {
+			usage := fromOpenAIUsage(chunk.Usage)
+			out.Usage = &usage
+		}
+		if len(chunk.Choices) > 0 {
+			out.Content = chunk.Choices[0].Delta.Content
+			out.FinishReason = chunk.Choices[0].FinishReason
+			if out.FinishReason == FinishReasonToolCalls && len(acc.Choices) > 0 {
+				out.ToolCalls = fromOpenAIToolCalls(acc.Choices[0].Message.ToolCalls)
+			}
+		}
+		if out.Content == "" && out.FinishReason == "" && out.Usage == nil {
+			continue
+		}
+		if err := onChunk(&out); err != nil {
+			if errors.Is(err, errno.OllamaInternalStopStream) {
+				return nil
+			}
+			return err
+		}
+	}
+	return stream.Err()
+}
+
+func toOpenAIParams(model string, req *ChatParams) openai.ChatCompletionNewParams {
+	params := openai.ChatCompletionNewParams{
+		Model:    model,
+		Messages: make([]openai.ChatCompletionMessageParamUnion, 0, len(req.Messages)),
+	}
+	for _, m := range req.Messages {
+		params.Messages = append(params.Messages, toOpenAIMessage(m))
+	}
+	// 只有在 tools 非空时才传递 Tools 参数，避免阿里云 API 报错
+	for _, t := range req.Tools {
+		params.Tools = append(params.Tools, openai.ChatCompletionToolUnionParam{
+			OfFunction: &openai.ChatCompletionFunctionToolParam{
+				Function: openai.FunctionDefinitionParam{
+					Name:        t.Name,
+					Description: param.NewOpt(t.Description),
+					Parameters:  t.Parameters,
+				},
+			},
+		})
+	}
+	if req.MaxTokens > 0 {
+		params.MaxTokens = openai.Int(req.MaxTokens)
+	}
+	if req.Temperature != nil {
+		params.Temperature = openai.Float(*req.Temperature)
+	}
+	if req.TopP != nil {
+		params.TopP = openai.Float(*req.TopP)
+	}
+	if req.JSONMode {
+		params.ResponseFormat.OfJSONObject = &shared.ResponseFormatJSONObjectParam{}
+	}
+	return params
+}
+
+// toOpenAIMessage 带图片的 user 与带 tool_calls 的 assistant 消息嵌套较深，借助两者一致的 JSON 格式转换
+func toOpenAIMessage(m ChatMessage) openai.ChatCompletionMessageParamUnion {
+	var out openai.ChatCompletionMessageParamUnion
+	switch m.Role {
+	case RoleSystem:
+		return openai.SystemMessage(m.Content)
+	case RoleTool:
+		return openai.ToolMessage(m.Content, m.ToolCallID)
+	case RoleUser:
+		if len(m.Images) == 0 {
+			return openai.UserMessage(m.Content)
+		}
+	case RoleAssistant:
+		if len(m.ToolCalls) == 0 {
+			return openai.AssistantMessage(m.Content)
+		}
+	}
+	b, _ := json.Marshal(m)
+	_ = json.Unmarshal(b, &out)
+	return out
+}
+
+func fromOpenAIToolCalls(calls []openai.ChatCompletionMessageToolCallUnion) []ChatToolCall {
+	if len(calls) == 0 {
+		return nil
+	}
+	out := make([]ChatToolCall, 0, len(calls))
+	for _, tc := range calls {
+		out = append(out, ChatToolCall{
+			ID:        tc.ID,
+			Name:      tc.Function.Name,
+			Arguments: tc.Function.Arguments,
+		})
+	}
+	return out
+}
+
+func fromOpenAIUsage(u openai.CompletionUsage) ChatUsage {
+	return ChatUsage{
+		PromptTokens:     u.PromptTokens,
+		CompletionTokens: u.CompletionTokens,
+		TotalTokens:      u.TotalTokens,
+	}
+}
@@ -0,0 +1,227 @@
+package ai_provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// ModelSpec 模型及其能力
+type ModelSpec struct {
+	Name          string
+	Vision        bool
+	Tools         bool
+	JSONMode      bool
+	ContextLength int
+}
+
+// Backend 一种模型接口协议的实现，负责在中立类型与具体协议之间转换
+type Backend interface {
+	Complete(ctx context.Context, model string, req *ChatParams) (*ChatCompletion, error)
+	Stream(ctx context.Context, model string, req *ChatParams, onChunk func(*ChatChunk) error) error
+}
+
+// Provider 一个具名的模型提供方
+type Provider struct {
+	Name    string
+	Type    string
+	backend Backend
+	breaker *breaker
+	models  map[string]ModelSpec
+}
+
+// Target 路由链上的一个候选：提供方 + 模型
+type Target struct {
+	Provider *Provider
+	Model    ModelSpec
+}
+
+func (t Target) String() string {
+	return t.Provider.Name + "/" + t.Model.Name
+}
+
+// Registry 提供方注册表与路由规则
+type Registry struct {
+	providers map[string]*Provider
+	order     []Target // 按配置顺序列出的全部模型，未配置 chat 路由时作为默认链
+	routes    map[string][]Target
+}
+
+// NewRegistry 根据配置构建注册表，未配置 providers 时按 mode 生成单一提供方
+func NewRegistry(cfg *config.AiProviderConfig) (*Registry, error) {
+	entries := cfg.Providers
+	if len(entries) == 0 {
+		legacy, err := legacyProvider(cfg)
+		if err != nil {
+			return nil, err
+		}
+		entries = []config.AiProviderEntry{legacy}
+	}
+
+	timeout := cfg.Options.RequestTimout
+	if timeout <= 0 {
+		timeout = constant.AiProviderDefaultRequestTimeout
+	}
+	threshold, openTimeout := breakerSettings(cfg.Breaker)
+
+	r := &Registry{
+		providers: make(map[string]*Provider, len(entries)),
+		routes:    make(map[string][]Target),
+	}
+	for _, e := range entries {
+		if _, ok := r.providers[e.Name]; ok || e.Name == "" {
+			return nil, fmt.Errorf("ai provider name %q is empty or duplicated", e.Name)
+		}
+		p, err := newProvider(e, timeout)
+		if err != nil {
+			return nil, err
+		}
+		p.breaker = newBreaker(p.Name, threshold, openTimeout)
+		r.providers[p.Name] = p
+		for _, m := range e.Models {
+			r.order = append(r.order, Target{Provider: p, Model: p.models[m.Name]})
+		}
+	}
+	if len(r.order) == 0 {
+		return nil, fmt.Errorf("no ai model configured")
+	}
+
+	for _, rc := range cfg.Routes {
+		chain := make([]Target, 0, len(rc.Chain))
+		for _, t := range rc.Chain {
+			p, ok := r.providers[t.Provider]
+			if !ok {
+				return nil, fmt.Errorf("route %s: unknown provider %q", rc.Route, t.Provider)
+			}
+			spec, ok := p.models[t.Model]
+			if !ok {
+				return nil, fmt.Errorf("route %s: provider %s has no model %q", rc.Route, t.Provider, t.Model)
+			}
+			chain = append(chain, Target{Provider: p, Model: spec})
+		}
+		r.routes[rc.Route] = chain
+	}
+	return r, nil
+}
+
+// breakerSettings 熔断器配置，未配置的字段使用默认值
+func breakerSettings(cfg config.AiBreakerConfig) (int, time.Duration) {
+	threshold, openTimeout := cfg.FailureThreshold, cfg.OpenTimeout
+	if threshold <= 0 {
+		threshold = constant.AiBreakerDefaultFailureThreshold
+	}
+	if openTimeout <= 0 {
+		openTimeout = constant.AiBreakerDefaultOpenTimeout
+	}
+	return threshold, openTimeout
+}
+
+// legacyProvider 旧配置只有一个提供方和一个模型，该模型不声明图片能力；
+// 与引入路由之前一致，携带图片的对话改用同一提供方上的 AiLegacyVisionModel
+func legacyProvider(cfg *config.AiProviderConfig) (config.AiProviderEntry, error) {
+	model := config.AiModelConfig{Name: cfg.Model, Tools: true, JSONMode: true}
+	vision := config.AiModelConfig{Name: constant.AiLegacyVisionModel, Vision: true, Tools: true, JSONMode: true}
+	models := func(m config.AiModelConfig) []config.AiModelConfig {
+		if m.Name == vision.Name {
+			m.Vision = true
+			return []config.AiModelConfig{m}
+		}
+		return []config.AiModelConfig{m, vision}
+	}
+	switch cfg.Mode {
+	case constant.AiProviderModeLocal:
+		return config.AiProviderEntry{
+			Name:    constant.AiProviderTypeOllama,
+			Type:    constant.AiProviderTypeOllama,
+			BaseURL: cfg.BaseURL,
+			Models:  models(model),
+		}, nil
+	case constant.AiProviderModeRemote:
+		name := cfg.Remote.Provider
+		if name == "" {
+			name = constant.AiProviderModeRemote
+		}
+		if cfg.Remote.Model != "" {
+			model.Name = cfg.Remote.Model
+		}
+		// remote.provider 为 anthropic 时走 Messages API，其余都视为 OpenAI 兼容接口；
+		// Anthropic 上没有 AiLegacyVisionModel，需要图片时应改用 providers 配置声明 vision 模型
+		if name == constant.AiProviderTypeAnthropic {
+			model.JSONMode = false
+			return config.AiProviderEntry{
+				Name:    name,
+				Type:    constant.AiProviderTypeAnthropic,
+				BaseURL: cfg.Remote.BaseURL,
+				APIKey:  cfg.Remote.APIKey,
+				Models:  []config.AiModelConfig{model},
+			}, nil
+		}
+		return config.AiProviderEntry{
+			Name:    name,
+			Type:    constant.AiProviderTypeOpenAI,
+			BaseURL: cfg.Remote.BaseURL,
+			APIKey:  cfg.Remote.APIKey,
+			Models:  models(model),
+		}, nil
+	default:
+		return config.AiProviderEntry{}, fmt.Errorf("unsupported mode: %s", cfg.Mode)
+	}
+}
+
+func newProvider(e config.AiProviderEntry, timeout time.Duration) (*Provider, error) {
+	var backend Backend
+	switch e.Type {
+	case constant.AiProviderTypeOpenAI:
+		backend = newOpenAIBackend(e.BaseURL, e.APIKey, timeout)
+	case constant.AiProviderTypeOllama:
+		// Ollama 的 OpenAI 兼容层，不校验 api key
+		backend = newOpenAIBackend(strings.TrimRight(e.BaseURL, "/")+"/v1", "ollama", timeout)
+	case constant.AiProviderTypeAnthropic:
+		backend = newAnthropicBackend(e.BaseURL, e.APIKey, timeout)
+	default:
+		return nil, fmt.Errorf("provider %s: unsupported type %q", e.Name, e.Type)
+	}
+
+	p := &Provider{
+		Name:    e.Name,
+		Type:    e.Type,
+		backend: backend,
+		models:  make(map[string]ModelSpec, len(e.Models)),
+	}
+	for _, m := range e.Models {
+		p.models[m.Name] = ModelSpec{
+			Name:          m.Name,
+			Vision:        m.Vision,
+			Tools:         m.Tools,
+			JSONMode:      m.JSONMode,
+			ContextLength: m.ContextLength,
+		}
+	}
+	return p, nil
+}
+
+// Route 返回某类请求的候选链，未配置的路由使用 chat，chat 也未配置时使用全部模型
+func (r *Registry) Route(route string) []Target {
+	if chain, ok := r.routes[route]; ok {
+		return chain
+	}
+	if chain, ok := r.routes[constant.AiRouteChat]; ok {
+		return chain
+	}
+	return r.order
+}
+
+// Lookup 按模型名查找，同名模型出现在多个提供方时按配置顺序返回全部
+func (r *Registry) Lookup(model string) []Target {
+	var out []Target
+	for _, t := range r.order {
+		if t.Model.Name == model {
+			out = append(out, t)
+		}
+	}
+	return out
+}
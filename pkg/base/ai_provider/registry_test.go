@@ -0,0 +1,65 @@
+package ai_provider
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+func Test_legacyProvider(t *testing.T) {
+	Convey("Test legacy single-model config", t, func() {
+		visionReq := &ChatParams{Messages: []ChatMessage{UserMessage("what is this", ChatImage{URL: "data:image/png;base64,aGVsbG8="})}}
+		textReq := &ChatParams{Messages: []ChatMessage{UserMessage("hi")}}
+
+		Convey("the configured model has no vision, images go to the legacy vision model", func() {
+			r, err := NewRegistry(&config.AiProviderConfig{
+				Mode:   constant.AiProviderModeRemote,
+				Model:  "qwen-plus",
+				Remote: config.AiProviderRemoteConfig{Provider: "aliyun"},
+			})
+			So(err, ShouldBeNil)
+			c := &Client{registry: r}
+
+			chain, err := c.targets(constant.AiRouteChat, textReq)
+			So(err, ShouldBeNil)
+			So(chain[0].Model.Name, ShouldEqual, "qwen-plus")
+			So(chain[0].Model.Vision, ShouldBeFalse)
+
+			chain, err = c.targets(constant.AiRouteVision, visionReq)
+			So(err, ShouldBeNil)
+			So(chain, ShouldHaveLength, 1)
+			So(chain[0].String(), ShouldEqual, "aliyun/"+constant.AiLegacyVisionModel)
+
+			primary, err := c.PrimaryModel(constant.AiRouteVision)
+			So(err, ShouldBeNil)
+			So(primary, ShouldEqual, constant.AiLegacyVisionModel)
+			primary, _ = c.PrimaryModel(constant.AiRouteChat)
+			So(primary, ShouldEqual, "qwen-plus")
+		})
+
+		Convey("configuring the vision model itself keeps a single model", func() {
+			r, err := NewRegistry(&config.AiProviderConfig{Mode: constant.AiProviderModeLocal, Model: constant.AiLegacyVisionModel})
+			So(err, ShouldBeNil)
+			So(r.order, ShouldHaveLength, 1)
+			So(r.order[0].Model.Vision, ShouldBeTrue)
+		})
+
+		Convey("without any vision model the vision route fails clearly", func() {
+			r, err := NewRegistry(&config.AiProviderConfig{
+				Mode:   constant.AiProviderModeRemote,
+				Remote: config.AiProviderRemoteConfig{Provider: constant.AiProviderTypeAnthropic, Model: "claude-test"},
+			})
+			So(err, ShouldBeNil)
+			c := &Client{registry: r}
+
+			_, err = c.PrimaryModel(constant.AiRouteVision)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "vision=true")
+			_, err = c.targets(constant.AiRouteVision, visionReq)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,91 @@
+package ai_provider
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/openai/openai-go/v2"
+)
+
+// retryPolicy 单个模型上的重试策略，零值表示不重试
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+}
+
+func newRetryPolicy(cfg config.AiRetryConfig) retryPolicy {
+	p := retryPolicy{maxAttempts: cfg.MaxAttempts, baseDelay: cfg.BaseDelay, maxDelay: cfg.MaxDelay}
+	if p.maxAttempts <= 0 {
+		p.maxAttempts = constant.AiRetryDefaultMaxAttempts
+	}
+	if p.baseDelay <= 0 {
+		p.baseDelay = constant.AiRetryDefaultBaseDelay
+	}
+	if p.maxDelay <= 0 {
+		p.maxDelay = constant.AiRetryDefaultMaxDelay
+	}
+	return p
+}
+
+// attempts 含首次调用的总次数
+func (p retryPolicy) attempts() int {
+	return max(p.maxAttempts, 1)
+}
+
+// delay 第 attempt 次失败后的等待时长。提供方给出 Retry-After 时以其为准，
+// 超过 maxDelay 则返回 false，不在该模型上继续等待
+func (p retryPolicy) delay(attempt int, err error) (time.Duration, bool) {
+	if d, ok := retryAfter(err); ok {
+		return d, d <= p.maxDelay
+	}
+	d := p.baseDelay << (attempt - 1)
+	if d <= 0 || d > p.maxDelay {
+		d = p.maxDelay
+	}
+	// 抖动：在 [d/2, d] 内随机，避免多个请求同时重试
+	half := d / 2
+	return half + rand.N(d-half+1), true
+}
+
+// retryAfter 解析错误响应中的 Retry-After 头，支持秒数与 HTTP 日期两种格式
+func retryAfter(err error) (time.Duration, bool) {
+	var header http.Header
+	var statusErr *StatusError
+	var apiErr *openai.Error
+	switch {
+	case errors.As(err, &statusErr):
+		header = statusErr.Header
+	case errors.As(err, &apiErr) && apiErr.Response != nil:
+		header = apiErr.Response.Header
+	}
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// sleepCtx 等待 d，ctx 结束时提前返回其错误
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,108 @@
+package ai_provider
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+func Test_retryPolicy_delay(t *testing.T) {
+	Convey("Test retryPolicy delay", t, func() {
+		p := retryPolicy{maxAttempts: 3, baseDelay: 100 * time.Millisecond, maxDelay: time.Second}
+
+		Convey("exponential backoff with jitter is capped by maxDelay", func() {
+			for attempt := 1; attempt <= 6; attempt++ {
+				d, ok := p.delay(attempt, &StatusError{StatusCode: http.StatusServiceUnavailable})
+				So(ok, ShouldBeTrue)
+				want := min(p.baseDelay<<(attempt-1), p.maxDelay)
+				So(d, ShouldBeBetweenOrEqual, want/2, want)
+			}
+		})
+
+		Convey("Retry-After is honored", func() {
+			err := &StatusError{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"1"}}}
+			d, ok := p.delay(1, err)
+			So(ok, ShouldBeTrue)
+			So(d, ShouldEqual, time.Second)
+
+			err.Header.Set("Retry-After", "120")
+			_, ok = p.delay(1, err)
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
+
+func Test_Client_Retry(t *testing.T) {
+	Convey("Test Client retries and circuit breaker", t, func() {
+		var calls atomic.Int32
+		var failFirst atomic.Int32
+		srv := newAnthropicStub(func(w http.ResponseWriter, req *anthropicRequest) {
+			calls.Add(1)
+			if failFirst.Add(-1) >= 0 {
+				http.Error(w, `{"type":"error","error":{"type":"overloaded_error"}}`, http.StatusServiceUnavailable)
+				return
+			}
+			if req.Stream {
+				writeSSE(w,
+					`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"ok"}}`,
+					`{"type":"message_delta","delta":{"stop_reason":"end_turn"},"usage":{"output_tokens":1}}`,
+					`{"type":"message_stop"}`,
+				)
+				return
+			}
+			_, _ = w.Write([]byte(`{"content":[{"type":"text","text":"ok"}],"stop_reason":"end_turn"}`))
+		})
+		defer srv.Close()
+
+		registry, err := NewRegistry(&config.AiProviderConfig{
+			Providers: []config.AiProviderEntry{{Name: "p", Type: constant.AiProviderTypeAnthropic, BaseURL: srv.URL,
+				APIKey: "test-key", Models: []config.AiModelConfig{{Name: "m", Tools: true, Vision: true}}}},
+			Breaker: config.AiBreakerConfig{FailureThreshold: 2, OpenTimeout: 50 * time.Millisecond},
+		})
+		So(err, ShouldBeNil)
+		c := &Client{registry: registry, retry: retryPolicy{maxAttempts: 2, baseDelay: time.Millisecond, maxDelay: 10 * time.Millisecond}}
+
+		Convey("transient failure is retried on the same model", func() {
+			failFirst.Store(1)
+			resp, err := c.Complete(context.Background(), constant.AiRouteChat, toolHistory())
+			So(err, ShouldBeNil)
+			So(resp.Content, ShouldEqual, "ok")
+			So(calls.Load(), ShouldEqual, 2)
+		})
+
+		Convey("stream failing before the first chunk is retried", func() {
+			failFirst.Store(1)
+			var content string
+			err := c.CompleteStream(context.Background(), constant.AiRouteChat, toolHistory(), func(chunk *ChatChunk) error {
+				content += chunk.Content
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(content, ShouldEqual, "ok")
+			So(calls.Load(), ShouldEqual, 2)
+		})
+
+		Convey("breaker opens after consecutive failures and half-opens after timeout", func() {
+			failFirst.Store(2)
+			_, err := c.Complete(context.Background(), constant.AiRouteChat, toolHistory())
+			So(statusCodeOf(err), ShouldEqual, http.StatusServiceUnavailable)
+
+			_, err = c.Complete(context.Background(), constant.AiRouteChat, toolHistory())
+			So(err, ShouldEqual, errCircuitOpen)
+			So(calls.Load(), ShouldEqual, 2)
+
+			time.Sleep(60 * time.Millisecond)
+			resp, err := c.Complete(context.Background(), constant.AiRouteChat, toolHistory())
+			So(err, ShouldBeNil)
+			So(resp.Content, ShouldEqual, "ok")
+			So(calls.Load(), ShouldEqual, 3)
+		})
+	})
+}
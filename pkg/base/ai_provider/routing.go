@@ -0,0 +1,247 @@
+package ai_provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/errno"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/openai/openai-go/v2"
+)
+
+// StatusError 提供方返回的非 2xx 响应（OpenAI 兼容接口的错误由 openai.Error 表示）
+type StatusError struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status %d: %s", e.StatusCode, e.Body)
+}
+
+// statusCodeOf 取出错误对应的 HTTP 状态码，非 HTTP 错误返回 0
+func statusCodeOf(err error) int {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode
+	}
+	var apiErr *openai.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// requirements 请求所需的模型能力
+type requirements struct {
+	vision   bool
+	tools    bool
+	jsonMode bool
+}
+
+func requirementsOf(req *ChatParams) requirements {
+	r := requirements{tools: len(req.Tools) > 0, jsonMode: req.JSONMode}
+	for _, msg := range req.Messages {
+		if len(msg.Images) > 0 {
+			r.vision = true
+		}
+	}
+	return r
+}
+
+func (r requirements) satisfiedBy(m ModelSpec) bool {
+	return (!r.vision || m.Vision) && (!r.tools || m.Tools) && (!r.jsonMode || m.JSONMode)
+}
+
+// targets 选出本次请求的候选链：req.Model 非空时固定使用该模型，否则按 route 路由；
+// 不具备请求所需能力的模型会被跳过
+func (c *Client) targets(route string, req *ChatParams) ([]Target, error) {
+	var chain []Target
+	if req.Model != "" {
+		chain = c.registry.Lookup(req.Model)
+	} else {
+		chain = c.registry.Route(route)
+	}
+
+	need := requirementsOf(req)
+	out := make([]Target, 0, len(chain))
+	for _, t := range chain {
+		if need.satisfiedBy(t.Model) {
+			out = append(out, t)
+		}
+	}
+	if len(out) == 0 {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode,
+			"no model available for route %q (model %q, vision=%v tools=%v json=%v)",
+			route, req.Model, need.vision, need.tools, need.jsonMode)
+	}
+	return out, nil
+}
+
+// isTransient 5xx、限流与超时属于瞬时失败，值得重试或换下一个模型；调用方自身取消/超时则不算
+func isTransient(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	if code := statusCodeOf(err); code != 0 {
+		return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// shouldFallback 瞬时失败或提供方熔断中时换下一个模型
+func shouldFallback(ctx context.Context, err error) bool {
+	return isTransient(ctx, err) || (errors.Is(err, errCircuitOpen) && ctx.Err() == nil)
+}
+
+// invoke 在单个提供方上执行一次调用：先经过熔断器，瞬时失败按退避重试。
+// fn 返回 started 表示已经有输出交给调用方，此后不再重试
+func (c *Client) invoke(ctx context.Context, br *breaker, provider, model string, fn func() (bool, error)) error {
+	attempts := c.retry.attempts()
+	for attempt := 1; ; attempt++ {
+		if err := br.allow(); err != nil {
+			requestsTotal.WithLabelValues(provider, model, resultCircuitOpen).Inc()
+			return err
+		}
+		started, err := fn()
+		transient := isTransient(ctx, err)
+		// 调用方取消与提供方健康无关，不计入熔断
+		br.done(!transient, err == nil || ctx.Err() == nil)
+
+		switch {
+		case err == nil:
+			requestsTotal.WithLabelValues(provider, model, resultSuccess).Inc()
+			return nil
+		case transient:
+			requestsTotal.WithLabelValues(provider, model, resultTransient).Inc()
+		default:
+			requestsTotal.WithLabelValues(provider, model, resultError).Inc()
+		}
+		if !transient || started || attempt >= attempts {
+			return err
+		}
+		wait, ok := c.retry.delay(attempt, err)
+		if !ok {
+			logger.Warnf("ai_provider: %s/%s asks to retry after %v, give up: %v", provider, model, wait, err)
+			return err
+		}
+		logger.Warnf("ai_provider: %s/%s attempt %d failed, retry in %v: %v", provider, model, attempt, wait, err)
+		retriesTotal.WithLabelValues(provider, model).Inc()
+		if sleepErr := sleepCtx(ctx, wait); sleepErr != nil {
+			return err
+		}
+	}
+}
+
+// Complete 非流式调用，按路由链依次尝试；路由开启缓存时先查 Redis
+func (c *Client) Complete(ctx context.Context, route string, req *ChatParams) (*ChatCompletion, error) {
+	chain, err := c.targets(route, req)
+	if err != nil {
+		return nil, err
+	}
+	cached := c.cache.enabled(route, req)
+	if cached {
+		if resp := c.cache.get(ctx, route, chain, req); resp != nil {
+			return resp, nil
+		}
+	}
+	for i, t := range chain {
+		if i > 0 {
+			logger.Warnf("ai_provider.Complete %s failed, fallback to %s: %v", chain[i-1], t, err)
+		}
+		var resp *ChatCompletion
+		err = c.invoke(ctx, t.Provider.breaker, t.Provider.Name, t.Model.Name, func() (bool, error) {
+			var callErr error
+			resp, callErr = t.Provider.backend.Complete(ctx, t.Model.Name, req)
+			return false, callErr
+		})
+		if err == nil {
+			if cached {
+				c.cache.set(ctx, t.Model.Name, req, resp)
+			}
+			return resp, nil
+		}
+		if !shouldFallback(ctx, err) {
+			break
+		}
+	}
+	logger.Errorf("ai_provider.Complete error: %v", err)
+	return nil, err
+}
+
+// CompleteStream 流式调用，按路由链依次尝试，不经过响应缓存。
+// 还没有任何 chunk 交给 onChunk 时才会重试或回退，避免调用方收到两次拼接的输出
+func (c *Client) CompleteStream(ctx context.Context, route string, req *ChatParams, onChunk func(*ChatChunk) error) error {
+	chain, err := c.targets(route, req)
+	if err != nil {
+		return err
+	}
+	started := false
+	for i, t := range chain {
+		if i > 0 {
+			logger.Warnf("ai_provider.CompleteStream %s failed, fallback to %s: %v", chain[i-1], t, err)
+		}
+		err = c.invoke(ctx, t.Provider.breaker, t.Provider.Name, t.Model.Name, func() (bool, error) {
+			streamErr := t.Provider.backend.Stream(ctx, t.Model.Name, req, func(chunk *ChatChunk) error {
+				started = true
+				return onChunk(chunk)
+			})
+			return started, streamErr
+		})
+		if err == nil {
+			return nil
+		}
+		if started || !shouldFallback(ctx, err) {
+			break
+		}
+	}
+	logger.Errorf("ai_provider.CompleteStream stream error: %v", err)
+	return err
+}
+
+// PrimaryModel 路由链上的首选模型，用于估算上下文预算等；vision 路由只考虑支持图片的模型，
+// 没有这样的模型时返回错误，而不是把图片交给不支持的模型
+func (c *Client) PrimaryModel(route string) (string, error) {
+	var need requirements
+	if route == constant.AiRouteVision {
+		need.vision = true
+	}
+	for _, t := range c.registry.Route(route) {
+		if need.satisfiedBy(t.Model) {
+			return t.Model.Name, nil
+		}
+	}
+	return "", errno.Errorf(errno.InternalServiceErrorCode,
+		"no model available for route %q (vision=%v), declare one in ai_provider.providers", route, need.vision)
+}
+
+// SupportsToolImages 路由链（model 非空时为该模型）中是否有同时支持图片与工具的模型，
+// 用于决定能否把工具返回的图片交给模型继续多轮工具调用
+func (c *Client) SupportsToolImages(route, model string) bool {
+	chain := c.registry.Route(route)
+	if model != "" {
+		chain = c.registry.Lookup(model)
+	}
+	need := requirements{vision: true, tools: true}
+	return slices.ContainsFunc(chain, func(t Target) bool { return need.satisfiedBy(t.Model) })
+}
+
+// ContextLength 模型在注册表中声明的上下文窗口，未声明时返回 0
+func (c *Client) ContextLength(model string) int {
+	for _, t := range c.registry.Lookup(model) {
+		if t.Model.ContextLength > 0 {
+			return t.Model.ContextLength
+		}
+	}
+	return 0
+}
@@ -4,8 +4,10 @@ import (
 	"sync"
 
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/lifecycle"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_policy"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
@@ -25,7 +27,10 @@ type ClientSet struct {
 	RegistryResolver registry.Resolver
 	ActualDB         *gorm.DB
 	Cache            *redis.Client
+	ToolPolicy       *tool_policy.Engine
+	Lifecycle        *lifecycle.Manager
 	cleanups         []func()
+	closeOnce        sync.Once
 }
 
 type Option func(clientSet *ClientSet)
@@ -42,10 +47,14 @@ func NewClientSet(opt ...Option) *ClientSet {
 	})
 	return instance
 }
+
+// Close 按 Option 的先后顺序关闭各客户端，重复调用只执行一次
 func (cs *ClientSet) Close() {
-	for _, cleanup := range cs.cleanups {
-		cleanup()
-	}
+	cs.closeOnce.Do(func() {
+		for _, cleanup := range cs.cleanups {
+			cleanup()
+		}
+	})
 }
 
 // SetGlobalClientSet 设置全局 ClientSet 实例（用于 MCP 服务等场景）
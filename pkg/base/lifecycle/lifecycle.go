@@ -0,0 +1,336 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// ErrShuttingDown 进入关闭流程后不再接收新的工作
+var ErrShuttingDown = errors.New("lifecycle: shutting down")
+
+// Manager 进程生命周期：存活/就绪探针、信号处理与分阶段关闭。
+// 关闭顺序：就绪探针转为 503 → 注销注册 → 等待 drainDelay → 排空进行中的工作（超过 timeout 后取消）→ 按登记顺序关闭客户端
+type Manager struct {
+	name       string
+	timeout    time.Duration
+	drainDelay time.Duration
+	probeAddr  string
+
+	ready atomic.Bool
+	// base 是进行中工作的父 ctx，排空超时后取消
+	base       context.Context
+	cancelBase context.CancelCauseFunc
+	// draining 进入关闭流程时关闭，长连接据此提前结束
+	draining chan struct{}
+	errCh    chan error
+
+	mu          sync.Mutex
+	closing     bool
+	inflight    sync.WaitGroup
+	deregisters []func() error
+	drains      []func(ctx context.Context) error
+	closers     []closer
+
+	shutdownOnce sync.Once
+	shutdownErr  error
+}
+
+type closer struct {
+	name string
+	fn   func()
+}
+
+type Option func(m *Manager)
+
+// WithTimeout 排空进行中工作的时限
+func WithTimeout(d time.Duration) Option {
+	return func(m *Manager) {
+		if d > 0 {
+			m.timeout = d
+		}
+	}
+}
+
+// WithDrainDelay 注销后继续正常服务的时长
+func WithDrainDelay(d time.Duration) Option {
+	return func(m *Manager) {
+		m.drainDelay = max(d, 0)
+	}
+}
+
+// WithProbeAddr 探针监听地址，为空时不启动探针
+func WithProbeAddr(addr string) Option {
+	return func(m *Manager) {
+		m.probeAddr = addr
+	}
+}
+
+// New 默认从 server.shutdown 与 services.<name>.probe-addr 读取配置，opts 可覆盖
+func New(name string, opts ...Option) *Manager {
+	base, cancel := context.WithCancelCause(context.Background())
+	m := &Manager{
+		name:       name,
+		timeout:    constant.ShutdownDefaultTimeout,
+		base:       base,
+		cancelBase: cancel,
+		draining:   make(chan struct{}),
+		errCh:      make(chan error, 1),
+	}
+	var defaults []Option
+	if config.Server != nil {
+		defaults = append(defaults, WithTimeout(config.Server.Shutdown.Timeout), WithDrainDelay(config.Server.Shutdown.DrainDelay))
+	}
+	if config.Service != nil {
+		defaults = append(defaults, WithProbeAddr(config.Service.ProbeAddr))
+	}
+	for _, opt := range append(defaults, opts...) {
+		opt(m)
+	}
+	return m
+}
+
+// SetReady 启动完成（监听就绪、已注册）后调用，就绪探针开始返回 200
+func (m *Manager) SetReady() {
+	m.ready.Store(true)
+}
+
+// Ready 已就绪且未进入关闭流程
+func (m *Manager) Ready() bool {
+	select {
+	case <-m.draining:
+		return false
+	default:
+		return m.ready.Load()
+	}
+}
+
+// Draining 进入关闭流程时关闭
+func (m *Manager) Draining() <-chan struct{} {
+	return m.draining
+}
+
+// UntilDraining 返回的 ctx 在进入关闭流程时取消，用于不计入排空、应尽早断开的长连接
+func (m *Manager) UntilDraining(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-m.draining:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// Context 排空超时后取消，不受单个请求生命周期约束的后台工作可从它派生
+func (m *Manager) Context() context.Context {
+	return m.base
+}
+
+// Track 登记一项进行中的工作，关闭流程会等待它结束。返回的 ctx 在 ctx 结束或排空超时后取消；
+// release 必须调用且可重复调用。进入关闭流程后返回 ErrShuttingDown
+func (m *Manager) Track(ctx context.Context) (context.Context, func(), error) {
+	m.mu.Lock()
+	if m.closing {
+		m.mu.Unlock()
+		return nil, nil, ErrShuttingDown
+	}
+	m.inflight.Add(1)
+	m.mu.Unlock()
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	stop := context.AfterFunc(m.base, func() { cancel(context.Cause(m.base)) })
+	var once sync.Once
+	return ctx, func() {
+		once.Do(func() {
+			stop()
+			cancel(nil)
+			m.inflight.Done()
+		})
+	}, nil
+}
+
+// OnDeregister 登记注销函数，关闭流程最先执行，使调用方不再发现本实例
+func (m *Manager) OnDeregister(fn func() error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deregisters = append(m.deregisters, fn)
+}
+
+// OnDrain 登记停止接收新请求并等待已有请求结束的函数（如 http.Server.Shutdown），ctx 在排空超时后到期
+func (m *Manager) OnDrain(fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.drains = append(m.drains, fn)
+}
+
+// OnClose 登记排空后按登记顺序执行的关闭函数，用于关闭 MCP 客户端、数据库、Redis 等
+func (m *Manager) OnClose(name string, fn func()) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closers = append(m.closers, closer{name: name, fn: fn})
+}
+
+// Go 在后台运行服务（如 ListenAndServe），返回非 http.ErrServerClosed 的错误时触发关闭，错误由 Wait 返回
+func (m *Manager) Go(serve func() error) {
+	go func() {
+		err := serve()
+		select {
+		case <-m.draining:
+			// 关闭流程中服务退出属于预期
+			return
+		default:
+		}
+		if errors.Is(err, http.ErrServerClosed) {
+			err = nil
+		}
+		select {
+		case m.errCh <- err:
+		default:
+		}
+	}()
+}
+
+// Wait 启动探针并阻塞到收到 SIGINT/SIGTERM 或 Go 中的服务退出，随后执行关闭流程
+func (m *Manager) Wait() error {
+	probe := m.serveProbes()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sig)
+
+	var serveErr error
+	select {
+	case s := <-sig:
+		logger.Infof("lifecycle: %s received %s, shutting down", m.name, s)
+	case serveErr = <-m.errCh:
+		if serveErr != nil {
+			logger.Errorf("lifecycle: %s server exited: %v", m.name, serveErr)
+		}
+	}
+
+	err := m.Shutdown()
+	if probe != nil {
+		_ = probe.Close()
+	}
+	return errors.Join(serveErr, err)
+}
+
+// Shutdown 执行关闭流程，重复调用只执行一次
+func (m *Manager) Shutdown() error {
+	m.shutdownOnce.Do(func() {
+		m.shutdownErr = m.shutdown()
+	})
+	return m.shutdownErr
+}
+
+func (m *Manager) shutdown() error {
+	m.mu.Lock()
+	m.closing = true
+	close(m.draining)
+	deregisters, drains, closers := m.deregisters, m.drains, m.closers
+	m.mu.Unlock()
+
+	var errs []error
+	for _, deregister := range deregisters {
+		if err := deregister(); err != nil {
+			logger.Errorf("lifecycle: %s deregister failed: %v", m.name, err)
+			errs = append(errs, err)
+		}
+	}
+	if m.drainDelay > 0 {
+		logger.Infof("lifecycle: %s deregistered, keep serving for %s", m.name, m.drainDelay)
+		time.Sleep(m.drainDelay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+	if err := m.drain(ctx, drains); err != nil {
+		errs = append(errs, err)
+	}
+	m.cancelBase(ErrShuttingDown)
+
+	for _, c := range closers {
+		logger.Infof("lifecycle: %s closing %s", m.name, c.name)
+		c.fn()
+	}
+	logger.Infof("lifecycle: %s shutdown complete", m.name)
+	return errors.Join(errs...)
+}
+
+// drain 并行执行 drains 并等待 Track 登记的工作，超时后取消剩余工作，再给它们一次收尾的机会
+func (m *Manager) drain(ctx context.Context, drains []func(ctx context.Context) error) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(drains))
+	for i, fn := range drains {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = fn(ctx)
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		m.inflight.Wait()
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return errors.Join(errs...)
+	case <-ctx.Done():
+	}
+	logger.Warnf("lifecycle: %s drain timed out after %s, cancelling in-flight work", m.name, m.timeout)
+	m.cancelBase(ErrShuttingDown)
+	// 取消后的工作通常很快结束（写入错误事件等），不再无限等待
+	select {
+	case <-done:
+		return errors.Join(append(errs, context.DeadlineExceeded)...)
+	case <-time.After(time.Second):
+		logger.Errorf("lifecycle: %s in-flight work did not stop after cancellation", m.name)
+		return context.DeadlineExceeded
+	}
+}
+
+// serveProbes 在 probeAddr 上提供存活与就绪探针
+func (m *Manager) serveProbes() *http.Server {
+	if m.probeAddr == "" {
+		return nil
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(constant.ProbeLivenessPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc(constant.ProbeReadinessPath, m.readiness)
+	srv := &http.Server{Addr: m.probeAddr, Handler: mux}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf("lifecycle: %s probe server on %s: %v", m.name, m.probeAddr, err)
+		}
+	}()
+	logger.Infof("lifecycle: %s probes listening at %s", m.name, m.probeAddr)
+	return srv
+}
+
+func (m *Manager) readiness(w http.ResponseWriter, r *http.Request) {
+	if !m.Ready() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("not ready"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
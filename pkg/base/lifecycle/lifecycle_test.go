@@ -0,0 +1,104 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_Manager(t *testing.T) {
+	Convey("Test lifecycle manager", t, func() {
+		var (
+			mu    sync.Mutex
+			steps []string
+		)
+		record := func(step string) {
+			mu.Lock()
+			defer mu.Unlock()
+			steps = append(steps, step)
+		}
+
+		Convey("shutdown deregisters, drains in-flight work and closes clients in order", func() {
+			m := New("test", WithTimeout(time.Second))
+			m.SetReady()
+			So(m.Ready(), ShouldBeTrue)
+
+			ctx, release, err := m.Track(context.Background())
+			So(err, ShouldBeNil)
+			go func() {
+				time.Sleep(50 * time.Millisecond)
+				if ctx.Err() == nil {
+					record("work done")
+				}
+				release()
+			}()
+			m.OnDeregister(func() error {
+				So(m.Ready(), ShouldBeFalse)
+				record("deregister")
+				return nil
+			})
+			m.OnDrain(func(ctx context.Context) error {
+				record("drain")
+				return nil
+			})
+			m.OnClose("db", func() { record("close db") })
+			m.OnClose("redis", func() { record("close redis") })
+
+			So(m.Shutdown(), ShouldBeNil)
+			So(steps, ShouldResemble, []string{"deregister", "drain", "work done", "close db", "close redis"})
+
+			_, _, err = m.Track(context.Background())
+			So(errors.Is(err, ErrShuttingDown), ShouldBeTrue)
+			So(m.Shutdown(), ShouldBeNil)
+			So(steps, ShouldHaveLength, 5)
+		})
+
+		Convey("work still running after the timeout is cancelled", func() {
+			m := New("test", WithTimeout(50*time.Millisecond))
+			ctx, release, err := m.Track(context.Background())
+			So(err, ShouldBeNil)
+			go func() {
+				<-ctx.Done()
+				record("cancelled")
+				release()
+			}()
+			m.OnClose("clients", func() { record("close") })
+
+			So(errors.Is(m.Shutdown(), context.DeadlineExceeded), ShouldBeTrue)
+			So(steps, ShouldResemble, []string{"cancelled", "close"})
+			So(errors.Is(context.Cause(ctx), ErrShuttingDown), ShouldBeTrue)
+		})
+
+		Convey("long-lived streams end as soon as draining starts", func() {
+			m := New("test")
+			ctx, cancel := m.UntilDraining(context.Background())
+			defer cancel()
+			go func() { _ = m.Shutdown() }()
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+				So("stream not cancelled", ShouldBeEmpty)
+			}
+		})
+
+		Convey("readiness probe follows the lifecycle", func() {
+			m := New("test")
+			probe := func() int {
+				w := httptest.NewRecorder()
+				m.readiness(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+				return w.Code
+			}
+			So(probe(), ShouldEqual, http.StatusServiceUnavailable)
+			m.SetReady()
+			So(probe(), ShouldEqual, http.StatusOK)
+			So(m.Shutdown(), ShouldBeNil)
+			So(probe(), ShouldEqual, http.StatusServiceUnavailable)
+		})
+	})
+}
@@ -7,27 +7,30 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"go.uber.org/zap"
+	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
-	"github.com/mark3labs/mcp-go/mcp"
-	"github.com/openai/openai-go/v2"
-	"github.com/openai/openai-go/v2/packages/param"
 )
 
 // AggregatedClient 面向 Host 的多路聚合客户端，实现 ToolClient 接口
 type AggregatedClient struct {
 	resolver        registry.Resolver
 	refreshInterval time.Duration
+	balancer        balancer // 同名工具多实例时的选择策略
 
 	mu               sync.RWMutex
 	discoverServices []string
-	clients          map[string]*MCPClient // url -> client
-	toolIndex        map[string]string     // toolName -> url
-	toolSnapshot     map[string]mcp.Tool   // 聚合后的 tool 定义
+	instances        map[string]*instance // url -> 实例
+	toolIndex        *toolIndex           // 暴露名/全名 -> 工具及提供它的实例
+	lastReport       string               // 上一次的冲突报告，变化时才打印
+	catalogs         map[string]catalog   // 服务 -> 提示词与资源，列举失败时保留上一次的结果
+	prompts          map[string]promptEntry
 
 	stopCh   chan struct{}
 	stopOnce sync.Once
@@ -41,9 +44,11 @@ func NewAggregatedClient(resolver registry.Resolver, services []string) *Aggrega
 		resolver:         resolver,
 		discoverServices: services,
 		refreshInterval:  config.Registry.RefreshInterval,
-		clients:          make(map[string]*MCPClient),
-		toolIndex:        make(map[string]string),
-		toolSnapshot:     make(map[string]mcp.Tool),
+		balancer:         newBalancer(config.MCP.ToolCall.Balance),
+		instances:        make(map[string]*instance),
+		toolIndex:        &toolIndex{},
+		catalogs:         make(map[string]catalog),
+		prompts:          make(map[string]promptEntry),
 		stopCh:           make(chan struct{}),
 	}
 	// 启动定时刷新goroutine
@@ -55,10 +60,17 @@ func (a *AggregatedClient) updateAggregatedClient() {
 	a.refresh()
 	ticker := time.NewTicker(a.refreshInterval)
 	defer ticker.Stop()
+	// 支持变更通知的 Resolver（如监听文件的静态列表）变化时立即刷新
+	var changes <-chan struct{}
+	if w, ok := a.resolver.(registry.Watcher); ok {
+		changes = w.Changes()
+	}
 	for {
 		select {
 		case <-ticker.C:
 			a.refresh()
+		case <-changes:
+			a.refresh()
 		case <-a.stopCh:
 			return
 		}
@@ -75,100 +87,91 @@ func (a *AggregatedClient) refresh() {
 	serviceToUrls, err := a.resolver.Resolve(a.discoverServices)
 	if err != nil {
 		logger.Warn("registry resolve:", zap.Error(err))
-		return
+		// 没有任何结果时保留全部现有实例；有部分结果（如合并的 Resolver 中只有一个失败）时按部分结果更新
+		if serviceToUrls == nil {
+			return
+		}
 	}
-	// 转化为set
-	target := make(map[string]struct{})
-	for _, urls := range serviceToUrls {
-		for _, url := range urls {
-			target[url] = struct{}{}
+	// 转化为set：addr -> 实例
+	target := make(map[string]registry.Instance)
+	services := make(map[string]string) // addr -> 服务名
+	for svc, insts := range serviceToUrls {
+		for _, inst := range insts {
+			target[inst.Addr] = inst
+			services[inst.Addr] = svc
 		}
 	}
 
 	a.mu.Lock()
-	defer a.mu.Unlock()
-
-	// 删除已关闭的连接
-	for u, cli := range a.clients {
+	// 删除已关闭的连接；保留的连接做一次存活检查，只有会话真正失效时才会在后台重连
+	for u, inst := range a.instances {
 		if _, ok := target[u]; ok {
+			inst.cli.Check()
 			continue
 		}
-		if u == "fzuhelper-mcp" {
-			continue
-		}
-		cli.Close()
-		delete(a.clients, u)
+		inst.cli.Close()
+		delete(a.instances, u)
 		logger.Info("mcp disconnected: ", zap.String("url", u))
 	}
-	// 新增连接
-	for u := range target {
-		if _, ok := a.clients[u]; ok {
+	// 新增连接；已有连接只更新权重，保留在途数与健康分
+	for u, ri := range target {
+		if inst, ok := a.instances[u]; ok {
+			inst.weight = max(ri.Weight, 1)
 			continue
 		}
-		cli, err := NewMCPClient("http://" + u + "/mcp")
+		cli, err := NewMCPClient(registry.Endpoint(u))
 		if err != nil {
 			logger.Errorf("mcp dial %s: %v", u, err)
 			continue
 		}
-		a.clients[u] = cli
-		logger.Infof("mcp connected: %s (tools=%d)", u, len(cli.Tools))
-		fmt.Println(cli.Tools)
-	}
-	// 建立fzu-helper-mcp连接
-	fzuCli, err := NewMCPClient(constant.FzuHelperServerMCPUrl)
-	if err != nil {
-		logger.Errorf("mcp dial %s: %v", constant.FzuHelperServerMCPUrl, err)
+		cli.OnToolsChanged(a.reindex)
+		a.instances[u] = newInstance(u, services[u], ri.Weight, cli)
+		logger.Infof("mcp connected: %s %s (tools=%d, weight=%d)", services[u], u, len(cli.ListTools()), ri.Weight)
 	}
-	a.clients["fzuhelper-mcp"] = fzuCli
-	//logger.Infof("fzu-mcp connected: %s (tools=%d)", constant.FzuHelperServerMCPUrl, len(fzuCli.Tools))
+	a.rebuildIndex()
+	a.mu.Unlock()
 
+	a.refreshCatalogs()
+}
+
+// reindex 某个实例重连或收到 list_changed 后工具列表可能变化，重建索引
+func (a *AggregatedClient) reindex() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	a.rebuildIndex()
 }
 
-// rebuildIndex 重建MCPClient映射
+// rebuildIndex 重建工具索引，调用方需持有写锁。冲突报告有变化时打印
 func (a *AggregatedClient) rebuildIndex() {
-	// tool -> 候选 url 列表
-	candidates := map[string][]string{}
-	toolDef := map[string]mcp.Tool{}
-	for url, cli := range a.clients {
-		if cli == nil {
+	insts := make([]*instance, 0, len(a.instances))
+	for url, inst := range a.instances {
+		if inst.cli == nil {
 			logger.Warn("mcp unexpect disconnection: ", zap.String("url", url))
 			continue
 		}
-		for _, t := range cli.Tools {
-			candidates[t.Name] = append(candidates[t.Name], url)
-			// 记录一个定义（相同名称一般结构一致）
-			if _, ok := toolDef[t.Name]; !ok {
-				toolDef[t.Name] = t
-			}
-		}
-	}
-	index := make(map[string]string, len(candidates))
-	for name, urls := range candidates {
-		//sort.Slice(urls, func(i, j int) bool {
-		//	si := scoreURL(urls[i])
-		//	sj := scoreURL(urls[j])
-		//	if si != sj {
-		//		return si > sj
-		//	}
-		//	return urls[i] < urls[j]
-		//})
-		index[name] = urls[0]
+		insts = append(insts, inst)
 	}
-	a.toolIndex = index
-	a.toolSnapshot = toolDef
-}
+	idx := buildToolIndex(insts, config.MCP.Tools)
+	a.toolIndex = idx
 
-// scoreURL URL权重，不是很必要
-func scoreURL(u string) int {
-	return 0
+	report := strings.Join(idx.report, "\n")
+	if report == a.lastReport {
+		return
+	}
+	a.lastReport = report
+	if report == "" {
+		logger.Infof("mcp tool index: no collisions (tools=%d)", len(idx.exposed))
+		return
+	}
+	logger.Warnf("mcp tool index: %d collision(s) (tools=%d):\n%s", len(idx.report), len(idx.exposed), report)
 }
 
 func (a *AggregatedClient) ConvertToolsToOllama() []map[string]any {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
-	out := make([]map[string]any, 0, len(a.toolSnapshot))
-	for _, t := range a.toolSnapshot {
+	out := make([]map[string]any, 0, len(a.toolIndex.exposed))
+	for _, e := range a.toolIndex.exposed {
+		t := e.tool
 		var params map[string]any
 		if b, _ := json.Marshal(t.InputSchema); len(b) != 0 {
 			_ = json.Unmarshal(b, &params)
@@ -185,56 +188,280 @@ func (a *AggregatedClient) ConvertToolsToOllama() []map[string]any {
 	return out
 }
 
-func (a *AggregatedClient) ConvertToolsToOpenAI() []openai.ChatCompletionToolUnionParam {
+func (a *AggregatedClient) ConvertTools() []ai_provider.ChatTool {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
-	out := make([]openai.ChatCompletionToolUnionParam, 0, len(a.toolSnapshot))
 	// 稳定顺序（按名称）
-	names := make([]string, 0, len(a.toolSnapshot))
-	for name := range a.toolSnapshot {
+	names := make([]string, 0, len(a.toolIndex.exposed))
+	for name := range a.toolIndex.exposed {
 		names = append(names, name)
 	}
 	sort.Strings(names)
+	out := make([]ai_provider.ChatTool, 0, len(names))
 	for _, name := range names {
-		t := a.toolSnapshot[name]
-		var paramsMap map[string]any
-		if b, _ := json.Marshal(t.InputSchema); len(b) != 0 {
-			_ = json.Unmarshal(b, &paramsMap)
+		out = append(out, convertTool(a.toolIndex.exposed[name].tool))
+	}
+	return out
+}
+
+// Resolve 见 ToolClient.Resolve，name 可以是暴露名或 服务__工具 全名
+func (a *AggregatedClient) Resolve(name string) (service, tool string, ok bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.toolIndex.resolve(name)
+}
+
+// CallTool 按负载均衡策略选出一个提供该工具的实例并调用，调用失败会降低该实例的健康分。
+// 会话层面的失败（连接断开、会话失效）会换一个实例重试一次。name 可以是暴露名或 服务__工具 全名
+func (a *AggregatedClient) CallTool(ctx context.Context, name string, args any) (*ToolResult, error) {
+	a.mu.RLock()
+	entry := a.toolIndex.lookup[name]
+	a.mu.RUnlock()
+	if entry == nil || len(entry.instances) == 0 {
+		return nil, fmt.Errorf("tool %q not found (no connected MCP server provides it)", name)
+	}
+	candidates := entry.instances
+	key := balanceKey(ctx, args)
+
+	inst := a.balancer.pick(key, alive(candidates, nil))
+	out, err := a.callInstance(ctx, inst, entry.remote, args)
+	if err == nil || !isTransportError(ctx, err) {
+		return out, err
+	}
+	rest := alive(candidates, inst)
+	if len(rest) == 0 {
+		return out, err
+	}
+	next := a.balancer.pick(key, rest)
+	logger.Warnf("mcp call tool %s on %s failed, retry on %s: %v", name, inst.url, next.url, err)
+	return a.callInstance(ctx, next, entry.remote, args)
+}
+
+func (a *AggregatedClient) callInstance(ctx context.Context, inst *instance, name string, args any) (*ToolResult, error) {
+	inst.inflight.Add(1)
+	defer inst.inflight.Add(-1)
+	out, err := inst.cli.CallTool(ctx, name, args)
+	// 调用方取消不代表实例不健康
+	if ctx.Err() == nil {
+		inst.report(err)
+	}
+	return out, err
+}
+
+// alive 去掉正在重连的实例与 exclude；排除 exclude 后没有存活实例时，返回其余全部实例
+func alive(candidates []*instance, exclude *instance) []*instance {
+	var out, rest []*instance
+	for _, c := range candidates {
+		if c == exclude {
+			continue
 		}
-		var fp openai.FunctionParameters
-		if b, err := json.Marshal(paramsMap); err == nil {
-			_ = json.Unmarshal(b, &fp)
+		rest = append(rest, c)
+		if c.cli.Alive() {
+			out = append(out, c)
 		}
-		fn := openai.FunctionDefinitionParam{
-			Name:        t.Name,
-			Description: param.Opt[string]{Value: t.Description},
-			Parameters:  fp,
+	}
+	if len(out) == 0 {
+		return rest
+	}
+	return out
+}
+
+// serviceInstance 选出服务的一个实例，服务没有实例时返回 nil：提示词与资源在同一服务的各实例间视为一致
+func (a *AggregatedClient) serviceInstance(service string) *instance {
+	a.mu.RLock()
+	var insts []*instance
+	for _, inst := range a.instances {
+		if inst.service == service {
+			insts = append(insts, inst)
 		}
-		tool := &openai.ChatCompletionFunctionToolParam{
-			Type:     "function",
-			Function: fn,
+	}
+	a.mu.RUnlock()
+	if len(insts) == 0 {
+		return nil
+	}
+	sort.Slice(insts, func(i, j int) bool { return insts[i].url < insts[j].url })
+	return a.balancer.pick("", alive(insts, nil))
+}
+
+// catalog 一个服务发布的提示词与资源
+type catalog struct {
+	prompts   []Prompt
+	resources []Resource
+}
+
+// promptEntry 聚合后的一个提示词，prompt.Name 为服务端的原始名称
+type promptEntry struct {
+	prompt  Prompt
+	service string
+}
+
+// refreshCatalogs 列举各服务的提示词与资源并缓存，查询与路由只读缓存。
+// 个别服务列举失败时保留它上一次的结果，不影响其他服务
+func (a *AggregatedClient) refreshCatalogs() {
+	a.mu.RLock()
+	services := make([]string, 0, len(a.instances))
+	for _, inst := range a.instances {
+		if !slices.Contains(services, inst.service) {
+			services = append(services, inst.service)
 		}
-		out = append(out, openai.ChatCompletionToolUnionParam{OfFunction: tool})
 	}
-	return out
+	old := a.catalogs
+	a.mu.RUnlock()
+
+	catalogs := make(map[string]catalog, len(services))
+	for _, svc := range services {
+		c, err := a.listCatalog(svc)
+		if err != nil {
+			logger.Warnf("mcp list prompts/resources of %s: %v", svc, err)
+			if prev, ok := old[svc]; ok {
+				catalogs[svc] = prev
+			}
+			continue
+		}
+		catalogs[svc] = c
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.catalogs = catalogs
+	a.prompts = buildPromptIndex(catalogs)
+}
+
+func (a *AggregatedClient) listCatalog(service string) (catalog, error) {
+	inst := a.serviceInstance(service)
+	if inst == nil {
+		return catalog{}, fmt.Errorf("no instance")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), constant.MCPDefaultCallTimeout)
+	defer cancel()
+	prompts, err := inst.cli.ListPrompts(ctx)
+	if err != nil {
+		return catalog{}, err
+	}
+	resources, err := inst.cli.ListResources(ctx)
+	if err != nil {
+		return catalog{}, err
+	}
+	return catalog{prompts: prompts, resources: resources}, nil
+}
+
+// buildPromptIndex 汇总各服务的提示词，多个服务重名时改用 服务__提示词 全名
+func buildPromptIndex(catalogs map[string]catalog) map[string]promptEntry {
+	byName := map[string][]promptEntry{}
+	for svc, c := range catalogs {
+		for _, p := range c.prompts {
+			byName[p.Name] = append(byName[p.Name], promptEntry{prompt: p, service: svc})
+		}
+	}
+	idx := make(map[string]promptEntry, len(byName))
+	for name, entries := range byName {
+		if len(entries) == 1 {
+			idx[name] = entries[0]
+			continue
+		}
+		for _, e := range entries {
+			idx[namespacedName(e.service, name)] = e
+		}
+	}
+	return idx
+}
+
+// sortedServices 缓存中的服务名，按名称排序，调用方需持有读锁
+func (a *AggregatedClient) sortedServices() []string {
+	services := make([]string, 0, len(a.catalogs))
+	for svc := range a.catalogs {
+		services = append(services, svc)
+	}
+	sort.Strings(services)
+	return services
+}
+
+// ListPrompts 各服务的提示词，按暴露名排序
+func (a *AggregatedClient) ListPrompts(ctx context.Context) ([]Prompt, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	out := make([]Prompt, 0, len(a.prompts))
+	for name, e := range a.prompts {
+		p := e.prompt
+		p.Name = name
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
 }
 
-func (a *AggregatedClient) CallTool(ctx context.Context, name string, args any) (string, error) {
+// GetPrompt name 为 ListPrompts 返回的暴露名，只发往提供它的服务
+func (a *AggregatedClient) GetPrompt(ctx context.Context, name string, args map[string]string) (*PromptResult, error) {
 	a.mu.RLock()
-	url, ok := a.toolIndex[name]
-	cli := a.clients[url]
+	e, ok := a.prompts[name]
 	a.mu.RUnlock()
-	if !ok || cli == nil {
-		return "", fmt.Errorf("tool %q not found (no connected MCP server provides it)", name)
+	if !ok {
+		return nil, fmt.Errorf("prompt %q not found (no connected MCP server provides it)", name)
+	}
+	inst := a.serviceInstance(e.service)
+	if inst == nil {
+		return nil, fmt.Errorf("prompt %q: service %s has no connected instance", name, e.service)
+	}
+	return inst.cli.GetPrompt(ctx, e.prompt.Name, args)
+}
+
+// ListResources 各服务的资源与资源模板，URI 相同时保留先出现的
+func (a *AggregatedClient) ListResources(ctx context.Context) ([]Resource, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	var out []Resource
+	seen := map[string]struct{}{}
+	for _, svc := range a.sortedServices() {
+		for _, r := range a.catalogs[svc].resources {
+			if _, ok := seen[r.URI]; !ok {
+				seen[r.URI] = struct{}{}
+				out = append(out, r)
+			}
+		}
 	}
-	return cli.CallTool(ctx, name, args)
+	return out, nil
+}
+
+// ReadResource 按 URI 的 scheme 找到发布了同类资源的服务读取，依次尝试直到成功
+func (a *AggregatedClient) ReadResource(ctx context.Context, uri string) (*ToolResult, error) {
+	scheme := uriScheme(uri)
+	a.mu.RLock()
+	var services []string
+	for _, svc := range a.sortedServices() {
+		if slices.ContainsFunc(a.catalogs[svc].resources, func(r Resource) bool { return uriScheme(r.URI) == scheme }) {
+			services = append(services, svc)
+		}
+	}
+	a.mu.RUnlock()
+
+	var lastErr error
+	for _, svc := range services {
+		inst := a.serviceInstance(svc)
+		if inst == nil {
+			continue
+		}
+		out, err := inst.cli.ReadResource(ctx, uri)
+		if err == nil {
+			return out, nil
+		}
+		lastErr = err
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("resource %q not found (no connected MCP server provides it)", uri)
+	}
+	return nil, lastErr
+}
+
+func uriScheme(uri string) string {
+	scheme, _, _ := strings.Cut(uri, "://")
+	return scheme
 }
 
 func (a *AggregatedClient) Close() {
 	a.stopOnce.Do(func() { close(a.stopCh) })
 	a.mu.Lock()
 	defer a.mu.Unlock()
-	for _, cli := range a.clients {
-		cli.Close()
+	for _, inst := range a.instances {
+		inst.cli.Close()
 	}
 }
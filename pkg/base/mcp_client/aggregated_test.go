@@ -0,0 +1,167 @@
+package mcp_client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	mcpc "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// catalogServer 发布 greet 与 <service>_only 两个提示词，以及 <service>://{id} 资源模板
+func catalogServer(service string) *httptest.Server {
+	core := server.NewMCPServer(service, "0.0.1", server.WithToolCapabilities(true))
+	reply := func(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		return mcp.NewGetPromptResult(service, []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(req.Params.Name+" from "+service)),
+		}), nil
+	}
+	core.AddPrompt(mcp.NewPrompt("greet"), reply)
+	core.AddPrompt(mcp.NewPrompt(service+"_only"), reply)
+	core.AddResourceTemplate(mcp.NewResourceTemplate(service+"://{id}", service),
+		func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			return []mcp.ResourceContents{mcp.TextResourceContents{URI: req.Params.URI, Text: "read by " + service}}, nil
+		})
+	return httptest.NewServer(server.NewStreamableHTTPServer(core))
+}
+
+func promptNames(prompts []Prompt) []string {
+	out := make([]string, 0, len(prompts))
+	for _, p := range prompts {
+		out = append(out, p.Name)
+	}
+	return out
+}
+
+func Test_AggregatedClient_catalogs(t *testing.T) {
+	Convey("Test prompts and resources routed from the cached catalogs", t, func() {
+		a := &AggregatedClient{
+			balancer:  newBalancer(constant.MCPBalanceRoundRobin),
+			instances: make(map[string]*instance),
+			toolIndex: &toolIndex{},
+			catalogs:  make(map[string]catalog),
+			prompts:   make(map[string]promptEntry),
+		}
+		servers := map[string]*httptest.Server{}
+		for _, svc := range []string{"alpha", "beta"} {
+			srv := catalogServer(svc)
+			defer srv.Close()
+			cli, err := newMCPClient(srv.URL, func() (*mcpc.Client, []mcp.Tool, error) { return dialHTTP(srv.URL) })
+			So(err, ShouldBeNil)
+			defer cli.Close()
+			servers[svc] = srv
+			a.instances[srv.URL] = newInstance(srv.URL, svc, 1, cli)
+		}
+		a.refreshCatalogs()
+		ctx := context.Background()
+
+		Convey("colliding prompt names are namespaced by service", func() {
+			prompts, err := a.ListPrompts(ctx)
+			So(err, ShouldBeNil)
+			So(promptNames(prompts), ShouldResemble, []string{"alpha__greet", "alpha_only", "beta__greet", "beta_only"})
+
+			res, err := a.GetPrompt(ctx, "beta__greet", nil)
+			So(err, ShouldBeNil)
+			So(res.Messages, ShouldResemble, []ai_provider.ChatMessage{ai_provider.UserMessage("greet from beta")})
+
+			_, err = a.GetPrompt(ctx, "greet", nil)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("resources are read from the service that publishes the scheme", func() {
+			resources, err := a.ListResources(ctx)
+			So(err, ShouldBeNil)
+			So(resources, ShouldResemble, []Resource{
+				{URI: "alpha://{id}", Name: "alpha", Template: true},
+				{URI: "beta://{id}", Name: "beta", Template: true},
+			})
+
+			out, err := a.ReadResource(ctx, "beta://1")
+			So(err, ShouldBeNil)
+			So(out.Text, ShouldContainSubstring, "read by beta")
+
+			_, err = a.ReadResource(ctx, "gamma://1")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("a server that goes down keeps its last catalog and does not break the others", func() {
+			// Close 会等待客户端的 SSE 长连接，先断开连接并停止监听
+			servers["beta"].Listener.Close()
+			servers["beta"].CloseClientConnections()
+			a.refreshCatalogs()
+
+			prompts, err := a.ListPrompts(ctx)
+			So(err, ShouldBeNil)
+			So(promptNames(prompts), ShouldResemble, []string{"alpha__greet", "alpha_only", "beta__greet", "beta_only"})
+
+			res, err := a.GetPrompt(ctx, "alpha_only", nil)
+			So(err, ShouldBeNil)
+			So(res.Messages, ShouldResemble, []ai_provider.ChatMessage{ai_provider.UserMessage("alpha_only from alpha")})
+
+			out, err := a.ReadResource(ctx, "alpha://1")
+			So(err, ShouldBeNil)
+			So(out.Text, ShouldContainSubstring, "read by alpha")
+
+			resources, err := a.ListResources(ctx)
+			So(err, ShouldBeNil)
+			So(resources, ShouldHaveLength, 2)
+		})
+
+		Convey("a service that leaves the registry drops its catalog", func() {
+			delete(a.instances, servers["beta"].URL)
+			a.refreshCatalogs()
+
+			prompts, err := a.ListPrompts(ctx)
+			So(err, ShouldBeNil)
+			So(promptNames(prompts), ShouldResemble, []string{"alpha_only", "greet"})
+		})
+	})
+}
+
+func Test_AggregatedClient_failover(t *testing.T) {
+	Convey("Test a call on a dead session fails over to another instance", t, func() {
+		a := &AggregatedClient{
+			balancer:  newBalancer(constant.MCPBalanceRoundRobin),
+			instances: make(map[string]*instance),
+			toolIndex: &toolIndex{},
+		}
+		handlers := map[string]*atomic.Pointer[server.StreamableHTTPServer]{}
+		for _, name := range []string{"a", "b"} {
+			h := &atomic.Pointer[server.StreamableHTTPServer]{}
+			h.Store(server.NewStreamableHTTPServer(newTestCore()))
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { h.Load().ServeHTTP(w, r) }))
+			defer ts.Close()
+			cli, err := newMCPClient(ts.URL, func() (*mcpc.Client, []mcp.Tool, error) { return dialHTTP(ts.URL) })
+			So(err, ShouldBeNil)
+			defer cli.Close()
+			handlers[name] = h
+			a.instances[name] = newInstance(name, "svc", 1, cli)
+		}
+		a.toolIndex = buildToolIndex([]*instance{a.instances["a"], a.instances["b"]}, config.MCPToolsConfig{})
+		dead := a.instances["a"]
+		reconnected := make(chan struct{}, 1)
+		dead.cli.OnToolsChanged(func() { reconnected <- struct{}{} })
+		handlers["a"].Store(server.NewStreamableHTTPServer(newTestCore()))
+
+		// 轮询下两次调用中必有一次先选中失效的实例
+		for range 2 {
+			out, err := a.CallTool(context.Background(), "echo", map[string]any{"text": "hi"})
+			So(err, ShouldBeNil)
+			So(out.Text, ShouldEqual, "echo:hi")
+		}
+		So(dead.health(), ShouldBeLessThan, 1)
+		So(a.instances["b"].health(), ShouldEqual, 1)
+
+		So(waitSignal(reconnected), ShouldBeTrue)
+		So(dead.cli.Alive(), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,182 @@
+package mcp_client
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
+)
+
+// instance 一个已连接的 MCP 服务实例及其负载状态，跨刷新保留
+type instance struct {
+	url     string
+	service string // 注册中心中的服务名，作为工具命名空间
+	weight  int
+	cli     *MCPClient
+
+	inflight atomic.Int64
+
+	mu       sync.Mutex
+	score    float64   // failedAt 时刻的健康分
+	failedAt time.Time // 为零值表示没有待恢复的失败，健康分为 1
+}
+
+func newInstance(url, service string, weight int, cli *MCPClient) *instance {
+	return &instance{url: url, service: service, weight: max(weight, 1), cli: cli, score: 1}
+}
+
+// health 健康分 [0,1]：调用失败时减半，随后随时间线性恢复
+func (i *instance) health() float64 {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.healthLocked()
+}
+
+func (i *instance) healthLocked() float64 {
+	if i.failedAt.IsZero() {
+		return 1
+	}
+	return min(1, i.score+float64(time.Since(i.failedAt))/float64(constant.MCPHealthRecoverWindow))
+}
+
+// report 汇报一次调用结果
+func (i *instance) report(err error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	h := i.healthLocked()
+	if err == nil {
+		h = min(1, h+0.2)
+	} else {
+		h /= 2
+	}
+	if h >= 1 {
+		i.score, i.failedAt = 1, time.Time{}
+		return
+	}
+	i.score, i.failedAt = h, time.Now()
+}
+
+// balancer 在提供同一工具的多个实例中选择一个，key 为一致性哈希使用的用户标识
+type balancer interface {
+	pick(key string, candidates []*instance) *instance
+}
+
+// newBalancer 按名称创建负载均衡策略，未知名称回退到轮询
+func newBalancer(name string) balancer {
+	switch name {
+	case constant.MCPBalanceRoundRobin, "":
+		return &roundRobin{}
+	case constant.MCPBalanceLeastInFlight:
+		return leastInFlight{}
+	case constant.MCPBalanceWeighted:
+		return weighted{}
+	case constant.MCPBalanceConsistentHash:
+		return &consistentHash{fallback: &roundRobin{}}
+	default:
+		logger.Warnf("mcp_client: unknown balance strategy %q, use %s", name, constant.MCPBalanceRoundRobin)
+		return &roundRobin{}
+	}
+}
+
+// available 过滤掉健康分过低的实例；全部过低时仍返回全部，避免工具整体不可用
+func available(candidates []*instance) []*instance {
+	out := make([]*instance, 0, len(candidates))
+	for _, c := range candidates {
+		if c.health() >= constant.MCPHealthMinScore {
+			out = append(out, c)
+		}
+	}
+	if len(out) == 0 {
+		return candidates
+	}
+	return out
+}
+
+type roundRobin struct {
+	next atomic.Uint64
+}
+
+func (b *roundRobin) pick(_ string, candidates []*instance) *instance {
+	candidates = available(candidates)
+	n := b.next.Add(1) - 1
+	return candidates[n%uint64(len(candidates))]
+}
+
+// leastInFlight 在途调用最少者优先，健康分越低视为越忙
+type leastInFlight struct{}
+
+func (leastInFlight) pick(_ string, candidates []*instance) *instance {
+	var best *instance
+	bestLoad := 0.0
+	for _, c := range available(candidates) {
+		load := float64(c.inflight.Load()+1) / max(c.health(), 0.01)
+		if best == nil || load < bestLoad {
+			best, bestLoad = c, load
+		}
+	}
+	return best
+}
+
+// weighted 按 weight * 健康分加权随机
+type weighted struct{}
+
+func (weighted) pick(_ string, candidates []*instance) *instance {
+	candidates = available(candidates)
+	total := 0.0
+	for _, c := range candidates {
+		total += float64(c.weight) * c.health()
+	}
+	r := rand.Float64() * total
+	for _, c := range candidates {
+		if r -= float64(c.weight) * c.health(); r < 0 {
+			return c
+		}
+	}
+	return candidates[len(candidates)-1]
+}
+
+// consistentHash 最高随机权重（rendezvous）哈希：同一 key 固定落在同一实例，
+// 实例增减只影响原本落在该实例上的 key；没有 key 时回退到轮询
+type consistentHash struct {
+	fallback balancer
+}
+
+func (b *consistentHash) pick(key string, candidates []*instance) *instance {
+	if key == "" {
+		return b.fallback.pick(key, candidates)
+	}
+	var best *instance
+	var bestScore uint64
+	for _, c := range available(candidates) {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(key))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(c.url))
+		if s := h.Sum64(); best == nil || s > bestScore {
+			best, bestScore = c, s
+		}
+	}
+	return best
+}
+
+// balanceKey 一致性哈希使用的用户标识：优先取工具参数中的 user_id，其次取请求上下文中的登录信息
+func balanceKey(ctx context.Context, args any) string {
+	if m, ok := args.(map[string]any); ok {
+		if v, ok := m["user_id"].(string); ok && v != "" {
+			return v
+		}
+	}
+	if id, ok := utils.ExtractStuID(ctx); ok && id != "" {
+		return id
+	}
+	if ld, ok := utils.ExtractLoginData(ctx); ok && ld != nil {
+		return ld.ID
+	}
+	return ""
+}
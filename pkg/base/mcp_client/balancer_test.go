@@ -0,0 +1,88 @@
+package mcp_client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+func testInstances(weights ...int) []*instance {
+	out := make([]*instance, 0, len(weights))
+	for i, w := range weights {
+		out = append(out, newInstance(fmt.Sprintf("127.0.0.1:1000%d", i), "svc", w, nil))
+	}
+	return out
+}
+
+func Test_balancer(t *testing.T) {
+	Convey("Test balancer strategies", t, func() {
+		Convey("round robin spreads calls evenly", func() {
+			insts := testInstances(1, 1, 1)
+			b := newBalancer(constant.MCPBalanceRoundRobin)
+			counts := map[*instance]int{}
+			for range 30 {
+				counts[b.pick("", insts)]++
+			}
+			for _, inst := range insts {
+				So(counts[inst], ShouldEqual, 10)
+			}
+		})
+
+		Convey("least in-flight picks the idlest instance", func() {
+			insts := testInstances(1, 1, 1)
+			insts[0].inflight.Store(3)
+			insts[2].inflight.Store(1)
+			So(newBalancer(constant.MCPBalanceLeastInFlight).pick("", insts), ShouldEqual, insts[1])
+		})
+
+		Convey("weighted follows registry weight", func() {
+			insts := testInstances(1, 9)
+			b := newBalancer(constant.MCPBalanceWeighted)
+			heavy := 0
+			for range 1000 {
+				if b.pick("", insts) == insts[1] {
+					heavy++
+				}
+			}
+			So(heavy, ShouldBeBetween, 800, 980)
+		})
+
+		Convey("consistent hash keeps a user on one instance", func() {
+			insts := testInstances(1, 1, 1)
+			b := newBalancer(constant.MCPBalanceConsistentHash)
+			first := b.pick("102301000", insts)
+			for range 10 {
+				So(b.pick("102301000", insts), ShouldEqual, first)
+			}
+
+			// 移除其它实例不影响该用户
+			var rest []*instance
+			for _, inst := range insts {
+				if inst == first || len(rest) == 0 {
+					rest = append(rest, inst)
+				}
+			}
+			So(b.pick("102301000", rest), ShouldEqual, first)
+		})
+
+		Convey("failing instance is skipped until it recovers", func() {
+			insts := testInstances(1, 1)
+			insts[0].report(errors.New("boom"))
+			insts[0].report(errors.New("boom"))
+			So(insts[0].health(), ShouldBeLessThan, constant.MCPHealthMinScore)
+
+			b := newBalancer(constant.MCPBalanceRoundRobin)
+			for range 4 {
+				So(b.pick("", insts), ShouldEqual, insts[1])
+			}
+
+			insts[0].report(nil)
+			insts[0].report(nil)
+			So(insts[0].health(), ShouldBeGreaterThanOrEqualTo, constant.MCPHealthMinScore)
+		})
+	})
+}
@@ -2,17 +2,29 @@ package mcp_client
 
 import (
 	"context"
-	"github.com/openai/openai-go/v2"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 )
 
 // ToolClient 定义了调用 MCP 工具客户端的接口
 type ToolClient interface {
 	// ConvertToolsToOllama 将MCP工具定义转换为 Ollama 工具格式
 	ConvertToolsToOllama() []map[string]any
-	// ConvertToolsToOpenAI 将MCP工具定义转换为 OpenAI Chat Completions 的 tools 参数
-	ConvertToolsToOpenAI() []openai.ChatCompletionToolUnionParam
-	// CallTool 调用工具
-	CallTool(ctx context.Context, name string, args any) (string, error)
+	// ConvertTools 将MCP工具定义转换为与模型提供方无关的工具声明
+	ConvertTools() []ai_provider.ChatTool
+	// CallTool 调用工具，工具自身报告的失败体现在 ToolResult.IsError 而不是 error
+	CallTool(ctx context.Context, name string, args any) (*ToolResult, error)
+	// Resolve 将暴露给模型的工具名（可能带 服务__ 前缀或是别名）解析为提供它的服务与服务端的原始工具名，
+	// 策略匹配、参数注入都应基于解析结果。单连接客户端的 service 为空
+	Resolve(name string) (service, tool string, ok bool)
+	// ListPrompts 列出可用的提示词模板
+	ListPrompts(ctx context.Context) ([]Prompt, error)
+	// GetPrompt 以 args 展开提示词模板
+	GetPrompt(ctx context.Context, name string, args map[string]string) (*PromptResult, error)
+	// ListResources 列出可用的资源与资源模板
+	ListResources(ctx context.Context) ([]Resource, error)
+	// ReadResource 读取资源，内容按工具结果的方式映射
+	ReadResource(ctx context.Context, uri string) (*ToolResult, error)
 	// Close 关闭客户端连接
 	Close()
 }
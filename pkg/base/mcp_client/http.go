@@ -5,21 +5,26 @@ import (
 	"fmt"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	mcpc "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
-// newSSEMCPClientWithConn [MCP规范已废弃]通过 SSE 连接指定 URL
-func newSSEMCPClientWithConn(url string) (*MCPClient, error) {
-	c, err := mcpc.NewStreamableHttpClient(url)
+// dialSSE [MCP规范已废弃]通过 SSE 连接指定 URL
+func dialSSE(url string) (*mcpc.Client, []mcp.Tool, error) {
+	// 持续监听 GET 流，才能收到服务端主动推送的 list_changed 等通知
+	trans, err := transport.NewStreamableHTTP(url, transport.WithContinuousListening())
 	if err != nil {
-		return nil, fmt.Errorf("new sse client: %w", err)
+		return nil, nil, fmt.Errorf("new sse client: %w", err)
 	}
+	c := mcpc.NewClient(withCancelNotify(trans))
 
 	ctx, cancel := context.WithTimeout(context.Background(), constant.MCPClientInitTimeout)
 	defer cancel()
 
-	if err := c.Start(ctx); err != nil {
-		return nil, fmt.Errorf("sse start: %w", err)
+	// 监听流的生命周期跟随 Start 的 ctx，不能使用初始化超时的 ctx
+	if err := c.Start(context.Background()); err != nil {
+		_ = c.Close()
+		return nil, nil, fmt.Errorf("sse start: %w", err)
 	}
 	_, err = c.Initialize(ctx, mcp.InitializeRequest{
 		Params: mcp.InitializeParams{
@@ -27,29 +32,35 @@ func newSSEMCPClientWithConn(url string) (*MCPClient, error) {
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("initialize (sse): %w", err)
+		_ = c.Close()
+		return nil, nil, fmt.Errorf("initialize (sse): %w", err)
 	}
 
 	resTool, err := c.ListTools(ctx, mcp.ListToolsRequest{})
 	if err != nil {
-		return nil, fmt.Errorf("list tools: %w", err)
+		_ = c.Close()
+		return nil, nil, fmt.Errorf("list tools: %w", err)
 	}
 
-	return &MCPClient{Client: c, Tools: resTool.Tools}, nil
+	return c, resTool.Tools, nil
 }
 
-// newHTTPMCPClientWithConn 通过 Streamable HTTP 连接指定 URL
-func newHTTPMCPClientWithConn(url string) (*MCPClient, error) {
-	c, err := mcpc.NewStreamableHttpClient(url)
+// dialHTTP 通过 Streamable HTTP 连接指定 URL
+func dialHTTP(url string) (*mcpc.Client, []mcp.Tool, error) {
+	// 持续监听 GET 流，才能收到服务端主动推送的 list_changed 等通知
+	trans, err := transport.NewStreamableHTTP(url, transport.WithContinuousListening())
 	if err != nil {
-		return nil, fmt.Errorf("new http client: %w", err)
+		return nil, nil, fmt.Errorf("new http client: %w", err)
 	}
+	c := mcpc.NewClient(withCancelNotify(trans))
 
 	ctx, cancel := context.WithTimeout(context.Background(), constant.MCPClientInitTimeout)
 	defer cancel()
 
-	if err := c.Start(ctx); err != nil {
-		return nil, fmt.Errorf("http start: %w", err)
+	// 监听流的生命周期跟随 Start 的 ctx，不能使用初始化超时的 ctx
+	if err := c.Start(context.Background()); err != nil {
+		_ = c.Close()
+		return nil, nil, fmt.Errorf("http start: %w", err)
 	}
 	_, err = c.Initialize(ctx, mcp.InitializeRequest{
 		Params: mcp.InitializeParams{
@@ -57,13 +68,15 @@ func newHTTPMCPClientWithConn(url string) (*MCPClient, error) {
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("initialize (http): %w", err)
+		_ = c.Close()
+		return nil, nil, fmt.Errorf("initialize (http): %w", err)
 	}
 
 	resTool, err := c.ListTools(ctx, mcp.ListToolsRequest{})
 	if err != nil {
-		return nil, fmt.Errorf("list tools: %w", err)
+		_ = c.Close()
+		return nil, nil, fmt.Errorf("list tools: %w", err)
 	}
 
-	return &MCPClient{Client: c, Tools: resTool.Tools}, nil
+	return c, resTool.Tools, nil
 }
@@ -0,0 +1,150 @@
+package mcp_client
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// toolEntry 聚合后的一个工具：来自某个服务，可能由该服务的多个实例提供
+type toolEntry struct {
+	tool      mcp.Tool // 暴露给模型的定义，Name 为暴露名
+	remote    string   // 服务端的原始工具名，调用时使用
+	service   string
+	instances []*instance
+}
+
+// toolIndex 工具索引：exposed 为暴露给模型的工具（每个工具一个名字），
+// lookup 额外包含带命名空间的全名，便于按全名调用
+type toolIndex struct {
+	exposed map[string]*toolEntry
+	lookup  map[string]*toolEntry
+	report  []string // 冲突报告，按字典序排列
+}
+
+// namespacedName 带命名空间的工具全名，如 mcp_local__get_todos
+func namespacedName(service, tool string) string {
+	return service + constant.MCPToolNamespaceSeparator + tool
+}
+
+// schemaFingerprint 入参 schema 的指纹，用于判断同名工具是否真的是同一个工具
+func schemaFingerprint(t mcp.Tool) string {
+	b, _ := json.Marshal(struct {
+		Schema mcp.ToolInputSchema `json:"schema"`
+		Raw    json.RawMessage     `json:"raw,omitempty"`
+	}{t.InputSchema, t.RawInputSchema})
+	sum := sha256.Sum256(b)
+	return fmt.Sprintf("%x", sum[:6])
+}
+
+// buildToolIndex 按「服务__工具」聚合全部实例上的工具，再决定暴露名：
+//   - 配置的别名优先，指向某个全名；
+//   - namespace 为 collision 时，只在多个服务提供同名工具时使用全名，否则保留原名；
+//   - namespace 为 always 时全部使用全名。
+//
+// 多个服务提供同名工具、同一服务的实例之间 schema 不一致、别名指向不存在的工具都会写入报告
+func buildToolIndex(instances []*instance, cfg config.MCPToolsConfig) *toolIndex {
+	entries := map[string]*toolEntry{}
+	fingerprints := map[string]map[string][]string{} // 全名 -> schema 指纹 -> 实例
+	for _, inst := range instances {
+		for _, t := range inst.cli.ListTools() {
+			full := namespacedName(inst.service, t.Name)
+			e, ok := entries[full]
+			if !ok {
+				e = &toolEntry{tool: t, remote: t.Name, service: inst.service}
+				entries[full] = e
+				fingerprints[full] = map[string][]string{}
+			}
+			e.instances = append(e.instances, inst)
+			fp := schemaFingerprint(t)
+			fingerprints[full][fp] = append(fingerprints[full][fp], inst.url)
+		}
+	}
+
+	idx := &toolIndex{exposed: map[string]*toolEntry{}, lookup: map[string]*toolEntry{}}
+	for full, e := range entries {
+		// 固定顺序，使轮询与一致性哈希的结果不受 map 遍历顺序影响
+		sort.Slice(e.instances, func(i, j int) bool { return e.instances[i].url < e.instances[j].url })
+		idx.lookup[full] = e
+		if len(fingerprints[full]) > 1 {
+			var parts []string
+			for fp, urls := range fingerprints[full] {
+				sort.Strings(urls)
+				parts = append(parts, fmt.Sprintf("%s%v", fp, urls))
+			}
+			sort.Strings(parts)
+			idx.report = append(idx.report, fmt.Sprintf("schema mismatch between instances of %s: %s", full, strings.Join(parts, " ")))
+		}
+	}
+
+	// 别名
+	aliased := map[string]bool{} // 已经以别名暴露的全名
+	for _, a := range cfg.Aliases {
+		e, ok := entries[a.Tool]
+		if !ok {
+			idx.report = append(idx.report, fmt.Sprintf("alias %s points to unknown tool %s", a.Alias, a.Tool))
+			continue
+		}
+		idx.expose(a.Alias, e)
+		aliased[a.Tool] = true
+	}
+
+	// 按原名分组，判断冲突
+	byName := map[string][]string{}
+	for full, e := range entries {
+		byName[e.remote] = append(byName[e.remote], full)
+	}
+	for name, fulls := range byName {
+		sort.Strings(fulls)
+		if len(fulls) > 1 {
+			kind := "duplicate"
+			if !sameSchema(entries, fulls) {
+				kind = "conflicting schemas"
+			}
+			idx.report = append(idx.report, fmt.Sprintf("tool %s is provided by several services (%s): %s", name, kind, strings.Join(fulls, ", ")))
+		}
+		for _, full := range fulls {
+			if aliased[full] {
+				continue
+			}
+			exposed := full
+			if cfg.Namespace != constant.MCPToolNamespaceAlways && len(fulls) == 1 && idx.exposed[name] == nil {
+				exposed = name
+			}
+			idx.expose(exposed, entries[full])
+		}
+	}
+	sort.Strings(idx.report)
+	return idx
+}
+
+// resolve 暴露名或全名 -> 服务与原始工具名
+func (idx *toolIndex) resolve(name string) (service, tool string, ok bool) {
+	e := idx.lookup[name]
+	if e == nil {
+		return "", "", false
+	}
+	return e.service, e.remote, true
+}
+
+func (idx *toolIndex) expose(name string, e *toolEntry) {
+	exposed := *e
+	exposed.tool.Name = name
+	idx.exposed[name] = &exposed
+	idx.lookup[name] = &exposed
+}
+
+func sameSchema(entries map[string]*toolEntry, fulls []string) bool {
+	first := schemaFingerprint(entries[fulls[0]].tool)
+	return !slices.ContainsFunc(fulls[1:], func(full string) bool {
+		return schemaFingerprint(entries[full].tool) != first
+	})
+}
@@ -0,0 +1,84 @@
+package mcp_client
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+func toolsInstance(url, service string, tools ...mcp.Tool) *instance {
+	return newInstance(url, service, 1, &MCPClient{tools: tools})
+}
+
+func Test_buildToolIndex(t *testing.T) {
+	Convey("Test buildToolIndex", t, func() {
+		todos := mcp.NewTool("get_todos", mcp.WithString("user_id"))
+		todosV2 := mcp.NewTool("get_todos", mcp.WithString("user_id"), mcp.WithNumber("limit"))
+		search := mcp.NewTool("web_search", mcp.WithString("query"))
+		insts := []*instance{
+			toolsInstance("10.0.0.1:1", "mcp_local", todos, search),
+			toolsInstance("10.0.0.2:1", "mcp_local", todos, search),
+			toolsInstance("10.0.0.3:1", "mcp_remote", todosV2),
+		}
+
+		Convey("unique tools keep their name, colliding ones are namespaced and reported", func() {
+			idx := buildToolIndex(insts, config.MCPToolsConfig{})
+			So(idx.exposed, ShouldContainKey, "web_search")
+			So(idx.exposed["web_search"].instances, ShouldHaveLength, 2)
+			So(idx.exposed, ShouldNotContainKey, "get_todos")
+			So(idx.exposed, ShouldContainKey, "mcp_local__get_todos")
+			So(idx.exposed, ShouldContainKey, "mcp_remote__get_todos")
+			So(idx.exposed["mcp_remote__get_todos"].remote, ShouldEqual, "get_todos")
+			So(idx.exposed["mcp_remote__get_todos"].tool.Name, ShouldEqual, "mcp_remote__get_todos")
+			// 未冲突的工具也能按全名调用
+			So(idx.lookup, ShouldContainKey, "mcp_local__web_search")
+
+			// 暴露名与全名都能解析回服务与原始工具名
+			svc, tool, ok := idx.resolve("mcp_remote__get_todos")
+			So(ok, ShouldBeTrue)
+			So([]string{svc, tool}, ShouldResemble, []string{"mcp_remote", "get_todos"})
+			svc, tool, ok = idx.resolve("web_search")
+			So(ok, ShouldBeTrue)
+			So([]string{svc, tool}, ShouldResemble, []string{"mcp_local", "web_search"})
+			_, _, ok = idx.resolve("get_todos")
+			So(ok, ShouldBeFalse)
+
+			So(idx.report, ShouldHaveLength, 1)
+			So(idx.report[0], ShouldContainSubstring, "conflicting schemas")
+		})
+
+		Convey("alias resolves a collision explicitly", func() {
+			idx := buildToolIndex(insts, config.MCPToolsConfig{Aliases: []config.MCPToolAlias{
+				{Alias: "get_todos", Tool: "mcp_local__get_todos"},
+				{Alias: "missing", Tool: "mcp_local__nothing"},
+			}})
+			So(idx.exposed["get_todos"].service, ShouldEqual, "mcp_local")
+			svc, tool, ok := idx.resolve("get_todos")
+			So(ok, ShouldBeTrue)
+			So([]string{svc, tool}, ShouldResemble, []string{"mcp_local", "get_todos"})
+			So(idx.exposed, ShouldNotContainKey, "mcp_local__get_todos")
+			So(idx.exposed, ShouldContainKey, "mcp_remote__get_todos")
+			So(idx.report, ShouldHaveLength, 2)
+		})
+
+		Convey("always namespace", func() {
+			idx := buildToolIndex(insts, config.MCPToolsConfig{Namespace: constant.MCPToolNamespaceAlways})
+			So(idx.exposed, ShouldContainKey, "mcp_local__web_search")
+			So(idx.exposed, ShouldNotContainKey, "web_search")
+			svc, tool, ok := idx.resolve("mcp_local__web_search")
+			So(ok, ShouldBeTrue)
+			So([]string{svc, tool}, ShouldResemble, []string{"mcp_local", "web_search"})
+		})
+
+		Convey("schema mismatch between replicas is reported", func() {
+			insts[1] = toolsInstance("10.0.0.2:1", "mcp_local", todosV2, search)
+			idx := buildToolIndex(insts, config.MCPToolsConfig{})
+			So(idx.report, ShouldHaveLength, 2)
+			So(idx.report[0], ShouldContainSubstring, "schema mismatch between instances of mcp_local__get_todos")
+		})
+	})
+}
@@ -0,0 +1,81 @@
+package mcp_client
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Progress 工具执行过程中服务端通过 notifications/progress 上报的进度
+type Progress struct {
+	Progress float64
+	Total    float64 // 总量未知时为 0
+	Message  string
+}
+
+// ProgressFunc 接收单次工具调用的进度，在收通知的协程中串行调用，不应阻塞
+type ProgressFunc func(Progress)
+
+type progressKey struct{}
+
+// WithProgress 在 ctx 上发起的工具调用会携带进度令牌，服务端上报的进度交给 fn
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+func progressFromContext(ctx context.Context) ProgressFunc {
+	fn, _ := ctx.Value(progressKey{}).(ProgressFunc)
+	return fn
+}
+
+// progressSeq 进度令牌在进程内唯一，同一会话上并发的调用靠它区分各自的进度
+var progressSeq atomic.Int64
+
+func nextProgressToken() string {
+	return "progress-" + strconv.FormatInt(progressSeq.Add(1), 10)
+}
+
+// guardProgress 同一会话上的通知可能经 GET 监听流或其他请求的响应流送达，与调用返回并发，彼此也可能乱序；
+// 这里把回调串行化，丢弃不大于已上报值的进度（协议要求进度递增），并保证 stop 之后不再调用，
+// 调用方据此可以确定进度单调且都在结果之前
+func guardProgress(fn ProgressFunc) (ProgressFunc, func()) {
+	var mu sync.Mutex
+	stopped := false
+	last, reported := 0.0, false
+	guarded := func(p Progress) {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped || (reported && p.Progress <= last) {
+			return
+		}
+		last, reported = p.Progress, true
+		fn(p)
+	}
+	stop := func() {
+		mu.Lock()
+		stopped = true
+		mu.Unlock()
+	}
+	return guarded, stop
+}
+
+// dispatchProgress 按令牌把进度通知交给对应的调用，调用已结束或令牌未知时丢弃
+func (m *MCPClient) dispatchProgress(n mcp.JSONRPCNotification) {
+	fields := n.Params.AdditionalFields
+	token, ok := fields["progressToken"].(string)
+	if !ok {
+		return
+	}
+	fn, ok := m.progress.Load(token)
+	if !ok {
+		return
+	}
+	p := Progress{}
+	p.Progress, _ = fields["progress"].(float64)
+	p.Total, _ = fields["total"].(float64)
+	p.Message, _ = fields["message"].(string)
+	fn.(ProgressFunc)(p)
+}
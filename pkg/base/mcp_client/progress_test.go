@@ -0,0 +1,96 @@
+package mcp_client
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mcpc "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+func Test_MCPClient_ProgressAndCancel(t *testing.T) {
+	Convey("Test progress routing and cancellation", t, func() {
+		ts := &tool_set.ToolSet{HandlerFunc: map[string]server.ToolHandlerFunc{}}
+		tool_set.AddTool(ts, "steps", "分两步完成", func(ctx context.Context, _ struct{}) (string, error) {
+			_ = tool_set.ReportProgress(ctx, 1, 2, "half")
+			_ = tool_set.ReportProgress(ctx, 2, 2, "")
+			// 进度可能经 GET 监听流送达，稍等再返回，避免与结果赛跑
+			time.Sleep(100 * time.Millisecond)
+			return "done", nil
+		})
+		tool_set.AddTool(ts, "block", "等到被取消", func(ctx context.Context, _ struct{}) (string, error) {
+			<-ctx.Done()
+			return "", ctx.Err()
+		})
+		core := server.NewMCPServer("test", "0.0.1", server.WithToolCapabilities(true))
+		for _, tool := range ts.Tools {
+			core.AddTool(*tool, ts.HandlerFunc[tool.Name])
+		}
+		cancelled := make(chan any, 1)
+		core.AddNotificationHandler(constant.MCPNotificationCancelled, func(ctx context.Context, n mcp.JSONRPCNotification) {
+			cancelled <- n.Params.AdditionalFields["requestId"]
+		})
+		srv := httptest.NewServer(server.NewStreamableHTTPServer(core))
+		defer srv.Close()
+
+		cli, err := newMCPClient(srv.URL, func() (*mcpc.Client, []mcp.Tool, error) { return dialHTTP(srv.URL) })
+		So(err, ShouldBeNil)
+		defer cli.Close()
+
+		Convey("progress reaches the callback of its own call only", func() {
+			var got []Progress
+			ctx := WithProgress(context.Background(), func(p Progress) { got = append(got, p) })
+			out, err := cli.CallTool(ctx, "steps", nil)
+			So(err, ShouldBeNil)
+			So(out.Text, ShouldEqual, "done")
+			// 两条通知可能乱序送达，落后的那条被丢弃
+			So(got, ShouldNotBeEmpty)
+			So(got[len(got)-1], ShouldResemble, Progress{Progress: 2, Total: 2})
+			if len(got) == 2 {
+				So(got[0], ShouldResemble, Progress{Progress: 1, Total: 2, Message: "half"})
+			}
+			n := len(got)
+
+			// 未设置回调的调用不携带令牌，服务端不会上报进度
+			_, err = cli.CallTool(context.Background(), "steps", nil)
+			So(err, ShouldBeNil)
+			So(got, ShouldHaveLength, n)
+		})
+
+		Convey("abandoned calls are cancelled on the server", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			_, err := cli.CallTool(ctx, "block", nil)
+			So(err, ShouldNotBeNil)
+
+			select {
+			case id := <-cancelled:
+				So(id, ShouldNotBeNil)
+			case <-time.After(5 * time.Second):
+				So("no notifications/cancelled received", ShouldBeEmpty)
+			}
+		})
+	})
+}
+
+func Test_guardProgress(t *testing.T) {
+	Convey("Test guardProgress keeps progress monotonic and stops after the result", t, func() {
+		var got []float64
+		guarded, stop := guardProgress(func(p Progress) { got = append(got, p.Progress) })
+		guarded(Progress{Progress: 0})
+		guarded(Progress{Progress: 2})
+		guarded(Progress{Progress: 1})
+		guarded(Progress{Progress: 2})
+		guarded(Progress{Progress: 3})
+		stop()
+		guarded(Progress{Progress: 4})
+		So(got, ShouldResemble, []float64{0, 2, 3})
+	})
+}
@@ -0,0 +1,90 @@
+package mcp_client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// Prompt 服务端发布的提示词模板
+type Prompt struct {
+	Name        string
+	Description string
+	Arguments   []PromptArgument
+}
+
+// PromptArgument 提示词模板的参数，取值均为字符串
+type PromptArgument struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+// PromptResult 展开后的提示词，消息中的图片、内嵌资源与资源链接按工具结果的方式映射
+type PromptResult struct {
+	Description string
+	Messages    []ai_provider.ChatMessage
+}
+
+func convertPrompt(p mcp.Prompt) Prompt {
+	out := Prompt{Name: p.Name, Description: p.Description}
+	for _, arg := range p.Arguments {
+		out.Arguments = append(out.Arguments, PromptArgument{Name: arg.Name, Description: arg.Description, Required: arg.Required})
+	}
+	return out
+}
+
+func toPromptResult(ctx context.Context, res *mcp.GetPromptResult, read resourceReader) *PromptResult {
+	out := &PromptResult{Description: res.Description}
+	for _, msg := range res.Messages {
+		r := &ToolResult{}
+		text := strings.Join(r.addContent(ctx, msg.Content, read), "\n")
+		if msg.Role == mcp.RoleAssistant {
+			out.Messages = append(out.Messages, ai_provider.AssistantMessage(text))
+			continue
+		}
+		out.Messages = append(out.Messages, ai_provider.UserMessage(text, r.Images...))
+	}
+	return out
+}
+
+// ListPrompts 列出服务端的提示词模板，服务端未声明 prompts 能力时返回空
+func (m *MCPClient) ListPrompts(ctx context.Context) ([]Prompt, error) {
+	c := m.session()
+	if c.GetServerCapabilities().Prompts == nil {
+		return nil, nil
+	}
+	res, err := c.ListPrompts(ctx, mcp.ListPromptsRequest{})
+	if err != nil {
+		return nil, m.requestFailed(ctx, "list prompts", err)
+	}
+	out := make([]Prompt, 0, len(res.Prompts))
+	for _, p := range res.Prompts {
+		out = append(out, convertPrompt(p))
+	}
+	return out, nil
+}
+
+// GetPrompt 以 args 展开提示词模板
+func (m *MCPClient) GetPrompt(ctx context.Context, name string, args map[string]string) (*PromptResult, error) {
+	c := m.session()
+	res, err := c.GetPrompt(ctx, mcp.GetPromptRequest{Params: mcp.GetPromptParams{Name: name, Arguments: args}})
+	if err != nil {
+		return nil, m.requestFailed(ctx, "get prompt "+name, err)
+	}
+	return toPromptResult(ctx, res, sessionReader(c)), nil
+}
+
+// requestFailed 记录失败的请求，会话层面的失败触发存活检查
+func (m *MCPClient) requestFailed(ctx context.Context, what string, err error) error {
+	logger.Errorf("mcp %s: %s: %v", m.name, what, err)
+	if isTransportError(ctx, err) {
+		m.Check()
+	}
+	return fmt.Errorf("%s: %w", what, err)
+}
@@ -0,0 +1,85 @@
+package mcp_client
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	mcpc "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/resource_set"
+)
+
+func Test_MCPClient_PromptsAndResources(t *testing.T) {
+	Convey("Test prompts and resources", t, func() {
+		core := server.NewMCPServer("test", "0.0.1", server.WithToolCapabilities(true))
+		core.AddPrompt(mcp.NewPrompt("greet",
+			mcp.WithPromptDescription("打招呼"),
+			mcp.WithArgument("name", mcp.RequiredArgument()),
+		), func(ctx context.Context, req mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+			return mcp.NewGetPromptResult("greeting", []mcp.PromptMessage{
+				mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent("hello "+req.Params.Arguments["name"])),
+				mcp.NewPromptMessage(mcp.RoleAssistant, mcp.NewTextContent("hi")),
+				mcp.NewPromptMessage(mcp.RoleUser, mcp.NewEmbeddedResource(mcp.BlobResourceContents{
+					URI: "file:///a.png", MIMEType: "image/png", Blob: "aGk=",
+				})),
+			}), nil
+		})
+		core.AddResource(mcp.NewResource("file:///readme", "readme", mcp.WithMIMEType("text/plain")),
+			func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+				return []mcp.ResourceContents{mcp.TextResourceContents{URI: req.Params.URI, Text: "read me"}}, nil
+			})
+		core.AddResourceTemplate(mcp.NewResourceTemplate("todo://{user_id}", "todos"),
+			func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+				return []mcp.ResourceContents{mcp.TextResourceContents{
+					URI: req.Params.URI, Text: "todos of " + resource_set.TemplateArg(req, "user_id"),
+				}}, nil
+			})
+		srv := httptest.NewServer(server.NewStreamableHTTPServer(core))
+		defer srv.Close()
+
+		cli, err := newMCPClient(srv.URL, func() (*mcpc.Client, []mcp.Tool, error) { return dialHTTP(srv.URL) })
+		So(err, ShouldBeNil)
+		defer cli.Close()
+		ctx := context.Background()
+
+		Convey("prompts are listed and expanded", func() {
+			prompts, err := cli.ListPrompts(ctx)
+			So(err, ShouldBeNil)
+			So(prompts, ShouldResemble, []Prompt{{
+				Name:        "greet",
+				Description: "打招呼",
+				Arguments:   []PromptArgument{{Name: "name", Required: true}},
+			}})
+
+			res, err := cli.GetPrompt(ctx, "greet", map[string]string{"name": "alice"})
+			So(err, ShouldBeNil)
+			So(res.Description, ShouldEqual, "greeting")
+			So(res.Messages, ShouldHaveLength, 3)
+			So(res.Messages[0], ShouldResemble, ai_provider.UserMessage("hello alice"))
+			So(res.Messages[1], ShouldResemble, ai_provider.AssistantMessage("hi"))
+			So(res.Messages[2].Content, ShouldEqual, "[image #1 image/png from file:///a.png]")
+			So(res.Messages[2].Images, ShouldResemble, []ai_provider.ChatImage{{URL: "data:image/png;base64,aGk="}})
+		})
+
+		Convey("resources and templates are listed and read", func() {
+			resources, err := cli.ListResources(ctx)
+			So(err, ShouldBeNil)
+			So(resources, ShouldResemble, []Resource{
+				{URI: "file:///readme", Name: "readme", MIMEType: "text/plain"},
+				{URI: "todo://{user_id}", Name: "todos", Template: true},
+			})
+
+			out, err := cli.ReadResource(ctx, "todo://102301")
+			So(err, ShouldBeNil)
+			So(out.Text, ShouldEqual, "[resource todo://102301]\ntodos of 102301")
+
+			_, err = cli.ReadResource(ctx, "file:///missing")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
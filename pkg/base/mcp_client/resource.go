@@ -0,0 +1,73 @@
+package mcp_client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Resource 服务端发布的资源；Template 为 true 时 URI 是 RFC 6570 模板，如 todo://{user_id}
+type Resource struct {
+	URI         string
+	Name        string
+	Description string
+	MIMEType    string
+	Template    bool
+}
+
+// ListResources 列出服务端的资源与资源模板，服务端未声明 resources 能力时返回空
+func (m *MCPClient) ListResources(ctx context.Context) ([]Resource, error) {
+	c := m.session()
+	if c.GetServerCapabilities().Resources == nil {
+		return nil, nil
+	}
+	res, err := c.ListResources(ctx, mcp.ListResourcesRequest{})
+	if err != nil {
+		return nil, m.requestFailed(ctx, "list resources", err)
+	}
+	templates, err := c.ListResourceTemplates(ctx, mcp.ListResourceTemplatesRequest{})
+	if err != nil {
+		return nil, m.requestFailed(ctx, "list resource templates", err)
+	}
+
+	out := make([]Resource, 0, len(res.Resources)+len(templates.ResourceTemplates))
+	for _, r := range res.Resources {
+		out = append(out, Resource{URI: r.URI, Name: r.Name, Description: r.Description, MIMEType: r.MIMEType})
+	}
+	for _, t := range templates.ResourceTemplates {
+		if t.URITemplate == nil {
+			continue
+		}
+		out = append(out, Resource{
+			URI:         t.URITemplate.Raw(),
+			Name:        t.Name,
+			Description: t.Description,
+			MIMEType:    t.MIMEType,
+			Template:    true,
+		})
+	}
+	return out, nil
+}
+
+// ReadResource 读取资源，内容按工具结果的方式映射为文本与图片
+func (m *MCPClient) ReadResource(ctx context.Context, uri string) (*ToolResult, error) {
+	contents, err := sessionReader(m.session())(ctx, uri)
+	if err != nil {
+		return nil, m.requestFailed(ctx, "read resource "+uri, err)
+	}
+	return toResourceResult(contents), nil
+}
+
+func toResourceResult(contents []mcp.ResourceContents) *ToolResult {
+	out := &ToolResult{}
+	parts := make([]string, 0, len(contents))
+	for _, rc := range contents {
+		parts = append(parts, out.addResource(rc))
+	}
+	out.Text = strings.Join(parts, "\n")
+	if out.Text == "" {
+		out.Text = "(no content)"
+	}
+	return out
+}
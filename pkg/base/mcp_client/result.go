@@ -0,0 +1,134 @@
+package mcp_client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	mcpc "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+)
+
+// ToolResult 一次工具调用的完整结果
+type ToolResult struct {
+	Text       string                  // 回填给模型的文本：文本内容、结构化内容 JSON、资源内容，以及无法直接展示的内容的说明
+	IsError    bool                    // 工具自身报告失败（isError），调用本身是成功的
+	Images     []ai_provider.ChatImage // 图片内容（含图片类资源），data URL 形式，能否交给模型由调用方决定
+	Structured any                     // 原样保留的 structuredContent
+}
+
+// resourceReader 通过 resources/read 读取 resource_link 指向的资源
+type resourceReader func(ctx context.Context, uri string) ([]mcp.ResourceContents, error)
+
+// sessionReader 在会话 c 上读取资源
+func sessionReader(c *mcpc.Client) resourceReader {
+	return func(ctx context.Context, uri string) ([]mcp.ResourceContents, error) {
+		rr, err := c.ReadResource(ctx, mcp.ReadResourceRequest{Params: mcp.ReadResourceParams{URI: uri}})
+		if err != nil {
+			return nil, err
+		}
+		return rr.Contents, nil
+	}
+}
+
+// toToolResult 把 MCP 的工具结果逐项映射为 ToolResult，各部分按原顺序以换行拼接
+func toToolResult(ctx context.Context, res *mcp.CallToolResult, read resourceReader) *ToolResult {
+	out := &ToolResult{IsError: res.IsError, Structured: res.StructuredContent}
+	var parts []string
+	for _, c := range res.Content {
+		parts = append(parts, out.addContent(ctx, c, read)...)
+	}
+	if out.Structured != nil && !containsJSON(parts, out.Structured) {
+		b, _ := json.Marshal(out.Structured)
+		parts = append(parts, string(b))
+	}
+
+	out.Text = strings.Join(parts, "\n")
+	if out.Text == "" {
+		out.Text = "(no content)"
+	}
+	return out
+}
+
+// addContent 映射单项内容，返回其在文本中的部分；提示词消息与资源内容也按同样的方式映射
+func (r *ToolResult) addContent(ctx context.Context, c mcp.Content, read resourceReader) []string {
+	if tc, ok := mcp.AsTextContent(c); ok {
+		return []string{tc.Text}
+	} else if ic, ok := mcp.AsImageContent(c); ok {
+		return []string{r.addImage(ic.MIMEType, ic.Data, "")}
+	} else if ac, ok := mcp.AsAudioContent(c); ok {
+		return []string{fmt.Sprintf("[audio %s omitted: audio content is not supported]", ac.MIMEType)}
+	} else if er, ok := mcp.AsEmbeddedResource(c); ok {
+		return []string{r.addResource(er.Resource)}
+	} else if link, ok := asResourceLink(c); ok {
+		return r.resolveLink(ctx, link, read)
+	}
+	return nil
+}
+
+// addImage 收下图片并返回在文本中占位的说明
+func (r *ToolResult) addImage(mimeType, data, uri string) string {
+	r.Images = append(r.Images, ai_provider.ChatImage{URL: "data:" + mimeType + ";base64," + data})
+	if uri != "" {
+		return fmt.Sprintf("[image #%d %s from %s]", len(r.Images), mimeType, uri)
+	}
+	return fmt.Sprintf("[image #%d %s]", len(r.Images), mimeType)
+}
+
+func (r *ToolResult) addResource(rc mcp.ResourceContents) string {
+	if t, ok := mcp.AsTextResourceContents(rc); ok {
+		return fmt.Sprintf("[resource %s]\n%s", t.URI, t.Text)
+	}
+	if b, ok := mcp.AsBlobResourceContents(rc); ok {
+		if strings.HasPrefix(b.MIMEType, "image/") {
+			return r.addImage(b.MIMEType, b.Blob, b.URI)
+		}
+		return fmt.Sprintf("[resource %s (%s) omitted: binary content]", b.URI, b.MIMEType)
+	}
+	return ""
+}
+
+func (r *ToolResult) resolveLink(ctx context.Context, link *mcp.ResourceLink, read resourceReader) []string {
+	if read == nil {
+		return []string{fmt.Sprintf("[resource link %s (%s)]", link.URI, link.Name)}
+	}
+	contents, err := read(ctx, link.URI)
+	if err != nil {
+		return []string{fmt.Sprintf("[resource link %s (%s): read failed: %v]", link.URI, link.Name, err)}
+	}
+	parts := make([]string, 0, len(contents))
+	for _, rc := range contents {
+		parts = append(parts, r.addResource(rc))
+	}
+	return parts
+}
+
+func asResourceLink(c mcp.Content) (*mcp.ResourceLink, bool) {
+	switch l := c.(type) {
+	case mcp.ResourceLink:
+		return &l, true
+	case *mcp.ResourceLink:
+		return l, l != nil
+	}
+	return nil, false
+}
+
+// containsJSON 工具通常会把结构化内容的 JSON 同时放在文本里，已有时不再重复
+func containsJSON(parts []string, structured any) bool {
+	var want any
+	b, err := json.Marshal(structured)
+	if err != nil || json.Unmarshal(b, &want) != nil {
+		return false
+	}
+	for _, p := range parts {
+		var got any
+		if json.Unmarshal([]byte(p), &got) == nil && reflect.DeepEqual(got, want) {
+			return true
+		}
+	}
+	return false
+}
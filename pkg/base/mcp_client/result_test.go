@@ -0,0 +1,61 @@
+package mcp_client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func Test_toToolResult(t *testing.T) {
+	Convey("Test tool result mapping", t, func() {
+		read := func(ctx context.Context, uri string) ([]mcp.ResourceContents, error) {
+			if uri == "file:///missing" {
+				return nil, errors.New("not found")
+			}
+			return []mcp.ResourceContents{mcp.TextResourceContents{URI: uri, Text: "linked body"}}, nil
+		}
+
+		Convey("every content kind is kept in order", func() {
+			res := &mcp.CallToolResult{Content: []mcp.Content{
+				mcp.NewTextContent("plot ready"),
+				mcp.NewImageContent("aGk=", "image/png"),
+				mcp.NewAudioContent("aGk=", "audio/wav"),
+				mcp.NewEmbeddedResource(mcp.TextResourceContents{URI: "file:///a.txt", Text: "embedded body"}),
+				mcp.NewEmbeddedResource(mcp.BlobResourceContents{URI: "file:///b.jpg", MIMEType: "image/jpeg", Blob: "aGk="}),
+				mcp.NewResourceLink("file:///c.txt", "c", "", "text/plain"),
+				mcp.NewResourceLink("file:///missing", "m", "", "text/plain"),
+			}}
+			out := toToolResult(context.Background(), res, read)
+			So(out.IsError, ShouldBeFalse)
+			So(out.Images, ShouldHaveLength, 2)
+			So(out.Images[0].URL, ShouldEqual, "data:image/png;base64,aGk=")
+			So(out.Text, ShouldEqual, "plot ready\n"+
+				"[image #1 image/png]\n"+
+				"[audio audio/wav omitted: audio content is not supported]\n"+
+				"[resource file:///a.txt]\nembedded body\n"+
+				"[image #2 image/jpeg from file:///b.jpg]\n"+
+				"[resource file:///c.txt]\nlinked body\n"+
+				"[resource link file:///missing (m): read failed: not found]")
+		})
+
+		Convey("structured content is appended unless the text already carries it", func() {
+			structured := map[string]any{"count": 2}
+			out := toToolResult(context.Background(), mcp.NewToolResultStructured(structured, `{"count": 2}`), read)
+			So(out.Text, ShouldEqual, `{"count": 2}`)
+			So(out.Structured, ShouldResemble, structured)
+
+			out = toToolResult(context.Background(), mcp.NewToolResultStructured(structured, "two items"), read)
+			So(out.Text, ShouldEqual, "two items\n{\"count\":2}")
+		})
+
+		Convey("errors are flagged", func() {
+			out := toToolResult(context.Background(), mcp.NewToolResultError("boom"), read)
+			So(out.IsError, ShouldBeTrue)
+			So(out.Text, ShouldEqual, "boom")
+			So(toToolResult(context.Background(), &mcp.CallToolResult{}, nil).Text, ShouldEqual, "(no content)")
+		})
+	})
+}
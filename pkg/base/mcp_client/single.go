@@ -3,40 +3,245 @@ package mcp_client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	mcpc "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
 	"github.com/mark3labs/mcp-go/mcp"
-	"github.com/openai/openai-go/v2"
-	"github.com/openai/openai-go/v2/packages/param"
 )
 
+// dialFunc 建立一个已完成 initialize 的会话并列出工具
+type dialFunc func() (*mcpc.Client, []mcp.Tool, error)
+
+// 重连退避的首次等待与上限，测试中调小
+var (
+	reconnectBaseDelay = constant.MCPReconnectBaseDelay
+	reconnectMaxDelay  = constant.MCPReconnectMaxDelay
+)
+
+// MCPClient 单个 MCP 服务的连接。会话失效（服务重启、连接断开）时在后台重新
+// initialize 并刷新工具列表，期间 Alive 返回 false；收到 tools/list_changed 通知时重新拉取工具列表
 type MCPClient struct {
-	Client *mcpc.Client
-	Tools  []mcp.Tool
+	name string
+	dial dialFunc
+
+	mu     sync.RWMutex
+	client *mcpc.Client
+	tools  []mcp.Tool
+
+	alive          atomic.Bool
+	checking       atomic.Bool // 同一时间只有一个存活检查/重连在进行
+	onToolsChanged func()      // 工具列表变化（重连、list_changed）后回调，用于刷新聚合索引
+	closeCh        chan struct{}
+	closeOnce      sync.Once
+	progress       sync.Map // 进行中调用的进度令牌 -> ProgressFunc
 }
 
 // NewMCPClient 启动 MCP Server 并建立连接
 func NewMCPClient(url string) (*MCPClient, error) {
+	var dial dialFunc
 	switch config.MCP.Transport {
 	case "stdio", "":
-		return newStdioMCPClient()
+		dial = dialStdio
 	case "sse":
-		return newSSEMCPClientWithConn(url)
+		dial = func() (*mcpc.Client, []mcp.Tool, error) { return dialSSE(url) }
 	case "http":
-		return newHTTPMCPClientWithConn(url)
+		dial = func() (*mcpc.Client, []mcp.Tool, error) { return dialHTTP(url) }
 	default:
 		return nil, fmt.Errorf("unknown MCP transport: %s", config.MCP.Transport)
 	}
+	return newMCPClient(url, dial)
+}
+
+func newMCPClient(name string, dial dialFunc) (*MCPClient, error) {
+	client, tools, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	m := &MCPClient{name: name, dial: dial, client: client, tools: tools, closeCh: make(chan struct{})}
+	m.alive.Store(true)
+	m.watch(client)
+	return m, nil
+}
+
+// watch 订阅会话上的通知：工具列表变化时立即重新拉取，进度通知转交给对应的调用
+func (m *MCPClient) watch(c *mcpc.Client) {
+	c.OnNotification(func(n mcp.JSONRPCNotification) {
+		switch n.Method {
+		case mcp.MethodNotificationToolsListChanged:
+			logger.Infof("mcp %s: tools list changed", m.name)
+			go m.refreshTools(c)
+		case constant.MCPNotificationProgress:
+			m.dispatchProgress(n)
+		}
+	})
+}
+
+// refreshTools 在会话 c 上重新拉取工具列表；c 已被重连替换时忽略
+func (m *MCPClient) refreshTools(c *mcpc.Client) {
+	ctx, cancel := context.WithTimeout(context.Background(), constant.MCPClientInitTimeout)
+	defer cancel()
+	res, err := c.ListTools(ctx, mcp.ListToolsRequest{})
+	if err != nil {
+		logger.Warnf("mcp %s: list tools failed: %v", m.name, err)
+		return
+	}
+	m.mu.Lock()
+	if m.client != c {
+		m.mu.Unlock()
+		return
+	}
+	changed := !sameTools(m.tools, res.Tools)
+	m.tools = res.Tools
+	m.mu.Unlock()
+	if changed {
+		logger.Infof("mcp %s: tools refreshed (tools=%d)", m.name, len(res.Tools))
+		m.notifyToolsChanged()
+	}
+}
+
+// sameTools 比较两次工具列表：名称、描述与入参 schema 都相同才算未变化，
+// 否则聚合索引会保留旧的 schema，参数校验也会按旧 schema 进行
+func sameTools(a, b []mcp.Tool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]string, len(a))
+	for _, t := range a {
+		seen[t.Name] = toolFingerprint(t)
+	}
+	for _, t := range b {
+		if fp, ok := seen[t.Name]; !ok || fp != toolFingerprint(t) {
+			return false
+		}
+	}
+	return true
+}
+
+func toolFingerprint(t mcp.Tool) string {
+	return t.Description + "\x00" + schemaFingerprint(t)
+}
+
+func (m *MCPClient) notifyToolsChanged() {
+	if m.onToolsChanged != nil {
+		m.onToolsChanged()
+	}
+}
+
+// OnToolsChanged 设置工具列表变化后的回调，需在客户端投入使用前设置
+func (m *MCPClient) OnToolsChanged(fn func()) {
+	m.onToolsChanged = fn
+}
+
+// ListTools 当前会话上的工具列表
+func (m *MCPClient) ListTools() []mcp.Tool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.tools
+}
+
+// Resolve 单连接不做命名空间处理，工具名即原始名，服务未知
+func (m *MCPClient) Resolve(name string) (service, tool string, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if !slices.ContainsFunc(m.tools, func(t mcp.Tool) bool { return t.Name == name }) {
+		return "", "", false
+	}
+	return "", name, true
+}
+
+// Alive 会话是否可用，重连期间为 false
+func (m *MCPClient) Alive() bool {
+	return m.alive.Load()
+}
+
+func (m *MCPClient) session() *mcpc.Client {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.client
+}
+
+// isTransportError 请求没能得到服务端的 JSON-RPC 响应（连接被拒、会话 404 等），
+// 调用方自身取消或超时不算；服务端返回的 JSON-RPC 错误说明会话仍然可用
+func isTransportError(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	var tErr *transport.Error
+	return errors.As(err, &tErr) && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled)
+}
+
+// Check 在后台检查会话：先 ping，ping 不通才认定会话已失效并重连；
+// 服务端未声明 tools.listChanged 时顺带重新拉取工具列表。已有检查在进行时直接返回
+func (m *MCPClient) Check() {
+	if !m.checking.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer m.checking.Store(false)
+		c := m.session()
+		ctx, cancel := context.WithTimeout(context.Background(), constant.MCPPingTimeout)
+		err := c.Ping(ctx)
+		cancel()
+		if err == nil {
+			m.alive.Store(true)
+			if caps := c.GetServerCapabilities(); caps.Tools == nil || !caps.Tools.ListChanged {
+				m.refreshTools(c)
+			}
+			return
+		}
+		logger.Warnf("mcp %s: session dead, reconnecting: %v", m.name, err)
+		m.alive.Store(false)
+		m.reconnect()
+	}()
+}
+
+// reconnect 以指数退避重新建立会话，直到成功或客户端被关闭
+func (m *MCPClient) reconnect() {
+	delay := reconnectBaseDelay
+	for attempt := 1; ; attempt++ {
+		client, tools, err := m.dial()
+		if err == nil {
+			select {
+			case <-m.closeCh:
+				_ = client.Close()
+				return
+			default:
+			}
+			m.watch(client)
+			m.mu.Lock()
+			old := m.client
+			m.client, m.tools = client, tools
+			m.mu.Unlock()
+			_ = old.Close()
+			m.alive.Store(true)
+			logger.Infof("mcp %s: reconnected after %d attempt(s) (tools=%d)", m.name, attempt, len(tools))
+			m.notifyToolsChanged()
+			return
+		}
+		logger.Warnf("mcp %s: reconnect attempt %d failed, retry in %v: %v", m.name, attempt, delay, err)
+		select {
+		case <-m.closeCh:
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, reconnectMaxDelay)
+	}
 }
 
 // ConvertToolsToOllama 转换 MCP 工具定义到 AiProvider 工具格式
 func (m *MCPClient) ConvertToolsToOllama() []map[string]any {
 	var out []map[string]any
-	for _, t := range m.Tools {
+	for _, t := range m.ListTools() {
 		var params map[string]any
 		b, _ := json.Marshal(t.InputSchema)
 		_ = json.Unmarshal(b, &params)
@@ -53,86 +258,56 @@ func (m *MCPClient) ConvertToolsToOllama() []map[string]any {
 	return out
 }
 
-// ConvertToolsToOpenAI 将 MCP 工具定义转换为 OpenAI Chat Completions 的 tools 参数
-func (m *MCPClient) ConvertToolsToOpenAI() []openai.ChatCompletionToolUnionParam {
-	out := make([]openai.ChatCompletionToolUnionParam, 0, len(m.Tools))
-	for _, t := range m.Tools {
-		var paramsMap map[string]any
-		if b, _ := json.Marshal(t.InputSchema); len(b) != 0 {
-			_ = json.Unmarshal(b, &paramsMap)
-		}
-
-		var fp openai.FunctionParameters
-		if b, err := json.Marshal(paramsMap); err == nil {
-			_ = json.Unmarshal(b, &fp)
-		}
-		fn := openai.FunctionDefinitionParam{
-			Name:        t.Name,
-			Description: param.Opt[string]{Value: t.Description},
-			Parameters:  fp,
-		}
-		tool := &openai.ChatCompletionFunctionToolParam{
-			Type:     "function",
-			Function: fn,
-		}
-		out = append(out, openai.ChatCompletionToolUnionParam{
-			OfFunction: tool,
-		})
+// ConvertTools 将 MCP 工具定义转换为与模型提供方无关的工具声明
+func (m *MCPClient) ConvertTools() []ai_provider.ChatTool {
+	tools := m.ListTools()
+	out := make([]ai_provider.ChatTool, 0, len(tools))
+	for _, t := range tools {
+		out = append(out, convertTool(t))
 	}
 	return out
 }
 
-// CallTool 调用 MCP 工具
-func (m *MCPClient) CallTool(ctx context.Context, name string, args any) (string, error) {
-	// 设置进度通知处理（这里应该用不上，是streamable HTTP的特性，太高级了）
-	//m.Client.OnNotification(func(notification mcp.JSONRPCNotification) {
-	//	logger.Infof("Received notification: %v", notification)
-	//	if notification.Method == "notifications/progress" {
-	//		fmt.Println(notification)
-	//		params := notification.Params.AdditionalFields
-	//		progress := params["progress"].(float64)
-	//		total := params["total"].(float64)
-	//		message := params["message"].(string)
-	//
-	//		// 打印进度信息
-	//		fmt.Printf("Progress: %.2f%% - %s\n", (progress/total)*100, message)
-	//	}
-	//})
-
-	res, err := m.Client.CallTool(ctx, mcp.CallToolRequest{
-		Params: mcp.CallToolParams{
-			Name:      name,
-			Arguments: args,
-			Meta: &mcp.Meta{
-				ProgressToken: time.Now().Unix(),
-			},
-		},
-	})
-	if err != nil {
-		logger.Errorf("call tool %s: %v", name, err)
-		return "", fmt.Errorf("call tool %s: %w", name, err)
+// convertTool InputSchema 经 JSON 转成 map，作为 JSON Schema 原样交给模型
+func convertTool(t mcp.Tool) ai_provider.ChatTool {
+	var params map[string]any
+	if b, err := json.Marshal(t.InputSchema); err == nil {
+		_ = json.Unmarshal(b, &params)
 	}
-
-	// 提取返回文本
-	var text string
-	for _, c := range res.Content {
-		if tc, ok := mcp.AsTextContent(c); ok {
-			text += tc.Text + "\n"
-		}
+	return ai_provider.ChatTool{
+		Name:        t.Name,
+		Description: t.Description,
+		Parameters:  params,
 	}
-	if text == "" && res.StructuredContent != nil {
-		b, _ := json.Marshal(res.StructuredContent)
-		text = string(b)
+}
+
+// CallTool 调用 MCP 工具。ctx 经 WithProgress 设置了回调时携带进度令牌，
+// 服务端上报的进度在调用返回前交给该回调；ctx 取消时服务端会收到 notifications/cancelled
+func (m *MCPClient) CallTool(ctx context.Context, name string, args any) (*ToolResult, error) {
+	params := mcp.CallToolParams{Name: name, Arguments: args}
+	if fn := progressFromContext(ctx); fn != nil {
+		token := nextProgressToken()
+		guarded, stop := guardProgress(fn)
+		m.progress.Store(token, guarded)
+		defer func() {
+			m.progress.Delete(token)
+			stop()
+		}()
+		params.Meta = &mcp.Meta{ProgressToken: token}
 	}
-	if text == "" {
-		text = "(no content)"
+
+	c := m.session()
+	res, err := c.CallTool(ctx, mcp.CallToolRequest{Params: params})
+	if err != nil {
+		return nil, m.requestFailed(ctx, "call tool "+name, err)
 	}
-	return text, nil
+	return toToolResult(ctx, res, sessionReader(c)), nil
 }
 
-// Close 关闭连接
+// Close 关闭连接并停止后台重连
 func (m *MCPClient) Close() {
-	if m.Client != nil {
-		_ = m.Client.Close()
+	m.closeOnce.Do(func() { close(m.closeCh) })
+	if c := m.session(); c != nil {
+		_ = c.Close()
 	}
 }
@@ -0,0 +1,192 @@
+package mcp_client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mcpc "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func echoTool(name string) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool(name, mcp.WithString("text")), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(name + ":" + req.GetString("text", "")), nil
+	}
+}
+
+func newTestCore() *server.MCPServer {
+	core := server.NewMCPServer("test", "0.0.1", server.WithToolCapabilities(true))
+	core.AddTool(echoTool("echo"))
+	return core
+}
+
+func waitSignal(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	case <-time.After(5 * time.Second):
+		return false
+	}
+}
+
+func Test_MCPClient_Lifecycle(t *testing.T) {
+	Convey("Test MCPClient follows the server", t, func() {
+		// 通过可替换的 handler 模拟服务端重启：新实例不认识旧会话
+		var handler atomic.Pointer[server.StreamableHTTPServer]
+		core := newTestCore()
+		handler.Store(server.NewStreamableHTTPServer(core))
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.Load().ServeHTTP(w, r)
+		}))
+		defer ts.Close()
+
+		cli, err := newMCPClient(ts.URL, func() (*mcpc.Client, []mcp.Tool, error) { return dialHTTP(ts.URL) })
+		So(err, ShouldBeNil)
+		defer cli.Close()
+		changed := make(chan struct{}, 4)
+		cli.OnToolsChanged(func() { changed <- struct{}{} })
+		So(cli.ListTools(), ShouldHaveLength, 1)
+
+		Convey("hot-added tool appears through list_changed", func() {
+			core.AddTool(echoTool("echo2"))
+			So(waitSignal(changed), ShouldBeTrue)
+			So(cli.ListTools(), ShouldHaveLength, 2)
+
+			out, err := cli.CallTool(context.Background(), "echo2", map[string]any{"text": "hi"})
+			So(err, ShouldBeNil)
+			So(out.Text, ShouldEqual, "echo2:hi")
+		})
+
+		Convey("a changed schema or description is picked up through list_changed", func() {
+			tool, handler := echoTool("echo")
+			tool.InputSchema.Required = []string{"text"}
+			core.AddTool(tool, handler)
+			So(waitSignal(changed), ShouldBeTrue)
+			So(cli.ListTools()[0].InputSchema.Required, ShouldResemble, []string{"text"})
+
+			tool.Description = "echo the text back"
+			core.AddTool(tool, handler)
+			So(waitSignal(changed), ShouldBeTrue)
+			So(cli.ListTools()[0].Description, ShouldEqual, "echo the text back")
+		})
+
+		Convey("dead session is re-initialized in the background", func() {
+			restarted := newTestCore()
+			restarted.AddTool(echoTool("echo3"))
+			handler.Store(server.NewStreamableHTTPServer(restarted))
+
+			_, err := cli.CallTool(context.Background(), "echo", map[string]any{"text": "hi"})
+			So(err, ShouldNotBeNil)
+			So(waitSignal(changed), ShouldBeTrue)
+			So(cli.Alive(), ShouldBeTrue)
+			So(cli.ListTools(), ShouldHaveLength, 2)
+
+			out, err := cli.CallTool(context.Background(), "echo3", map[string]any{"text": "hi"})
+			So(err, ShouldBeNil)
+			So(out.Text, ShouldEqual, "echo3:hi")
+		})
+	})
+}
+
+// flakyDial 前 fails 次拨号失败，之后连接 url；记录每次拨号的时间与当时客户端是否存活
+type flakyDial struct {
+	url   string
+	fails int
+
+	mu       sync.Mutex
+	cli      *MCPClient
+	attempts []time.Time
+	alive    []bool
+}
+
+func (d *flakyDial) dial() (*mcpc.Client, []mcp.Tool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.cli == nil {
+		return dialHTTP(d.url)
+	}
+	d.attempts = append(d.attempts, time.Now())
+	d.alive = append(d.alive, d.cli.Alive())
+	if len(d.attempts) <= d.fails {
+		return nil, nil, errors.New("connection refused")
+	}
+	return dialHTTP(d.url)
+}
+
+func (d *flakyDial) attemptCount() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.attempts)
+}
+
+func Test_MCPClient_Reconnect(t *testing.T) {
+	Convey("Test MCPClient reconnects with backoff", t, func() {
+		base, maxDelay := reconnectBaseDelay, reconnectMaxDelay
+		reconnectBaseDelay, reconnectMaxDelay = 20*time.Millisecond, 40*time.Millisecond
+		defer func() { reconnectBaseDelay, reconnectMaxDelay = base, maxDelay }()
+
+		var handler atomic.Pointer[server.StreamableHTTPServer]
+		handler.Store(server.NewStreamableHTTPServer(newTestCore()))
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler.Load().ServeHTTP(w, r)
+		}))
+		defer ts.Close()
+
+		d := &flakyDial{url: ts.URL, fails: 3}
+		cli, err := newMCPClient(ts.URL, d.dial)
+		So(err, ShouldBeNil)
+		defer cli.Close()
+		d.mu.Lock()
+		d.cli = cli
+		d.mu.Unlock()
+		changed := make(chan struct{}, 4)
+		cli.OnToolsChanged(func() { changed <- struct{}{} })
+
+		// 服务端重启后旧会话失效，ping 不通
+		handler.Store(server.NewStreamableHTTPServer(newTestCore()))
+
+		Convey("the session comes back after failed attempts, waiting longer each time", func() {
+			cli.Check()
+			So(waitSignal(changed), ShouldBeTrue)
+			So(cli.Alive(), ShouldBeTrue)
+
+			d.mu.Lock()
+			attempts, alive := d.attempts, d.alive
+			d.mu.Unlock()
+			So(attempts, ShouldHaveLength, 4)
+			So(alive, ShouldResemble, []bool{false, false, false, false})
+			for i, want := range []time.Duration{20 * time.Millisecond, 40 * time.Millisecond, 40 * time.Millisecond} {
+				So(attempts[i+1].Sub(attempts[i]), ShouldBeGreaterThanOrEqualTo, want)
+			}
+
+			out, err := cli.CallTool(context.Background(), "echo", map[string]any{"text": "hi"})
+			So(err, ShouldBeNil)
+			So(out.Text, ShouldEqual, "echo:hi")
+		})
+
+		Convey("Close stops the retry loop", func() {
+			d.mu.Lock()
+			d.fails = 1 << 30
+			d.mu.Unlock()
+			cli.Check()
+			for d.attemptCount() < 2 {
+				time.Sleep(5 * time.Millisecond)
+			}
+			cli.Close()
+			time.Sleep(100 * time.Millisecond)
+			n := d.attemptCount()
+			time.Sleep(100 * time.Millisecond)
+			So(d.attemptCount(), ShouldEqual, n)
+			So(cli.checking.Load(), ShouldBeFalse)
+			So(cli.Alive(), ShouldBeFalse)
+		})
+	})
+}
@@ -6,24 +6,26 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	mcpc "github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
-// newStdioMCPClient 通过 stdio 连接
-func newStdioMCPClient() (*MCPClient, error) {
+// dialStdio 通过 stdio 连接，重连时会重新拉起子进程
+func dialStdio() (*mcpc.Client, []mcp.Tool, error) {
 	cmd := config.MCP.Stdio.ServerCmd
 	if cmd == "" {
 		cmd = "./bin/mcp-server"
 	}
-	client, err := mcpc.NewStdioMCPClient(cmd, nil, config.MCP.Stdio.ServerArgs...)
-	if err != nil {
-		return nil, fmt.Errorf("start stdio client: %w", err)
+	// 需经 Start 注册通知回调，否则收不到 list_changed、进度等通知；子进程生命周期跟随 Start 的 ctx
+	client := mcpc.NewClient(withCancelNotify(transport.NewStdio(cmd, nil, config.MCP.Stdio.ServerArgs...)))
+	if err := client.Start(context.Background()); err != nil {
+		return nil, nil, fmt.Errorf("start stdio client: %w", err)
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), constant.MCPClientInitTimeout)
 	defer cancel()
 
-	_, err = client.Initialize(ctx, mcp.InitializeRequest{
+	_, err := client.Initialize(ctx, mcp.InitializeRequest{
 		Params: mcp.InitializeParams{
 			ClientInfo: mcp.Implementation{
 				Name:    "mcp-host",
@@ -32,12 +34,14 @@ func newStdioMCPClient() (*MCPClient, error) {
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("initialize mcp (stdio): %w", err)
+		_ = client.Close()
+		return nil, nil, fmt.Errorf("initialize mcp (stdio): %w", err)
 	}
 
 	res, err := client.ListTools(ctx, mcp.ListToolsRequest{})
 	if err != nil {
-		return nil, fmt.Errorf("list tools: %w", err)
+		_ = client.Close()
+		return nil, nil, fmt.Errorf("list tools: %w", err)
 	}
-	return &MCPClient{Client: client, Tools: res.Tools}, nil
+	return client, res.Tools, nil
 }
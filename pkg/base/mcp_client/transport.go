@@ -0,0 +1,62 @@
+package mcp_client
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// cancelTransport mcp-go 的客户端在 ctx 取消时只是不再等待响应，这里补上
+// notifications/cancelled，让服务端停掉仍在执行的请求（工具超时、对话中止等）
+type cancelTransport struct {
+	transport.Interface
+}
+
+func withCancelNotify(t transport.Interface) transport.Interface {
+	return &cancelTransport{Interface: t}
+}
+
+func (t *cancelTransport) SendRequest(ctx context.Context, req transport.JSONRPCRequest) (*transport.JSONRPCResponse, error) {
+	resp, err := t.Interface.SendRequest(ctx, req)
+	// 规范不允许取消 initialize
+	if err != nil && ctx.Err() != nil && req.Method != string(mcp.MethodInitialize) {
+		go t.notifyCancelled(req.ID, context.Cause(ctx))
+	}
+	return resp, err
+}
+
+func (t *cancelTransport) notifyCancelled(id mcp.RequestId, reason error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constant.MCPCancelNotifyTimeout)
+	defer cancel()
+	err := t.SendNotification(ctx, mcp.JSONRPCNotification{
+		JSONRPC: mcp.JSONRPC_VERSION,
+		Notification: mcp.Notification{
+			Method: constant.MCPNotificationCancelled,
+			Params: mcp.NotificationParams{AdditionalFields: map[string]any{
+				"requestId": id,
+				"reason":    reason.Error(),
+			}},
+		},
+	})
+	if err != nil {
+		logger.Warnf("mcp: send cancelled notification for request %v failed: %v", id, err)
+	}
+}
+
+// SetRequestHandler mcpc.Client 通过类型断言使用该方法（sampling 等服务端请求），需转发给底层传输
+func (t *cancelTransport) SetRequestHandler(handler transport.RequestHandler) {
+	if b, ok := t.Interface.(transport.BidirectionalInterface); ok {
+		b.SetRequestHandler(handler)
+	}
+}
+
+// SetProtocolVersion 同上，Streamable HTTP 需要在请求头中携带协商出的协议版本
+func (t *cancelTransport) SetProtocolVersion(version string) {
+	if h, ok := t.Interface.(transport.HTTPConnection); ok {
+		h.SetProtocolVersion(version)
+	}
+}
@@ -0,0 +1,90 @@
+package mcp_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// inflightCalls 执行中的工具调用，收到 notifications/cancelled 时取消对应 handler 的 ctx。
+// mcp-go 既不处理该通知，也不把 JSON-RPC 请求 ID 交给 handler：由 BeforeCallTool 钩子
+// 把 ID 写进请求的 _meta，再由 handler 中间件按 会话+ID 登记
+type inflightCalls struct {
+	mu      sync.Mutex
+	cancels map[string]context.CancelCauseFunc
+}
+
+func newInflightCalls() *inflightCalls {
+	return &inflightCalls{cancels: make(map[string]context.CancelCauseFunc)}
+}
+
+// callKey 请求 ID 只在会话内唯一；JSON 解码后数字 ID 均为 float64，两侧格式化结果一致
+func callKey(ctx context.Context, id any) string {
+	sessionID := ""
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		sessionID = session.SessionID()
+	}
+	return fmt.Sprintf("%s/%v", sessionID, id)
+}
+
+// tagRequestID BeforeCallTool 钩子
+func (c *inflightCalls) tagRequestID(_ context.Context, id any, req *mcp.CallToolRequest) {
+	if req.Params.Meta == nil {
+		req.Params.Meta = &mcp.Meta{}
+	}
+	if req.Params.Meta.AdditionalFields == nil {
+		req.Params.Meta.AdditionalFields = make(map[string]any)
+	}
+	req.Params.Meta.AdditionalFields[constant.MCPMetaRequestID] = id
+}
+
+// middleware 为每次调用派生可取消的 ctx，调用结束后注销
+func (c *inflightCalls) middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var id any
+		if req.Params.Meta != nil {
+			id = req.Params.Meta.AdditionalFields[constant.MCPMetaRequestID]
+		}
+		if id == nil {
+			return next(ctx, req)
+		}
+
+		ctx, cancel := context.WithCancelCause(ctx)
+		defer cancel(nil)
+		key := callKey(ctx, id)
+		c.mu.Lock()
+		c.cancels[key] = cancel
+		c.mu.Unlock()
+		defer func() {
+			c.mu.Lock()
+			delete(c.cancels, key)
+			c.mu.Unlock()
+		}()
+		return next(ctx, req)
+	}
+}
+
+// onCancelled notifications/cancelled 的处理：请求已结束或 ID 未知时忽略
+func (c *inflightCalls) onCancelled(ctx context.Context, n mcp.JSONRPCNotification) {
+	id := n.Params.AdditionalFields["requestId"]
+	if id == nil {
+		return
+	}
+	reason, _ := n.Params.AdditionalFields["reason"].(string)
+	key := callKey(ctx, id)
+	c.mu.Lock()
+	cancel, ok := c.cancels[key]
+	c.mu.Unlock()
+	if !ok {
+		return
+	}
+	logger.Infof("mcp_server: request %v cancelled by client: %s", id, reason)
+	cancel(errors.New("cancelled by client: " + reason))
+}
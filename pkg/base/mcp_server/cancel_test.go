@@ -0,0 +1,57 @@
+package mcp_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+func Test_inflightCalls(t *testing.T) {
+	Convey("Test notifications/cancelled stops the matching call", t, func() {
+		calls := newInflightCalls()
+		started := make(chan struct{})
+		handler := calls.middleware(func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			close(started)
+			<-ctx.Done()
+			return mcp.NewToolResultError(context.Cause(ctx).Error()), nil
+		})
+
+		req := mcp.CallToolRequest{}
+		calls.tagRequestID(context.Background(), float64(7), &req)
+		done := make(chan *mcp.CallToolResult, 1)
+		go func() {
+			res, _ := handler(context.Background(), req)
+			done <- res
+		}()
+		<-started
+
+		cancelled := func(id any) mcp.JSONRPCNotification {
+			n := mcp.JSONRPCNotification{}
+			n.Method = constant.MCPNotificationCancelled
+			n.Params.AdditionalFields = map[string]any{"requestId": id, "reason": "timeout"}
+			return n
+		}
+		// 其他请求的取消不影响该调用
+		calls.onCancelled(context.Background(), cancelled(float64(8)))
+		select {
+		case <-done:
+			So("call cancelled by another request id", ShouldBeEmpty)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		calls.onCancelled(context.Background(), cancelled(float64(7)))
+		select {
+		case res := <-done:
+			So(res.IsError, ShouldBeTrue)
+			So(res.Content[0].(mcp.TextContent).Text, ShouldEqual, "cancelled by client: timeout")
+		case <-time.After(5 * time.Second):
+			So("call was not cancelled", ShouldBeEmpty)
+		}
+		So(calls.cancels, ShouldBeEmpty)
+	})
+}
@@ -1,15 +1,25 @@
 package mcp_server
 
 import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
 	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/lifecycle"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/prompt_set"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/consul"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/etcd"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/nacos"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/resource_set"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_set"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"github.com/FantasyRL/go-mcp-demo/pkg/utils"
 	"github.com/google/uuid"
+	"strconv"
 	"time"
 
 	"github.com/mark3labs/mcp-go/server"
@@ -24,13 +34,29 @@ type HTTPOpts struct {
 }
 
 // NewCoreServer 在此注册 tools/prompts/resources
-func NewCoreServer(name, version string, toolSet *tool_set.ToolSet, promptSet *prompt_set.PromptSet) *server.MCPServer {
+func NewCoreServer(
+	name, version string,
+	toolSet *tool_set.ToolSet,
+	promptSet *prompt_set.PromptSet,
+	resourceSet *resource_set.ResourceSet,
+) *server.MCPServer {
+	// 调用方放弃请求时发来 notifications/cancelled，据此取消仍在执行的工具
+	inflight := newInflightCalls()
+	hooks := &server.Hooks{}
+	hooks.AddBeforeCallTool(inflight.tagRequestID)
+
 	s := server.NewMCPServer(
 		name,
 		version,
 		server.WithRecovery(),
-		server.WithToolCapabilities(false),
+		// 声明 tools.listChanged，运行时增删工具会通知已连接的 Host
+		server.WithToolCapabilities(true),
+		server.WithPromptCapabilities(true),
+		server.WithResourceCapabilities(false, true),
+		server.WithHooks(hooks),
+		server.WithToolHandlerMiddleware(inflight.middleware),
 	)
+	s.AddNotificationHandler(constant.MCPNotificationCancelled, inflight.onCancelled)
 
 	if toolSet != nil {
 		for _, t := range toolSet.Tools {
@@ -42,34 +68,122 @@ func NewCoreServer(name, version string, toolSet *tool_set.ToolSet, promptSet *p
 			s.AddPrompt(*p, promptSet.HandlerFunc[p.Name])
 		}
 	}
+	if resourceSet != nil {
+		for _, r := range resourceSet.Resources {
+			s.AddResource(*r, resourceSet.HandlerFunc[r.URI])
+		}
+		for _, t := range resourceSet.Templates {
+			s.AddResourceTemplate(*t, resourceSet.TemplateHandlerFunc[t.URITemplate.Raw()])
+		}
+	}
 
 	return s
 }
 
 // NewStreamableHTTPServer 基于核心 Server 创建StreamableHTTP服务器组件
-func NewStreamableHTTPServer(core *server.MCPServer, serviceName string, addr string) *server.StreamableHTTPServer {
+func NewStreamableHTTPServer(core *server.MCPServer) *server.StreamableHTTPServer {
+	var httpOpts []server.StreamableHTTPOption
+	httpOpts = append(httpOpts, server.WithHeartbeatInterval(constant.MCPServerHeartbeatInterval))
+	return server.NewStreamableHTTPServer(core, httpOpts...)
+}
+
+// ServeStreamableHTTP 在 addr 上提供 Streamable HTTP 服务，开始监听后注册到注册中心，关闭流程交给 lc：
+// 先注销，再停止接收新请求，排空进行中的工具调用；GET 建立的 SSE 通知流在关闭开始时即断开，由 Host 重连到其他实例
+func ServeStreamableHTTP(lc *lifecycle.Manager, core *server.MCPServer, serviceName string, addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(constant.RegistryMCPDefaultPath, drainHandler(lc, NewStreamableHTTPServer(core)))
+	srv := &http.Server{
+		Handler:     mux,
+		BaseContext: func(net.Listener) context.Context { return lc.Context() },
+	}
+	lc.Go(func() error { return srv.Serve(ln) })
+	lc.OnDrain(srv.Shutdown)
+
+	deregister, err := register(serviceName, addr)
+	if err != nil {
+		_ = srv.Close()
+		return err
+	}
+	if deregister != nil {
+		lc.OnDeregister(deregister)
+	}
+	lc.SetReady()
+	return nil
+}
+
+// drainHandler 工具调用等请求登记到 lc，关闭时等待其完成；关闭开始后的新请求返回 503
+func drainHandler(lc *lifecycle.Manager, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			ctx, cancel := lc.UntilDraining(r.Context())
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+		ctx, release, err := lc.Track(r.Context())
+		if err != nil {
+			w.Header().Set("Retry-After", "1")
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		defer release()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// register 按 registry.provider 注册实例，不使用注册中心时返回的 deregister 为 nil
+func register(serviceName string, addr string) (func() error, error) {
+	registrar := newRegistrar(serviceName)
+	if registrar == nil {
+		return nil, nil
+	}
+	id, _ := uuid.NewV7()
+	deregister, err := registrar.Register(context.Background(), &registry.Registration{
+		Service: serviceName,
+		ID:      id.String(),
+		Address: addr,
+		Port:    utils.AddrGetPort(addr),
+		Tags:    []string{constant.RegistryMCPTag},
+		Meta: map[string]string{
+			constant.RegistryMetaAddr:   addr,
+			constant.RegistryMetaWeight: strconv.Itoa(max(config.Registry.Weight, 1)),
+		},
+		Path: constant.RegistryMCPDefaultPath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("mcp_server: %s register failed: %w", config.Registry.Provider, err)
+	}
+	logger.Infof("%s : registered to %s successfully on %s", serviceName, config.Registry.Provider, addr)
+	return deregister, nil
+}
+
+// newRegistrar 按 registry.provider 创建注册器；不使用注册中心时返回 nil，所选注册中心配置无效时 panic
+func newRegistrar(serviceName string) registry.Registrar {
+	var registrar registry.Registrar
 	switch config.Registry.Provider {
 	case constant.RegistryProviderConsul:
-		registrar := consul.NewRegistrar(serviceName)
-		id, _ := uuid.NewV7()
-		_, err := registrar.Register(&registry.Registration{
-			Service: serviceName,
-			ID:      id.String(),
-			Address: addr,
-			Port:    utils.AddrGetPort(addr),
-			Tags:    []string{constant.RegistryMCPTag},
-			Meta:    map[string]string{"addr": addr},
-			Path:    constant.RegistryMCPDefaultPath,
-		})
-		if err != nil {
-			panic("mcp_server: consul register failed, err: " + err.Error())
+		if r := consul.NewRegistrar(serviceName); r != nil {
+			registrar = r
+		}
+	case constant.RegistryProviderEtcd:
+		if r := etcd.NewRegistrar(); r != nil {
+			registrar = r
+		}
+	case constant.RegistryProviderNacos:
+		if r := nacos.NewRegistrar(); r != nil {
+			registrar = r
 		}
-		logger.Infof("%s : registered to consul successfully on %s", serviceName, addr)
 	default:
+		return nil
 	}
-	var httpOpts []server.StreamableHTTPOption
-	httpOpts = append(httpOpts, server.WithHeartbeatInterval(constant.MCPServerHeartbeatInterval))
-	return server.NewStreamableHTTPServer(core, httpOpts...)
+	if registrar == nil {
+		panic("mcp_server: invalid " + config.Registry.Provider + " registry config")
+	}
+	return registrar
 }
 
 // ServeStdio stdio
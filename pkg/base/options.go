@@ -5,16 +5,25 @@ import (
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/ai_provider"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/cache"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/db"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/lifecycle"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/mcp_client"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/consul"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/etcd"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/nacos"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry/static"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_policy"
 	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"log"
+	"slices"
 )
 
 // WithMCPClient 通过配置手动注入初始化 ClientSet.MCPCli。
 // - stdio: 直接创建单连接客户端（本地进程/stdio）
 // - none(单点): 使用 config.MCP.HTTP.BaseURL 创建单连接客户端
-// - consul: 创建聚合客户端（基于 Consul Resolver 定时刷新，自动发现多实例）
+// - consul/etcd/nacos: 创建聚合客户端（基于注册中心 Resolver 定时刷新，自动发现多实例；etcd 另有 Watch 即时刷新）
+// - static: 创建聚合客户端（基于配置/文件中的固定服务列表，文件变更时热更新）
 func WithMCPClient(services []string) Option {
 	return func(clientSet *ClientSet) {
 		switch {
@@ -37,16 +46,43 @@ func WithMCPClient(services []string) Option {
 			}
 			clientSet.MCPCli = mcpCli
 
-		// 服务发现（Consul）：使用聚合客户端，多路连接 + 定时刷新
-		case config.Registry.Provider == constant.RegistryProviderConsul:
-			resolver := consul.NewResolver()
-			if resolver == nil {
-				log.Fatalf("consul config invalid, can't create MCP client")
+		// 服务发现（Consul/etcd/Nacos）：使用聚合客户端，多路连接 + 定时刷新
+		case slices.Contains(discoveryProviders, config.Registry.Provider):
+			discovery := newDiscoveryResolver()
+			if discovery == nil {
+				log.Fatalf("%s config invalid, can't create MCP client", config.Registry.Provider)
+			}
+			resolver := discovery
+			// 静态配置的外部服务（如 fzuhelper）与注册中心发现的实例一并聚合
+			staticResolver := static.NewResolver()
+			if staticResolver != nil {
+				resolver = registry.Merge(discovery, staticResolver)
 			}
 			ac := mcp_client.NewAggregatedClient(resolver, services) // 可按需调整刷新周期
 			clientSet.RegistryResolver = resolver
 			clientSet.MCPCli = ac
 			clientSet.cleanups = append(clientSet.cleanups, ac.Close)
+			// 合并后的 Resolver 持有转发变更通知的 goroutine，静态列表持有文件监听
+			if staticResolver != nil {
+				merged := resolver.(interface{ Close() error })
+				clientSet.cleanups = append(clientSet.cleanups, func() { _ = merged.Close() }, func() { _ = staticResolver.Close() })
+			}
+			// etcd 的 Resolver 持有连接与 Watch，在聚合客户端之后关闭
+			if c, ok := discovery.(interface{ Close() error }); ok {
+				clientSet.cleanups = append(clientSet.cleanups, func() { _ = c.Close() })
+			}
+
+		// 静态列表：聚合配置（及文件）中的多个服务，无需运行 Consul
+		case config.Registry.Provider == constant.RegistryProviderStatic:
+			resolver := static.NewResolver()
+			if resolver == nil {
+				log.Fatalf("registry provider is 'static' but registry.static has neither services nor file")
+			}
+			ac := mcp_client.NewAggregatedClient(resolver, services)
+			clientSet.RegistryResolver = resolver
+			clientSet.MCPCli = ac
+			clientSet.cleanups = append(clientSet.cleanups, ac.Close)
+			clientSet.cleanups = append(clientSet.cleanups, func() { _ = resolver.Close() })
 
 		default:
 			log.Fatalf("unknown registry provider: %s, can't create MCP client", config.Registry.Provider)
@@ -54,13 +90,56 @@ func WithMCPClient(services []string) Option {
 	}
 }
 
+// discoveryProviders 通过注册中心发现 MCP 服务的 registry.provider
+var discoveryProviders = []string{
+	constant.RegistryProviderConsul,
+	constant.RegistryProviderEtcd,
+	constant.RegistryProviderNacos,
+}
+
+// newDiscoveryResolver 按 registry.provider 创建注册中心的 Resolver，配置无效时返回 nil
+func newDiscoveryResolver() registry.Resolver {
+	switch config.Registry.Provider {
+	case constant.RegistryProviderConsul:
+		if r := consul.NewResolver(); r != nil {
+			return r
+		}
+	case constant.RegistryProviderEtcd:
+		if r := etcd.NewResolver(); r != nil {
+			return r
+		}
+	case constant.RegistryProviderNacos:
+		if r := nacos.NewResolver(); r != nil {
+			return r
+		}
+	}
+	return nil
+}
+
 func WithAiProviderClient() Option {
 	return func(clientSet *ClientSet) {
 		cli := ai_provider.NewAiProviderClient()
+		if cli != nil && clientSet.Cache != nil {
+			cli.UseCache(clientSet.Cache)
+		}
 		clientSet.AiProviderCli = cli
 	}
 }
 
+// WithToolPolicy 创建工具策略引擎，规则由 Host 从配置与数据库中载入
+func WithToolPolicy() Option {
+	return func(clientSet *ClientSet) {
+		clientSet.ToolPolicy = tool_policy.NewEngine()
+	}
+}
+
+// WithLifecycle 注入进程生命周期，后台生成等进行中的工作登记到它，关闭时排空
+func WithLifecycle(lc *lifecycle.Manager) Option {
+	return func(clientSet *ClientSet) {
+		clientSet.Lifecycle = lc
+	}
+}
+
 func WithDB() Option {
 	return func(clientSet *ClientSet) {
 		actualDB, err := db.InitDBClient()
@@ -68,6 +147,15 @@ func WithDB() Option {
 			log.Fatalf("failed to initialize gorm db: %s", err)
 		}
 		clientSet.ActualDB = actualDB
+		clientSet.cleanups = append(clientSet.cleanups, func() {
+			sqlDB, err := actualDB.DB()
+			if err == nil {
+				err = sqlDB.Close()
+			}
+			if err != nil {
+				logger.Errorf("failed to close db: %v", err)
+			}
+		})
 	}
 }
 
@@ -78,5 +166,14 @@ func WithCache() Option {
 			log.Fatalf("failed to initialize cache client: %s", err)
 		}
 		clientSet.Cache = cacheClient
+		clientSet.cleanups = append(clientSet.cleanups, func() {
+			if err := cacheClient.Close(); err != nil {
+				logger.Errorf("failed to close cache client: %v", err)
+			}
+		})
+		// 模型响应缓存复用同一个 Redis 客户端，与 Option 的先后顺序无关
+		if clientSet.AiProviderCli != nil {
+			clientSet.AiProviderCli.UseCache(cacheClient)
+		}
 	}
 }
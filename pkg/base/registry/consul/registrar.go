@@ -1,6 +1,7 @@
 package consul
 
 import (
+	"context"
 	"fmt"
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
@@ -29,7 +30,7 @@ func NewRegistrar(serviceName string) *Registrar {
 }
 
 // Register 注册服务实例，用于mcp_server将自己注册到consul
-func (r *Registrar) Register(reg *registry.Registration) (func() error, error) {
+func (r *Registrar) Register(ctx context.Context, reg *registry.Registration) (func() error, error) {
 	// 创建 Consul 客户端配置
 	conf := api.DefaultConfig()
 	conf.Address = r.cfg.Address
@@ -71,7 +72,7 @@ func (r *Registrar) Register(reg *registry.Registration) (func() error, error) {
 			DeregisterCriticalServiceAfter: deregister.String(),
 		},
 	}
-	if err := cl.Agent().ServiceRegister(asr); err != nil {
+	if err := cl.Agent().ServiceRegisterOpts(asr, api.ServiceRegisterOpts{}.WithContext(ctx)); err != nil {
 		return nil, fmt.Errorf("consul register: %w", err)
 	}
 	return func() error {
@@ -2,6 +2,8 @@ package consul
 
 import (
 	"fmt"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"strings"
 
@@ -31,7 +33,7 @@ func NewResolver() *Resolver {
 }
 
 // Resolve 返回所有通过健康检查的实例 URL
-func (r *Resolver) Resolve(services []string) (map[string][]string, error) {
+func (r *Resolver) Resolve(services []string) (map[string][]registry.Instance, error) {
 	// 基本校验
 	if len(services) == 0 {
 		return nil, fmt.Errorf("no Services provided")
@@ -59,7 +61,7 @@ func (r *Resolver) Resolve(services []string) (map[string][]string, error) {
 		Token:      r.cfg.Token,
 	}
 	// 结果集合与去重
-	out := make(map[string][]string)
+	out := make(map[string][]registry.Instance)
 
 	// 依次查询每个服务名
 	for _, svc := range services {
@@ -74,8 +76,12 @@ func (r *Resolver) Resolve(services []string) (map[string][]string, error) {
 		for _, inst := range entries {
 			// 使用注册时写入的完整 addr
 			if inst.Service != nil && inst.Service.Meta != nil {
-				if u := strings.TrimSpace(inst.Service.Meta["addr"]); u != "" {
-					out[svc] = append(out[svc], u)
+				if u := strings.TrimSpace(inst.Service.Meta[constant.RegistryMetaAddr]); u != "" {
+					out[svc] = append(out[svc], registry.Instance{
+						Addr:   u,
+						Weight: registry.ParseWeight(inst.Service.Meta[constant.RegistryMetaWeight]),
+						Meta:   inst.Service.Meta,
+					})
 				} else {
 					logger.Errorf("consul: service %s no metadata", inst.Service.Service)
 				}
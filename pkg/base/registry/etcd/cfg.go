@@ -0,0 +1,69 @@
+package etcd
+
+import (
+	"path"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+type EtcdConfig struct {
+	Endpoints   []string      // ["127.0.0.1:2379"]
+	Username    string        // 可空
+	Password    string        // 可空
+	Prefix      string        // 实例键前缀，默认 /go-mcp-demo/services
+	DialTimeout time.Duration // 默认 5s
+	LeaseTTL    time.Duration // 默认 15s
+}
+
+// newConfig 读取配置并补全默认值，未配置 endpoints 时返回 nil
+func newConfig() *EtcdConfig {
+	cfg := &EtcdConfig{
+		Endpoints:   config.Registry.Etcd.Endpoints,
+		Username:    config.Registry.Etcd.Username,
+		Password:    config.Registry.Etcd.Password,
+		Prefix:      config.Registry.Etcd.Prefix,
+		DialTimeout: config.Registry.Etcd.DialTimeout,
+		LeaseTTL:    config.Registry.Etcd.LeaseTTL,
+	}
+	if len(cfg.Endpoints) == 0 {
+		return nil
+	}
+	if cfg.Prefix == "" {
+		cfg.Prefix = constant.RegistryEtcdDefaultPrefix
+	}
+	if cfg.DialTimeout <= 0 {
+		cfg.DialTimeout = constant.RegistryEtcdDefaultDialTimeout
+	}
+	if cfg.LeaseTTL <= 0 {
+		cfg.LeaseTTL = constant.RegistryDeregisterAfter
+	}
+	return cfg
+}
+
+func (c *EtcdConfig) newClient() (*clientv3.Client, error) {
+	return clientv3.New(clientv3.Config{
+		Endpoints:   c.Endpoints,
+		DialTimeout: c.DialTimeout,
+		Username:    c.Username,
+		Password:    c.Password,
+	})
+}
+
+// instanceKey 实例键：前缀/服务名/实例ID
+func (c *EtcdConfig) instanceKey(service, id string) string {
+	return path.Join(c.Prefix, service, id)
+}
+
+// record 实例键的值
+type record struct {
+	ID      string            `json:"id"`
+	Service string            `json:"service"`
+	Address string            `json:"address"`
+	Port    int               `json:"port"`
+	Tags    []string          `json:"tags,omitempty"`
+	Meta    map[string]string `json:"meta,omitempty"`
+}
@@ -0,0 +1,122 @@
+package etcd
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/server/v3/embed"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+func freeURL(t *testing.T) url.URL {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return url.URL{Scheme: "http", Host: l.Addr().String()}
+}
+
+// startEtcd 启动单节点的内嵌 etcd，返回客户端地址
+func startEtcd(t *testing.T) string {
+	cfg := embed.NewConfig()
+	cfg.Dir = t.TempDir()
+	cfg.LogLevel = "error"
+	client, peer := freeURL(t), freeURL(t)
+	cfg.ListenClientUrls, cfg.AdvertiseClientUrls = []url.URL{client}, []url.URL{client}
+	cfg.ListenPeerUrls, cfg.AdvertisePeerUrls = []url.URL{peer}, []url.URL{peer}
+	cfg.InitialCluster = cfg.Name + "=" + peer.String()
+	e, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(e.Close)
+	select {
+	case <-e.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.Fatal("embedded etcd not ready")
+	}
+	return client.Host
+}
+
+// eventually 轮询 cond 直到成立或超时
+func eventually(cond func() bool) bool {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	return false
+}
+
+func Test_EtcdRegistry(t *testing.T) {
+	endpoint := startEtcd(t)
+	Convey("Test etcd registrar and resolver", t, func() {
+		cfg := &EtcdConfig{
+			Endpoints:   []string{endpoint},
+			Prefix:      "/test/" + t.Name(),
+			DialTimeout: 5 * time.Second,
+			LeaseTTL:    2 * time.Second,
+		}
+		resolver := newResolver(cfg)
+		So(resolver, ShouldNotBeNil)
+		defer resolver.Close()
+		services := []string{constant.ServiceNameMCPLocal}
+		resolved := func() []registry.Instance {
+			out, _ := resolver.Resolve(services)
+			return out[constant.ServiceNameMCPLocal]
+		}
+
+		deregister, err := (&Registrar{cfg: cfg}).Register(context.Background(), &registry.Registration{
+			Service: constant.ServiceNameMCPLocal,
+			ID:      "instance-1",
+			Address: "127.0.0.1:10002",
+			Port:    10002,
+			Meta: map[string]string{
+				constant.RegistryMetaAddr:   "127.0.0.1:10002",
+				constant.RegistryMetaWeight: "2",
+			},
+		})
+		So(err, ShouldBeNil)
+
+		So(eventually(func() bool { return len(resolved()) == 1 }), ShouldBeTrue)
+		So(resolved()[0].Addr, ShouldEqual, "127.0.0.1:10002")
+		So(resolved()[0].Weight, ShouldEqual, 2)
+
+		Convey("a lost lease is re-registered", func() {
+			cli, err := cfg.newClient()
+			So(err, ShouldBeNil)
+			defer cli.Close()
+			key := cfg.instanceKey(constant.ServiceNameMCPLocal, "instance-1")
+			resp, err := cli.Get(context.Background(), key)
+			So(err, ShouldBeNil)
+			So(resp.Kvs, ShouldHaveLength, 1)
+			lost := clientv3.LeaseID(resp.Kvs[0].Lease)
+			_, err = cli.Revoke(context.Background(), lost)
+			So(err, ShouldBeNil)
+
+			So(eventually(func() bool {
+				resp, err := cli.Get(context.Background(), key)
+				return err == nil && len(resp.Kvs) == 1 && clientv3.LeaseID(resp.Kvs[0].Lease) != lost
+			}), ShouldBeTrue)
+			So(eventually(func() bool { return len(resolved()) == 1 }), ShouldBeTrue)
+			So(deregister(), ShouldBeNil)
+		})
+
+		Convey("deregister removes the instance", func() {
+			So(deregister(), ShouldBeNil)
+			So(eventually(func() bool { return len(resolved()) == 0 }), ShouldBeTrue)
+			_, err := resolver.Resolve(services)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,136 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+type Registrar struct {
+	cfg *EtcdConfig
+}
+
+func NewRegistrar() *Registrar {
+	cfg := newConfig()
+	if cfg == nil {
+		return nil
+	}
+	return &Registrar{cfg: cfg}
+}
+
+// Register 以租约写入实例键并持续续约。续约中断（etcd 重启、网络分区导致租约过期）时重新注册；
+// 返回的 deregister 停止续约并撤销租约，实例键随之删除
+func (r *Registrar) Register(ctx context.Context, reg *registry.Registration) (func() error, error) {
+	cli, err := r.cfg.newClient()
+	if err != nil {
+		return nil, fmt.Errorf("etcd client: %w", err)
+	}
+	ttl := reg.DeregisterAfter
+	if ttl <= 0 {
+		ttl = r.cfg.LeaseTTL
+	}
+	value, err := json.Marshal(&record{
+		ID:      reg.ID,
+		Service: reg.Service,
+		Address: reg.Address,
+		Port:    reg.Port,
+		Tags:    reg.Tags,
+		Meta:    reg.Meta,
+	})
+	if err != nil {
+		_ = cli.Close()
+		return nil, err
+	}
+	s := &lease{cli: cli, key: r.cfg.instanceKey(reg.Service, reg.ID), value: string(value), ttl: ttl}
+	if err = s.grant(ctx); err != nil {
+		_ = cli.Close()
+		return nil, err
+	}
+
+	keepCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go s.keepAlive(keepCtx, done)
+
+	var (
+		once      sync.Once
+		revokeErr error
+	)
+	return func() error {
+		once.Do(func() {
+			cancel()
+			<-done
+			revokeCtx, cancelRevoke := context.WithTimeout(context.Background(), r.cfg.DialTimeout)
+			defer cancelRevoke()
+			if _, err := cli.Revoke(revokeCtx, s.id()); err != nil {
+				revokeErr = fmt.Errorf("etcd revoke %s: %w", s.key, err)
+			}
+			_ = cli.Close()
+		})
+		return revokeErr
+	}, nil
+}
+
+// lease 一个实例键及其租约
+type lease struct {
+	cli     *clientv3.Client
+	key     string
+	value   string
+	ttl     time.Duration
+	leaseID atomic.Int64
+}
+
+func (l *lease) id() clientv3.LeaseID {
+	return clientv3.LeaseID(l.leaseID.Load())
+}
+
+// grant 申请新租约并写入实例键
+func (l *lease) grant(ctx context.Context) error {
+	resp, err := l.cli.Grant(ctx, int64(max(l.ttl/time.Second, 1)))
+	if err != nil {
+		return fmt.Errorf("etcd grant lease: %w", err)
+	}
+	if _, err = l.cli.Put(ctx, l.key, l.value, clientv3.WithLease(resp.ID)); err != nil {
+		return fmt.Errorf("etcd put %s: %w", l.key, err)
+	}
+	l.leaseID.Store(int64(resp.ID))
+	return nil
+}
+
+// keepAlive 续约直到 ctx 取消；续约通道关闭说明租约已失效，立即重新注册，失败时按检查间隔重试
+func (l *lease) keepAlive(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+	for {
+		ch, err := l.cli.KeepAlive(ctx, l.id())
+		if err == nil {
+			for range ch {
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Warnf("etcd: keepalive of %s lost, re-register", l.key)
+		for {
+			grantCtx, cancel := context.WithTimeout(ctx, constant.RegistryEtcdDefaultDialTimeout)
+			err = l.grant(grantCtx)
+			cancel()
+			if err == nil {
+				break
+			}
+			logger.Errorf("etcd: re-register %s failed: %v", l.key, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(constant.RegistryCheckInterval):
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// Resolver 启动后先读取前缀下的全部实例，之后通过 Watch 增量维护本地缓存，Resolve 只读缓存；
+// 缓存变化时从 Changes 发出信号，聚合客户端据此立即刷新
+type Resolver struct {
+	cfg *EtcdConfig
+	cli *clientv3.Client
+
+	mu      sync.RWMutex
+	synced  bool
+	records map[string]record // 键 -> 实例
+	changes chan struct{}
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewResolver() *Resolver {
+	cfg := newConfig()
+	if cfg == nil {
+		return nil
+	}
+	return newResolver(cfg)
+}
+
+func newResolver(cfg *EtcdConfig) *Resolver {
+	cli, err := cfg.newClient()
+	if err != nil {
+		logger.Errorf("etcd client: %v", err)
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &Resolver{
+		cfg:     cfg,
+		cli:     cli,
+		records: make(map[string]record),
+		changes: make(chan struct{}, 1),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go r.run(ctx)
+	return r
+}
+
+// run 全量同步后从下一个版本开始 Watch；Watch 出错（如版本已被压缩）时重新全量同步
+func (r *Resolver) run(ctx context.Context) {
+	defer close(r.done)
+	prefix := r.cfg.Prefix + "/"
+	for ctx.Err() == nil {
+		rev, err := r.sync(ctx, prefix)
+		if err != nil {
+			logger.Errorf("etcd: sync %s failed: %v", prefix, err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(constant.RegistryCheckInterval):
+			}
+			continue
+		}
+		watchCtx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+		for resp := range r.cli.Watch(watchCtx, prefix, clientv3.WithPrefix(), clientv3.WithRev(rev+1)) {
+			if err = resp.Err(); err != nil {
+				logger.Warnf("etcd: watch %s: %v, resync", prefix, err)
+				break
+			}
+			r.apply(resp.Events)
+		}
+		cancel()
+	}
+}
+
+func (r *Resolver) sync(ctx context.Context, prefix string) (int64, error) {
+	getCtx, cancel := context.WithTimeout(ctx, r.cfg.DialTimeout)
+	defer cancel()
+	resp, err := r.cli.Get(getCtx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	records := make(map[string]record, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		if rec, ok := decode(kv.Key, kv.Value); ok {
+			records[string(kv.Key)] = rec
+		}
+	}
+	r.mu.Lock()
+	r.records, r.synced = records, true
+	r.mu.Unlock()
+	r.notify()
+	return resp.Header.Revision, nil
+}
+
+func (r *Resolver) apply(events []*clientv3.Event) {
+	r.mu.Lock()
+	for _, ev := range events {
+		key := string(ev.Kv.Key)
+		if ev.Type == clientv3.EventTypeDelete {
+			delete(r.records, key)
+			continue
+		}
+		if rec, ok := decode(ev.Kv.Key, ev.Kv.Value); ok {
+			r.records[key] = rec
+		}
+	}
+	r.mu.Unlock()
+	r.notify()
+}
+
+func decode(key, value []byte) (record, bool) {
+	var rec record
+	if err := json.Unmarshal(value, &rec); err != nil || rec.Service == "" {
+		logger.Errorf("etcd: invalid instance %s: %s", key, value)
+		return rec, false
+	}
+	return rec, true
+}
+
+func (r *Resolver) notify() {
+	select {
+	case r.changes <- struct{}{}:
+	default:
+	}
+}
+
+// Resolve 返回缓存中各服务的实例，按键排序
+func (r *Resolver) Resolve(services []string) (map[string][]registry.Instance, error) {
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no Services provided")
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if !r.synced {
+		return nil, fmt.Errorf("etcd: instances under %s not synced yet", r.cfg.Prefix)
+	}
+
+	keys := make([]string, 0, len(r.records))
+	for key := range r.records {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	out := make(map[string][]registry.Instance)
+	for _, svc := range services {
+		for _, key := range keys {
+			rec := r.records[key]
+			if rec.Service != svc {
+				continue
+			}
+			addr := strings.TrimSpace(rec.Meta[constant.RegistryMetaAddr])
+			if addr == "" {
+				addr = net.JoinHostPort(rec.Address, strconv.Itoa(rec.Port))
+			}
+			out[svc] = append(out[svc], registry.Instance{
+				Addr:   addr,
+				Weight: registry.ParseWeight(rec.Meta[constant.RegistryMetaWeight]),
+				Meta:   rec.Meta,
+			})
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("etcd: no instances for %v", services)
+	}
+	return out, nil
+}
+
+// Changes 缓存变化后发出信号
+func (r *Resolver) Changes() <-chan struct{} {
+	return r.changes
+}
+
+// Close 停止 Watch 并关闭连接
+func (r *Resolver) Close() error {
+	r.cancel()
+	<-r.done
+	return r.cli.Close()
+}
@@ -0,0 +1,32 @@
+package nacos
+
+import (
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+type NacosConfig struct {
+	Address   string // http://127.0.0.1:8848
+	Namespace string // 命名空间 ID，可空
+	Group     string // 默认 DEFAULT_GROUP
+	Username  string // 开启鉴权时填写
+	Password  string // 开启鉴权时填写
+}
+
+// newConfig 读取配置并补全默认值，未配置 address 时返回 nil
+func newConfig() *NacosConfig {
+	cfg := &NacosConfig{
+		Address:   config.Registry.Nacos.Address,
+		Namespace: config.Registry.Nacos.Namespace,
+		Group:     config.Registry.Nacos.Group,
+		Username:  config.Registry.Nacos.Username,
+		Password:  config.Registry.Nacos.Password,
+	}
+	if cfg.Address == "" {
+		return nil
+	}
+	if cfg.Group == "" {
+		cfg.Group = constant.RegistryNacosDefaultGroup
+	}
+	return cfg
+}
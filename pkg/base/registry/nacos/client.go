@@ -0,0 +1,174 @@
+package nacos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// nacosCodeNotFound 心跳时服务端已没有该实例（心跳中断过久被剔除），需要重新注册
+const nacosCodeNotFound = 20404
+
+// client Nacos Open API（v1）的最小封装：注册、注销、心跳与查询实例，开启鉴权时自动登录
+type client struct {
+	cfg  *NacosConfig
+	http *resty.Client
+
+	mu          sync.Mutex
+	token       string
+	tokenExpire time.Time
+}
+
+func newClient(cfg *NacosConfig) *client {
+	return &client{
+		cfg:  cfg,
+		http: resty.New().SetBaseURL(strings.TrimRight(cfg.Address, "/")).SetTimeout(constant.RegistryNacosRequestTimeout),
+	}
+}
+
+// instance Nacos 中的一个实例
+type instance struct {
+	IP       string            `json:"ip"`
+	Port     int               `json:"port"`
+	Weight   float64           `json:"weight"`
+	Healthy  bool              `json:"healthy"`
+	Enabled  bool              `json:"enabled"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+func (c *client) register(ctx context.Context, service string, inst *instance) error {
+	metadata, err := json.Marshal(inst.Metadata)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(ctx, resty.MethodPost, "/nacos/v1/ns/instance", map[string]string{
+		"serviceName": service,
+		"ip":          inst.IP,
+		"port":        strconv.Itoa(inst.Port),
+		"weight":      strconv.FormatFloat(inst.Weight, 'f', -1, 64),
+		"metadata":    string(metadata),
+		"enabled":     "true",
+		"healthy":     "true",
+		"ephemeral":   "true",
+	})
+	return err
+}
+
+func (c *client) deregister(ctx context.Context, service string, inst *instance) error {
+	_, err := c.do(ctx, resty.MethodDelete, "/nacos/v1/ns/instance", map[string]string{
+		"serviceName": service,
+		"ip":          inst.IP,
+		"port":        strconv.Itoa(inst.Port),
+		"ephemeral":   "true",
+	})
+	return err
+}
+
+// beat 发送心跳，返回 false 表示服务端已没有该实例
+func (c *client) beat(ctx context.Context, service string, inst *instance) (bool, error) {
+	beat, err := json.Marshal(map[string]any{
+		"serviceName": c.cfg.Group + "@@" + service,
+		"ip":          inst.IP,
+		"port":        inst.Port,
+		"weight":      inst.Weight,
+		"metadata":    inst.Metadata,
+	})
+	if err != nil {
+		return false, err
+	}
+	body, err := c.do(ctx, resty.MethodPut, "/nacos/v1/ns/instance/beat", map[string]string{
+		"serviceName": service,
+		"beat":        string(beat),
+	})
+	if err != nil {
+		return false, err
+	}
+	var resp struct {
+		Code int `json:"code"`
+	}
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return false, fmt.Errorf("nacos beat: %w", err)
+	}
+	return resp.Code != nacosCodeNotFound, nil
+}
+
+// list 查询服务的健康实例
+func (c *client) list(ctx context.Context, service string) ([]instance, error) {
+	body, err := c.do(ctx, resty.MethodGet, "/nacos/v1/ns/instance/list", map[string]string{
+		"serviceName": service,
+		"healthyOnly": "true",
+	})
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Hosts []instance `json:"hosts"`
+	}
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("nacos list %s: %w", service, err)
+	}
+	return resp.Hosts, nil
+}
+
+// do 携带命名空间、分组与鉴权参数发起请求，非 2xx 响应视为失败
+func (c *client) do(ctx context.Context, method, path string, params map[string]string) ([]byte, error) {
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req := c.http.R().SetContext(ctx).SetQueryParams(params).SetQueryParam("groupName", c.cfg.Group)
+	if c.cfg.Namespace != "" {
+		req.SetQueryParam("namespaceId", c.cfg.Namespace)
+	}
+	if token != "" {
+		req.SetQueryParam("accessToken", token)
+	}
+	resp, err := req.Execute(method, path)
+	if err != nil {
+		return nil, fmt.Errorf("nacos %s %s: %w", method, path, err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("nacos %s %s: %s %s", method, path, resp.Status(), resp.Body())
+	}
+	return resp.Body(), nil
+}
+
+// accessToken 未配置用户名时不鉴权；令牌在过期前一分钟刷新
+func (c *client) accessToken(ctx context.Context) (string, error) {
+	if c.cfg.Username == "" {
+		return "", nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && time.Now().Before(c.tokenExpire) {
+		return c.token, nil
+	}
+	resp, err := c.http.R().SetContext(ctx).SetFormData(map[string]string{
+		"username": c.cfg.Username,
+		"password": c.cfg.Password,
+	}).Post("/nacos/v1/auth/login")
+	if err != nil {
+		return "", fmt.Errorf("nacos login: %w", err)
+	}
+	if resp.IsError() {
+		return "", fmt.Errorf("nacos login: %s %s", resp.Status(), resp.Body())
+	}
+	var login struct {
+		AccessToken string `json:"accessToken"`
+		TokenTTL    int64  `json:"tokenTtl"` // 秒
+	}
+	if err = json.Unmarshal(resp.Body(), &login); err != nil || login.AccessToken == "" {
+		return "", fmt.Errorf("nacos login: unexpected response %s", resp.Body())
+	}
+	c.token = login.AccessToken
+	c.tokenExpire = time.Now().Add(time.Duration(login.TokenTTL)*time.Second - time.Minute)
+	return c.token, nil
+}
@@ -0,0 +1,168 @@
+package nacos
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// fakeNacos 进程内的 Nacos Open API：只实现注册中心用到的接口，并要求登录令牌
+type fakeNacos struct {
+	mu        sync.Mutex
+	instances map[string]map[string]instance // 分组@@服务 -> ip:port -> 实例
+	beats     int
+}
+
+func newFakeNacos() *fakeNacos {
+	return &fakeNacos{instances: map[string]map[string]instance{}}
+}
+
+func (f *fakeNacos) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if r.URL.Path == "/nacos/v1/auth/login" {
+		if r.FormValue("username") != "nacos" || r.FormValue("password") != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"accessToken": "token-1", "tokenTtl": 18000})
+		return
+	}
+	if q.Get("accessToken") != "token-1" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	svc := q.Get("groupName") + "@@" + q.Get("serviceName")
+	key := q.Get("ip") + ":" + q.Get("port")
+	switch {
+	case r.URL.Path == "/nacos/v1/ns/instance" && r.Method == http.MethodPost:
+		inst := instance{IP: q.Get("ip"), Healthy: true, Enabled: true}
+		inst.Port, _ = strconv.Atoi(q.Get("port"))
+		inst.Weight, _ = strconv.ParseFloat(q.Get("weight"), 64)
+		_ = json.Unmarshal([]byte(q.Get("metadata")), &inst.Metadata)
+		if f.instances[svc] == nil {
+			f.instances[svc] = map[string]instance{}
+		}
+		f.instances[svc][key] = inst
+		_, _ = w.Write([]byte("ok"))
+	case r.URL.Path == "/nacos/v1/ns/instance" && r.Method == http.MethodDelete:
+		delete(f.instances[svc], key)
+		_, _ = w.Write([]byte("ok"))
+	case r.URL.Path == "/nacos/v1/ns/instance/beat":
+		f.beats++
+		var beat struct {
+			IP   string `json:"ip"`
+			Port int    `json:"port"`
+		}
+		_ = json.Unmarshal([]byte(q.Get("beat")), &beat)
+		code := 10200
+		if _, ok := f.instances[svc][beat.IP+":"+strconv.Itoa(beat.Port)]; !ok {
+			code = nacosCodeNotFound
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"code": code, "clientBeatInterval": 5000})
+	case r.URL.Path == "/nacos/v1/ns/instance/list":
+		hosts := make([]instance, 0)
+		for _, inst := range f.instances[svc] {
+			hosts = append(hosts, inst)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"hosts": hosts})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// expire 模拟心跳中断过久，服务端剔除全部实例
+func (f *fakeNacos) expire() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.instances = map[string]map[string]instance{}
+}
+
+func eventually(cond func() bool) bool {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	return false
+}
+
+func Test_NacosRegistry(t *testing.T) {
+	Convey("Test nacos registrar and resolver", t, func() {
+		fake := newFakeNacos()
+		srv := httptest.NewServer(fake)
+		defer srv.Close()
+		cfg := &NacosConfig{
+			Address:  srv.URL,
+			Group:    constant.RegistryNacosDefaultGroup,
+			Username: "nacos",
+			Password: "secret",
+		}
+		resolver := &Resolver{cli: newClient(cfg)}
+		registrar := &Registrar{cli: newClient(cfg), beatInterval: 20 * time.Millisecond}
+		services := []string{constant.ServiceNameMCPLocal}
+
+		deregister, err := registrar.Register(context.Background(), &registry.Registration{
+			Service: constant.ServiceNameMCPLocal,
+			ID:      "instance-1",
+			Address: "127.0.0.1:10002",
+			Port:    10002,
+			Meta: map[string]string{
+				constant.RegistryMetaAddr:   "127.0.0.1:10002",
+				constant.RegistryMetaWeight: "2",
+			},
+		})
+		So(err, ShouldBeNil)
+
+		got, err := resolver.Resolve(services)
+		So(err, ShouldBeNil)
+		So(got[constant.ServiceNameMCPLocal], ShouldHaveLength, 1)
+		So(got[constant.ServiceNameMCPLocal][0].Addr, ShouldEqual, "127.0.0.1:10002")
+		So(got[constant.ServiceNameMCPLocal][0].Weight, ShouldEqual, 2)
+
+		Convey("heartbeats re-register an expired instance", func() {
+			fake.expire()
+			So(eventually(func() bool {
+				got, err := resolver.Resolve(services)
+				return err == nil && len(got[constant.ServiceNameMCPLocal]) == 1
+			}), ShouldBeTrue)
+			So(deregister(), ShouldBeNil)
+		})
+
+		Convey("deregister stops heartbeats and removes the instance", func() {
+			So(deregister(), ShouldBeNil)
+			_, err := resolver.Resolve(services)
+			So(err, ShouldNotBeNil)
+
+			fake.mu.Lock()
+			beats := fake.beats
+			fake.mu.Unlock()
+			time.Sleep(100 * time.Millisecond)
+			fake.mu.Lock()
+			So(fake.beats, ShouldEqual, beats)
+			fake.mu.Unlock()
+		})
+
+		Convey("wrong credentials are reported", func() {
+			bad := *cfg
+			bad.Password = "wrong"
+			_, err := (&Resolver{cli: newClient(&bad)}).Resolve(services)
+			So(err, ShouldNotBeNil)
+			So(deregister(), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,88 @@
+package nacos
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+type Registrar struct {
+	cli          *client
+	beatInterval time.Duration
+}
+
+func NewRegistrar() *Registrar {
+	cfg := newConfig()
+	if cfg == nil {
+		return nil
+	}
+	return &Registrar{cli: newClient(cfg), beatInterval: constant.RegistryNacosBeatInterval}
+}
+
+// Register 注册为临时实例并定期发送心跳；实例因心跳中断被剔除后，下一次心跳时重新注册。
+// 返回的 deregister 停止心跳并注销实例
+func (r *Registrar) Register(ctx context.Context, reg *registry.Registration) (func() error, error) {
+	host := reg.Address
+	if h, _, err := net.SplitHostPort(reg.Address); err == nil {
+		host = h
+	}
+	inst := &instance{
+		IP:       host,
+		Port:     reg.Port,
+		Weight:   float64(registry.ParseWeight(reg.Meta[constant.RegistryMetaWeight])),
+		Metadata: reg.Meta,
+	}
+	if err := r.cli.register(ctx, reg.Service, inst); err != nil {
+		return nil, err
+	}
+
+	beatCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go r.heartbeat(beatCtx, reg.Service, inst, done)
+
+	var (
+		once          sync.Once
+		deregisterErr error
+	)
+	return func() error {
+		once.Do(func() {
+			cancel()
+			<-done
+			deregisterCtx, cancelDeregister := context.WithTimeout(context.Background(), constant.RegistryNacosRequestTimeout)
+			defer cancelDeregister()
+			deregisterErr = r.cli.deregister(deregisterCtx, reg.Service, inst)
+		})
+		return deregisterErr
+	}, nil
+}
+
+func (r *Registrar) heartbeat(ctx context.Context, service string, inst *instance, done chan<- struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(r.beatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		found, err := r.cli.beat(ctx, service, inst)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warnf("nacos: beat %s %s:%d failed: %v", service, inst.IP, inst.Port, err)
+			}
+			continue
+		}
+		if !found {
+			logger.Warnf("nacos: instance %s %s:%d expired, re-register", service, inst.IP, inst.Port)
+			if err = r.cli.register(ctx, service, inst); err != nil && ctx.Err() == nil {
+				logger.Errorf("nacos: re-register %s failed: %v", service, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+type Resolver struct {
+	cli *client
+}
+
+func NewResolver() *Resolver {
+	cfg := newConfig()
+	if cfg == nil {
+		return nil
+	}
+	return &Resolver{cli: newClient(cfg)}
+}
+
+// Resolve 返回各服务健康且启用的实例
+func (r *Resolver) Resolve(services []string) (map[string][]registry.Instance, error) {
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no Services provided")
+	}
+	out := make(map[string][]registry.Instance)
+	for _, svc := range services {
+		if svc == "" {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), constant.RegistryNacosRequestTimeout)
+		hosts, err := r.cli.list(ctx, svc)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("nacos discover %q: %w", svc, err)
+		}
+		for _, h := range hosts {
+			if !h.Healthy || !h.Enabled {
+				continue
+			}
+			addr := strings.TrimSpace(h.Metadata[constant.RegistryMetaAddr])
+			if addr == "" {
+				addr = net.JoinHostPort(h.IP, strconv.Itoa(h.Port))
+			}
+			weight, ok := h.Metadata[constant.RegistryMetaWeight]
+			if !ok {
+				weight = strconv.Itoa(int(h.Weight))
+			}
+			out[svc] = append(out[svc], registry.Instance{
+				Addr:   addr,
+				Weight: registry.ParseWeight(weight),
+				Meta:   h.Metadata,
+			})
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("nacos: no healthy instances for %v", services)
+	}
+	return out, nil
+}
@@ -2,14 +2,32 @@ package registry
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
 )
 
 // Resolver 抽象服务发现（可扩展 consul/etcd/...）
-// 返回「全部」可用实例的完整 URL（如 http://127.0.0.1:8080/mcp）
+// 返回「全部」可用实例
 type Resolver interface {
-	// Resolve 解析服务名列表，返回可用实例的完整 URL 列表
-	Resolve(services []string) (map[string][]string, error)
+	// Resolve 解析服务名列表，返回 服务名 -> 可用实例列表；出错时可以同时返回仍然可信的部分结果
+	Resolve(services []string) (map[string][]Instance, error)
+}
+
+// Watcher 可选接口：服务列表变化时 Resolver 从 Changes 发出信号，调用方据此立即刷新而不必等待下一个周期
+type Watcher interface {
+	Changes() <-chan struct{}
+}
+
+// Instance 发现到的一个服务实例
+type Instance struct {
+	Addr   string            // 注册时写入 Meta["addr"] 的地址，如 127.0.0.1:10001；静态配置时为完整 URL
+	Weight int               // 负载均衡权重，来自 Meta["weight"]，未配置时为 1
+	Meta   map[string]string // 注册时写入的全部元信息
 }
 
 // Registrar 抽象服务注册
@@ -33,3 +51,95 @@ type Registration struct {
 	CheckInterval   time.Duration
 	DeregisterAfter time.Duration
 }
+
+// ParseWeight 解析 Meta 中的权重，缺省或非法时返回 1
+func ParseWeight(v string) int {
+	w, err := strconv.Atoi(v)
+	if err != nil || w <= 0 {
+		return 1
+	}
+	return w
+}
+
+// Endpoint 实例的 MCP 地址：Addr 已是完整 URL（静态配置）时原样返回，否则按 http://addr/mcp 拼接
+func Endpoint(addr string) string {
+	if strings.Contains(addr, "://") {
+		return addr
+	}
+	return "http://" + addr + constant.RegistryMCPDefaultPath
+}
+
+// Merge 合并多个 Resolver 的结果，如 Consul 发现的实例加上静态配置的外部服务。
+// 某个 Resolver 失败时沿用它上一次成功的结果，与其余 Resolver 的结果一并返回，同时返回错误；
+// 调用方据此只保留失败来源的旧实例，不会把暂时解析不到的实例当作已下线。用完需调用 Close
+func Merge(resolvers ...Resolver) Resolver {
+	m := &merged{
+		resolvers: resolvers,
+		last:      make([]map[string][]Instance, len(resolvers)),
+		changes:   make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+	for _, r := range resolvers {
+		if w, ok := r.(Watcher); ok {
+			go m.forward(w.Changes())
+		}
+	}
+	return m
+}
+
+type merged struct {
+	resolvers []Resolver
+	mu        sync.Mutex
+	last      []map[string][]Instance // 各 Resolver 上一次成功的结果
+	changes   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// forward 把单个 Watcher 的变更通知转发到合并后的 Changes，Close 后退出
+func (m *merged) forward(changes <-chan struct{}) {
+	for {
+		select {
+		case _, ok := <-changes:
+			if !ok {
+				return
+			}
+			select {
+			case m.changes <- struct{}{}:
+			default:
+			}
+		case <-m.done:
+			return
+		}
+	}
+}
+
+func (m *merged) Resolve(services []string) (map[string][]Instance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make(map[string][]Instance)
+	var errs []error
+	for i, r := range m.resolvers {
+		res, err := r.Resolve(services)
+		if err != nil {
+			errs = append(errs, err)
+			res = m.last[i]
+		} else {
+			m.last[i] = res
+		}
+		for svc, insts := range res {
+			out[svc] = append(out[svc], insts...)
+		}
+	}
+	return out, errors.Join(errs...)
+}
+
+func (m *merged) Changes() <-chan struct{} {
+	return m.changes
+}
+
+// Close 停止转发各 Watcher 的变更通知，不关闭被合并的 Resolver
+func (m *merged) Close() error {
+	m.closeOnce.Do(func() { close(m.done) })
+	return nil
+}
@@ -0,0 +1,86 @@
+package registry
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeResolver 返回固定结果，err 非空时解析失败；changes 非空时同时实现 Watcher
+type fakeResolver struct {
+	res     map[string][]Instance
+	err     error
+	changes chan struct{}
+}
+
+func (r *fakeResolver) Resolve([]string) (map[string][]Instance, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.res, nil
+}
+
+type fakeWatcher struct {
+	*fakeResolver
+}
+
+func (w fakeWatcher) Changes() <-chan struct{} { return w.changes }
+
+func Test_Merge(t *testing.T) {
+	Convey("Test merging resolvers", t, func() {
+		discovery := &fakeResolver{res: map[string][]Instance{"mcp_local": {{Addr: "127.0.0.1:10001"}}}}
+		static := &fakeResolver{res: map[string][]Instance{"fzuhelper": {{Addr: "https://fzuhelper.west2.online/mcp"}}}}
+		m := Merge(discovery, static)
+		defer m.(*merged).Close()
+
+		res, err := m.Resolve(nil)
+		So(err, ShouldBeNil)
+		So(res, ShouldResemble, map[string][]Instance{
+			"mcp_local": {{Addr: "127.0.0.1:10001"}},
+			"fzuhelper": {{Addr: "https://fzuhelper.west2.online/mcp"}},
+		})
+
+		Convey("a failing resolver keeps its last result and the others stay fresh", func() {
+			discovery.err = errors.New("consul down")
+			static.res = map[string][]Instance{"fzuhelper": {{Addr: "https://fzuhelper.example.com/mcp"}}}
+
+			res, err := m.Resolve(nil)
+			So(err, ShouldNotBeNil)
+			So(res, ShouldResemble, map[string][]Instance{
+				"mcp_local": {{Addr: "127.0.0.1:10001"}},
+				"fzuhelper": {{Addr: "https://fzuhelper.example.com/mcp"}},
+			})
+		})
+
+		Convey("a resolver that never succeeded contributes nothing", func() {
+			m := Merge(&fakeResolver{err: errors.New("consul down")}, static)
+			res, err := m.Resolve(nil)
+			So(err, ShouldNotBeNil)
+			So(res, ShouldResemble, map[string][]Instance{"fzuhelper": {{Addr: "https://fzuhelper.west2.online/mcp"}}})
+		})
+	})
+
+	Convey("Test forwarding changes until Close", t, func() {
+		before := runtime.NumGoroutine()
+		w := fakeWatcher{&fakeResolver{changes: make(chan struct{})}}
+		m := Merge(&fakeResolver{}, w).(*merged)
+
+		w.changes <- struct{}{}
+		select {
+		case <-m.Changes():
+		case <-time.After(time.Second):
+			So("no change forwarded", ShouldBeEmpty)
+		}
+
+		So(m.Close(), ShouldBeNil)
+		So(m.Close(), ShouldBeNil)
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+		So(runtime.NumGoroutine(), ShouldEqual, before)
+	})
+}
@@ -0,0 +1,186 @@
+package static
+
+import (
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// Resolver 静态配置的 MCP 服务列表：registry.static.services，以及可选的 registry.static.file。
+// 文件变更时重新读取，并通过 Changes 通知聚合客户端立即刷新；用完需调用 Close 停止监听
+type Resolver struct {
+	base []config.StaticService
+	file *viper.Viper // 未配置文件时为 nil
+
+	mu       sync.RWMutex
+	services map[string][]registry.Instance
+	changes  chan struct{}
+
+	watcher   *fsnotify.Watcher // 监听失败或未配置文件时为 nil
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func NewResolver() *Resolver {
+	cfg := config.Registry.Static
+	if len(cfg.Services) == 0 && cfg.File == "" {
+		return nil
+	}
+	return newResolver(cfg.Services, cfg.File)
+}
+
+func newResolver(base []config.StaticService, file string) *Resolver {
+	r := &Resolver{
+		base:     base,
+		services: toInstances(base),
+		changes:  make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	if file == "" {
+		return r
+	}
+	r.file = viper.New()
+	r.file.SetConfigFile(file)
+	// 启动时文件读取失败只使用配置中的服务，文件修正后由监听重新载入
+	if err := r.file.ReadInConfig(); err != nil {
+		logger.Errorf("static resolver: read %s: %v", file, err)
+	} else {
+		r.reload()
+	}
+	r.watch(file)
+	return r
+}
+
+// watch 监听文件所在目录（编辑器常以改名方式保存），文件被写入或重新创建时重新载入；
+// 一次保存可能触发多个事件，静默一小段时间后再读取，避免读到写了一半的文件。
+// 不使用 viper.WatchConfig：它启动的监听无法停止
+func (r *Resolver) watch(file string) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		logger.Errorf("static resolver: watch %s: %v", file, err)
+		return
+	}
+	if err := w.Add(filepath.Dir(file)); err != nil {
+		logger.Errorf("static resolver: watch %s: %v", file, err)
+		_ = w.Close()
+		return
+	}
+	r.watcher = w
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		file := filepath.Clean(file)
+		debounce := time.NewTimer(time.Hour)
+		debounce.Stop()
+		defer debounce.Stop()
+		for {
+			select {
+			case e, ok := <-w.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(e.Name) == file && e.Has(fsnotify.Write|fsnotify.Create) {
+					debounce.Reset(constant.RegistryStaticReloadDebounce)
+				}
+			case <-debounce.C:
+				logger.Infof("static resolver: %s changed, reload", file)
+				if err := r.file.ReadInConfig(); err != nil {
+					logger.Errorf("static resolver: read %s: %v", file, err)
+					continue
+				}
+				r.reload()
+			case err, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+				logger.Warnf("static resolver: watch %s: %v", file, err)
+			case <-r.done:
+				return
+			}
+		}
+	}()
+}
+
+// reload 以文件中的服务覆盖配置中的同名服务；文件内容非法时保留当前列表
+func (r *Resolver) reload() {
+	var fromFile []config.StaticService
+	if err := r.file.UnmarshalKey("services", &fromFile); err != nil {
+		logger.Errorf("static resolver: parse %s: %v", r.file.ConfigFileUsed(), err)
+		return
+	}
+	names := make(map[string]bool, len(fromFile))
+	for _, s := range fromFile {
+		names[s.Name] = true
+	}
+	services := slices.DeleteFunc(slices.Clone(r.base), func(s config.StaticService) bool { return names[s.Name] })
+	services = append(services, fromFile...)
+
+	r.mu.Lock()
+	r.services = toInstances(services)
+	r.mu.Unlock()
+	select {
+	case r.changes <- struct{}{}:
+	default:
+	}
+}
+
+func toInstances(services []config.StaticService) map[string][]registry.Instance {
+	out := make(map[string][]registry.Instance)
+	for _, s := range services {
+		name, url := strings.TrimSpace(s.Name), strings.TrimSpace(s.URL)
+		if name == "" || url == "" {
+			logger.Warnf("static resolver: skip service without name or url: %+v", s)
+			continue
+		}
+		weight := max(s.Weight, 1)
+		out[name] = append(out[name], registry.Instance{
+			Addr:   url,
+			Weight: weight,
+			Meta: map[string]string{
+				constant.RegistryMetaAddr:   url,
+				constant.RegistryMetaWeight: strconv.Itoa(weight),
+			},
+		})
+	}
+	return out
+}
+
+// Resolve 静态列表本身就是要聚合的服务集合，不按 services 过滤：外部服务（如 fzuhelper）不在 Host 的服务名列表中
+func (r *Resolver) Resolve(_ []string) (map[string][]registry.Instance, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make(map[string][]registry.Instance, len(r.services))
+	for svc, insts := range r.services {
+		out[svc] = slices.Clone(insts)
+	}
+	return out, nil
+}
+
+// Changes 文件重新载入后发出信号
+func (r *Resolver) Changes() <-chan struct{} {
+	return r.changes
+}
+
+// Close 停止监听文件，返回后不会再重新载入
+func (r *Resolver) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+		if r.watcher != nil {
+			_ = r.watcher.Close()
+		}
+	})
+	r.wg.Wait()
+	return nil
+}
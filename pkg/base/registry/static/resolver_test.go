@@ -0,0 +1,91 @@
+package static
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/registry"
+)
+
+func addrs(insts []registry.Instance) []string {
+	out := make([]string, 0, len(insts))
+	for _, inst := range insts {
+		out = append(out, inst.Addr)
+	}
+	return out
+}
+
+func Test_Resolver(t *testing.T) {
+	Convey("Test static resolver", t, func() {
+		base := []config.StaticService{
+			{Name: "mcp_local", URL: "http://127.0.0.1:10002/mcp", Weight: 3},
+			{Name: "mcp_local", URL: "http://127.0.0.1:10012/mcp"},
+			{Name: "fzuhelper", URL: "https://fzuhelper.west2.online/mcp"},
+			{Name: "broken"},
+		}
+
+		Convey("config entries are grouped by service name", func() {
+			got, err := newResolver(base, "").Resolve(nil)
+			So(err, ShouldBeNil)
+			So(got, ShouldHaveLength, 2)
+			So(addrs(got["mcp_local"]), ShouldResemble, []string{"http://127.0.0.1:10002/mcp", "http://127.0.0.1:10012/mcp"})
+			So(got["mcp_local"][0].Weight, ShouldEqual, 3)
+			So(got["mcp_local"][1].Weight, ShouldEqual, 1)
+			So(registry.Endpoint(got["fzuhelper"][0].Addr), ShouldEqual, "https://fzuhelper.west2.online/mcp")
+			So(registry.Endpoint("127.0.0.1:10002"), ShouldEqual, "http://127.0.0.1:10002/mcp")
+		})
+
+		Convey("file entries override same-named services and are reloaded on change", func() {
+			file := filepath.Join(t.TempDir(), "mcp.yaml")
+			So(os.WriteFile(file, []byte("services:\n  - { name: mcp_local, url: http://10.0.0.1:10002/mcp }\n"), 0o644), ShouldBeNil)
+
+			r := newResolver(base, file)
+			defer r.Close()
+			got, _ := r.Resolve(nil)
+			So(addrs(got["mcp_local"]), ShouldResemble, []string{"http://10.0.0.1:10002/mcp"})
+			So(got, ShouldContainKey, "fzuhelper")
+			<-r.Changes() // 初次载入
+
+			So(os.WriteFile(file, []byte("services:\n  - { name: mcp_remote, url: http://10.0.0.2:10003/mcp }\n"), 0o644), ShouldBeNil)
+			select {
+			case <-r.Changes():
+			case <-time.After(5 * time.Second):
+				So("no reload after file change", ShouldBeEmpty)
+			}
+			got, _ = r.Resolve(nil)
+			So(addrs(got["mcp_local"]), ShouldHaveLength, 2)
+			So(addrs(got["mcp_remote"]), ShouldResemble, []string{"http://10.0.0.2:10003/mcp"})
+		})
+
+		Convey("Close stops watching the file", func() {
+			file := filepath.Join(t.TempDir(), "mcp.yaml")
+			So(os.WriteFile(file, []byte("services:\n  - { name: mcp_local, url: http://10.0.0.1:10002/mcp }\n"), 0o644), ShouldBeNil)
+			r := newResolver(base, file)
+			<-r.Changes()
+			So(r.Close(), ShouldBeNil)
+			So(r.Close(), ShouldBeNil)
+
+			So(os.WriteFile(file, []byte("services:\n  - { name: mcp_remote, url: http://10.0.0.2:10003/mcp }\n"), 0o644), ShouldBeNil)
+			select {
+			case <-r.Changes():
+				So("reloaded after Close", ShouldBeEmpty)
+			case <-time.After(200 * time.Millisecond):
+			}
+			got, _ := r.Resolve(nil)
+			So(got, ShouldNotContainKey, "mcp_remote")
+		})
+
+		Convey("merged with another resolver", func() {
+			merged := registry.Merge(newResolver(base[:1], ""), newResolver(base[2:3], ""))
+			got, err := merged.Resolve(nil)
+			So(err, ShouldBeNil)
+			So(got, ShouldContainKey, "mcp_local")
+			So(got, ShouldContainKey, "fzuhelper")
+		})
+	})
+}
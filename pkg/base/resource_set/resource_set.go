@@ -0,0 +1,52 @@
+package resource_set
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"sync"
+)
+
+var (
+	instance *ResourceSet
+	once     sync.Once
+)
+
+type ResourceSet struct {
+	// 固定 URI 的资源
+	Resources []*mcp.Resource
+	// map[r.URI]HandlerFunc
+	HandlerFunc map[string]server.ResourceHandlerFunc
+	// URI 模板资源，如 todo://{user_id}
+	Templates []*mcp.ResourceTemplate
+	// map[t.URITemplate.Raw()]TemplateHandlerFunc
+	TemplateHandlerFunc map[string]server.ResourceTemplateHandlerFunc
+}
+
+type Option func(resourceSet *ResourceSet)
+
+func NewResourceSet(opt ...Option) *ResourceSet {
+	once.Do(func() {
+		var options []Option
+		instance = new(ResourceSet)
+		instance.HandlerFunc = make(map[string]server.ResourceHandlerFunc)
+		instance.TemplateHandlerFunc = make(map[string]server.ResourceTemplateHandlerFunc)
+		options = append(options, opt...)
+		for _, opt := range options {
+			opt(instance)
+		}
+	})
+	return instance
+}
+
+// TemplateArg 取出 URI 模板匹配到的变量，未匹配时返回空串
+func TemplateArg(req mcp.ReadResourceRequest, name string) string {
+	switch v := req.Params.Arguments[name].(type) {
+	case string:
+		return v
+	case []string:
+		if len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
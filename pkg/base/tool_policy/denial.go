@@ -0,0 +1,51 @@
+package tool_policy
+
+import (
+	"encoding/json"
+)
+
+// DeniedCode 被策略拒绝的工具结果中 error 字段的固定取值
+const DeniedCode = "tool_denied"
+
+// 拒绝原因
+const (
+	ReasonNotAllowed      = "not_allowed"      // 工具对当前用户/接口不可用
+	ReasonInvalidArgument = "invalid_argument" // 参数不满足约束
+	ReasonRateLimited     = "rate_limited"     // 超出频率限制
+
+	ReasonApprovalRequired = "approval_required" // 需要用户确认，但当前对话无法发起确认
+	ReasonApprovalRejected = "approval_rejected" // 用户拒绝
+	ReasonApprovalTimeout  = "approval_timeout"  // 用户未在时限内确认
+)
+
+// Denial 策略拒绝一次工具调用的结构化说明，以 JSON 形式作为工具结果回填给模型，
+// 让模型知道是换参数重试、稍后再试还是放弃该工具
+type Denial struct {
+	Code       string `json:"error"`
+	Tool       string `json:"tool"`
+	Reason     string `json:"reason"`
+	Message    string `json:"message"`
+	Rule       string `json:"rule,omitempty"`
+	Argument   string `json:"argument,omitempty"`
+	RetryAfter int64  `json:"retry_after_seconds,omitempty"`
+}
+
+// NotAllowed 构造一个不可用的拒绝，用于策略之外的拦截（如调用方限制、模型臆造的工具名）
+func NotAllowed(tool, message string) *Denial {
+	return Deny(tool, ReasonNotAllowed, message)
+}
+
+// Deny 构造指定原因的拒绝
+func Deny(tool, reason, message string) *Denial {
+	return &Denial{Code: DeniedCode, Tool: tool, Reason: reason, Message: message}
+}
+
+func (d *Denial) Error() string {
+	return d.Reason + ": " + d.Message
+}
+
+// JSON 回填给模型的工具结果
+func (d *Denial) JSON() string {
+	b, _ := json.Marshal(d)
+	return string(b)
+}
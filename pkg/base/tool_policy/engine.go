@@ -0,0 +1,296 @@
+package tool_policy
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// Subject 一次工具访问的主体
+type Subject struct {
+	User     string
+	Endpoint string // 发起对话的接口，见 constant.ToolPolicyEndpoint*
+}
+
+// Engine 声明式工具策略：按用户/角色/接口决定工具是否可见、参数是否合法、是否超出频率限制。
+// 规则可随时通过 Load 整体替换，限流计数跨替换保留。nil Engine 放行一切
+type Engine struct {
+	mu          sync.RWMutex
+	defaultDeny bool
+	roles       map[string][]string // 用户 -> 角色
+	rules       []*rule
+
+	limiter *limiter
+}
+
+type rule struct {
+	config.ToolPolicyRule
+	args []argConstraint
+}
+
+type argConstraint struct {
+	config.ToolArgConstraint
+	pattern *regexp.Regexp
+}
+
+func NewEngine() *Engine {
+	return &Engine{limiter: newLimiter()}
+}
+
+// Load 替换全部规则。校验失败的规则会被跳过并在返回的错误中说明，其余规则照常生效
+func (e *Engine) Load(cfg config.MCPPolicyConfig) error {
+	var errs []error
+	roles := map[string][]string{}
+	for _, r := range cfg.Roles {
+		for _, u := range r.Users {
+			roles[u] = append(roles[u], r.Role)
+		}
+	}
+	rules := make([]*rule, 0, len(cfg.Rules))
+	for i, rc := range cfg.Rules {
+		if rc.Name == "" {
+			rc.Name = "rule#" + strconv.Itoa(i)
+		}
+		r, err := compile(rc)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tool_policy: skip rule %s: %w", rc.Name, err))
+			continue
+		}
+		rules = append(rules, r)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.defaultDeny = cfg.Default == constant.ToolPolicyDefaultDeny
+	e.roles = roles
+	e.rules = rules
+	return errors.Join(errs...)
+}
+
+func compile(rc config.ToolPolicyRule) (*rule, error) {
+	patterns := slices.Concat(rc.Allow, rc.Deny, rc.Confirm)
+	for _, c := range rc.Args {
+		patterns = append(patterns, c.Tool)
+	}
+	for _, l := range rc.RateLimits {
+		if l.Limit <= 0 || l.Window <= 0 {
+			return nil, fmt.Errorf("rate limit needs positive limit and window")
+		}
+		patterns = append(patterns, l.Tools...)
+	}
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("bad tool pattern %q: %w", p, err)
+		}
+	}
+
+	r := &rule{ToolPolicyRule: rc}
+	for _, c := range rc.Args {
+		ac := argConstraint{ToolArgConstraint: c}
+		if c.Pattern != "" {
+			re, err := regexp.Compile(`^(?:` + c.Pattern + `)$`)
+			if err != nil {
+				return nil, fmt.Errorf("bad pattern for %s.%s: %w", c.Tool, c.Arg, err)
+			}
+			ac.pattern = re
+		}
+		r.args = append(r.args, ac)
+	}
+	return r, nil
+}
+
+// matchTool 工具是否命中通配列表：按原始工具名匹配，服务已知时也可写成 服务/工具
+func matchTool(patterns []string, tool Tool) bool {
+	return slices.ContainsFunc(patterns, func(p string) bool {
+		if ok, _ := path.Match(p, tool.Name); ok {
+			return true
+		}
+		if tool.Service == "" {
+			return false
+		}
+		ok, _ := path.Match(p, tool.Service+"/"+tool.Name)
+		return ok
+	})
+}
+
+func (r *rule) matches(s Subject, roles []string) bool {
+	if len(r.Users) > 0 && !slices.Contains(r.Users, s.User) {
+		return false
+	}
+	if len(r.Roles) > 0 && !slices.ContainsFunc(r.Roles, func(role string) bool { return slices.Contains(roles, role) }) {
+		return false
+	}
+	if len(r.Endpoints) > 0 && !slices.Contains(r.Endpoints, s.Endpoint) {
+		return false
+	}
+	return true
+}
+
+// matched 命中主体的规则（按配置顺序）及当前的默认行为
+func (e *Engine) matched(s Subject) ([]*rule, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	roles := e.roles[s.User]
+	var out []*rule
+	for _, r := range e.rules {
+		if r.matches(s, roles) {
+			out = append(out, r)
+		}
+	}
+	return out, e.defaultDeny
+}
+
+// visible 按命中规则判断工具可见性：deny 优先；存在 allow 列表时须命中其一；否则按默认行为
+func visible(rules []*rule, defaultDeny bool, tool Tool) (bool, string) {
+	restricted := false
+	for _, r := range rules {
+		if matchTool(r.Deny, tool) {
+			return false, r.Name
+		}
+	}
+	for _, r := range rules {
+		if len(r.Allow) == 0 {
+			continue
+		}
+		restricted = true
+		if matchTool(r.Allow, tool) {
+			return true, r.Name
+		}
+	}
+	return !restricted && !defaultDeny, ""
+}
+
+// Visible 工具是否对主体可见，用于过滤暴露给模型的工具列表
+func (e *Engine) Visible(s Subject, tool Tool) bool {
+	if e == nil {
+		return true
+	}
+	rules, defaultDeny := e.matched(s)
+	ok, _ := visible(rules, defaultDeny, tool)
+	return ok
+}
+
+// RequiresApproval 工具调用前是否需要用户确认
+func (e *Engine) RequiresApproval(s Subject, tool Tool) bool {
+	if e == nil {
+		return false
+	}
+	rules, _ := e.matched(s)
+	return slices.ContainsFunc(rules, func(r *rule) bool { return matchTool(r.Confirm, tool) })
+}
+
+// Check 在调用工具前检查可见性、参数约束与频率限制，全部通过时才计入限流；
+// 返回 nil 表示放行
+func (e *Engine) Check(s Subject, tool Tool, args map[string]any) *Denial {
+	if e == nil {
+		return nil
+	}
+	rules, defaultDeny := e.matched(s)
+	if ok, by := visible(rules, defaultDeny, tool); !ok {
+		return &Denial{
+			Code:    DeniedCode,
+			Tool:    tool.String(),
+			Reason:  ReasonNotAllowed,
+			Message: fmt.Sprintf("tool %q is not available to you here; do not call it again", tool.String()),
+			Rule:    by,
+		}
+	}
+	for _, r := range rules {
+		for _, c := range r.args {
+			if !matchTool([]string{c.Tool}, tool) {
+				continue
+			}
+			if msg := c.check(args); msg != "" {
+				return &Denial{
+					Code:     DeniedCode,
+					Tool:     tool.String(),
+					Reason:   ReasonInvalidArgument,
+					Message:  msg + "; fix the argument and call the tool again",
+					Rule:     r.Name,
+					Argument: c.Arg,
+				}
+			}
+		}
+	}
+
+	var keys []limitKey
+	for _, r := range rules {
+		for i, l := range r.RateLimits {
+			if len(l.Tools) > 0 && !matchTool(l.Tools, tool) {
+				continue
+			}
+			keys = append(keys, limitKey{key: r.Name + "#" + strconv.Itoa(i) + "|" + s.User, limit: l.Limit, window: l.Window, rule: r.Name})
+		}
+	}
+	if key, wait := e.limiter.take(keys, time.Now()); key != nil {
+		return &Denial{
+			Code:       DeniedCode,
+			Tool:       tool.String(),
+			Reason:     ReasonRateLimited,
+			Message:    fmt.Sprintf("rate limit of %d calls per %s reached for tool %q; try again later or answer without it", key.limit, key.window, tool.String()),
+			Rule:       key.rule,
+			RetryAfter: int64(wait.Round(time.Second) / time.Second),
+		}
+	}
+	return nil
+}
+
+// check 返回违反约束的说明，满足时返回空串
+func (c *argConstraint) check(args map[string]any) string {
+	v, ok := args[c.Arg]
+	if !ok || v == nil {
+		if c.Required {
+			return fmt.Sprintf("argument %q is required", c.Arg)
+		}
+		return ""
+	}
+	if len(c.Enum) > 0 && !slices.Contains(c.Enum, fmt.Sprint(v)) {
+		return fmt.Sprintf("argument %q = %v is not one of %v", c.Arg, v, c.Enum)
+	}
+	if c.pattern != nil && !c.pattern.MatchString(fmt.Sprint(v)) {
+		return fmt.Sprintf("argument %q = %v does not match %s", c.Arg, v, c.Pattern)
+	}
+	if c.MaxLength > 0 {
+		if s, ok := v.(string); ok && utf8.RuneCountInString(s) > c.MaxLength {
+			return fmt.Sprintf("argument %q is longer than %d characters", c.Arg, c.MaxLength)
+		}
+	}
+	if c.Min != nil || c.Max != nil {
+		n, ok := number(v)
+		switch {
+		case !ok:
+			return fmt.Sprintf("argument %q = %v is not a number", c.Arg, v)
+		case c.Min != nil && n < *c.Min:
+			return fmt.Sprintf("argument %q = %v is below minimum %v", c.Arg, v, *c.Min)
+		case c.Max != nil && n > *c.Max:
+			return fmt.Sprintf("argument %q = %v exceeds maximum %v", c.Arg, v, *c.Max)
+		}
+	}
+	return ""
+}
+
+// number 模型经常把数字写成字符串，这里一并接受
+func number(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
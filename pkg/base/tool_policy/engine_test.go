@@ -0,0 +1,146 @@
+package tool_policy
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+func ptr(f float64) *float64 { return &f }
+
+func Test_Engine(t *testing.T) {
+	Convey("Test tool policy engine", t, func() {
+		e := NewEngine()
+		err := e.Load(config.MCPPolicyConfig{
+			Roles: []config.ToolPolicyRole{{Role: "admin", Users: []string{"root"}}},
+			Rules: []config.ToolPolicyRule{
+				{Name: "chat", Endpoints: []string{constant.ToolPolicyEndpointChat}, Deny: []string{"get_todos", "fs_*"}},
+				{Name: "schedule", Endpoints: []string{constant.ToolPolicyEndpointDailySchedule}, Allow: []string{"get_todos"}},
+				{Name: "admin", Roles: []string{"admin"}, Allow: []string{"*"}},
+				{
+					Name: "search",
+					Args: []config.ToolArgConstraint{
+						{Tool: "web_search", Arg: "max_results", Max: ptr(10)},
+						{Tool: "web_search", Arg: "lang", Enum: []string{"zh", "en"}},
+					},
+					RateLimits: []config.ToolRateLimit{{Tools: []string{"web_search"}, Limit: 2, Window: time.Minute}},
+				},
+				{Name: "broken", Deny: []string{"["}},
+			},
+		})
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "broken")
+
+		chat := Subject{User: "102301000", Endpoint: constant.ToolPolicyEndpointChat}
+		schedule := Subject{User: "102301000", Endpoint: constant.ToolPolicyEndpointDailySchedule}
+
+		Convey("deny and allow lists by endpoint", func() {
+			So(e.Visible(chat, ParseTool("get_todos")), ShouldBeFalse)
+			So(e.Visible(chat, ParseTool("fs_cat")), ShouldBeFalse)
+			So(e.Visible(chat, ParseTool("web_search")), ShouldBeTrue)
+			So(e.Visible(schedule, ParseTool("get_todos")), ShouldBeTrue)
+			So(e.Visible(schedule, ParseTool("web_search")), ShouldBeFalse)
+
+			d := e.Check(chat, ParseTool("fs_cat"), nil)
+			So(d, ShouldNotBeNil)
+			So(d.Reason, ShouldEqual, ReasonNotAllowed)
+			So(d.Rule, ShouldEqual, "chat")
+			So(d.JSON(), ShouldContainSubstring, `"error":"tool_denied"`)
+		})
+
+		Convey("namespaced or aliased tools match rules by their original name", func() {
+			namespaced := Tool{Exposed: "mcp_local__get_todos", Service: "mcp_local", Name: "get_todos"}
+			So(e.Visible(chat, namespaced), ShouldBeFalse)
+			So(e.Visible(schedule, namespaced), ShouldBeTrue)
+			d := e.Check(chat, namespaced, nil)
+			So(d, ShouldNotBeNil)
+			So(d.Tool, ShouldEqual, "mcp_local__get_todos")
+
+			aliased := Tool{Exposed: "todos", Service: "mcp_local", Name: "get_todos"}
+			So(e.Visible(chat, aliased), ShouldBeFalse)
+			So(e.Visible(schedule, aliased), ShouldBeTrue)
+
+			// 无法解析时按 服务__工具 拆分
+			So(e.Visible(chat, ParseTool("mcp_remote__get_todos")), ShouldBeFalse)
+			So(e.Visible(schedule, ParseTool("mcp_remote__get_todos")), ShouldBeTrue)
+
+			// 规则也可以写成 服务/工具，只匹配该服务提供的工具
+			So(e.Load(config.MCPPolicyConfig{Rules: []config.ToolPolicyRule{
+				{Name: "remote", Deny: []string{"mcp_remote/*"}},
+			}}), ShouldBeNil)
+			So(e.Visible(chat, Tool{Service: "mcp_remote", Name: "get_todos"}), ShouldBeFalse)
+			So(e.Visible(chat, namespaced), ShouldBeTrue)
+			So(e.Visible(chat, ParseTool("get_todos")), ShouldBeTrue)
+		})
+
+		Convey("role grants union with endpoint allow list, deny still wins", func() {
+			admin := Subject{User: "root", Endpoint: constant.ToolPolicyEndpointDailySchedule}
+			So(e.Visible(admin, ParseTool("web_search")), ShouldBeTrue)
+			So(e.Visible(Subject{User: "root", Endpoint: constant.ToolPolicyEndpointChat}, ParseTool("get_todos")), ShouldBeFalse)
+		})
+
+		Convey("argument constraints", func() {
+			So(e.Check(chat, ParseTool("web_search"), map[string]any{"max_results": "5", "lang": "zh"}), ShouldBeNil)
+
+			d := e.Check(chat, ParseTool("web_search"), map[string]any{"max_results": 50.0})
+			So(d.Reason, ShouldEqual, ReasonInvalidArgument)
+			So(d.Argument, ShouldEqual, "max_results")
+			So(d.Message, ShouldContainSubstring, "exceeds maximum 10")
+
+			d = e.Check(chat, ParseTool("web_search"), map[string]any{"lang": "fr"})
+			So(d.Argument, ShouldEqual, "lang")
+		})
+
+		Convey("rate limit is per user and only counts allowed calls", func() {
+			So(e.Check(chat, ParseTool("web_search"), map[string]any{"max_results": 99.0}), ShouldNotBeNil)
+			So(e.Check(chat, ParseTool("web_search"), nil), ShouldBeNil)
+			So(e.Check(chat, ParseTool("web_search"), nil), ShouldBeNil)
+			d := e.Check(chat, ParseTool("web_search"), nil)
+			So(d.Reason, ShouldEqual, ReasonRateLimited)
+			So(d.RetryAfter, ShouldBeGreaterThan, 0)
+
+			So(e.Check(Subject{User: "other", Endpoint: constant.ToolPolicyEndpointChat}, ParseTool("web_search"), nil), ShouldBeNil)
+		})
+
+		Convey("confirm list marks tools that need user approval", func() {
+			So(e.Load(config.MCPPolicyConfig{Rules: []config.ToolPolicyRule{
+				{Name: "confirm", Endpoints: []string{constant.ToolPolicyEndpointChat}, Confirm: []string{"code_*"}},
+			}}), ShouldBeNil)
+			So(e.RequiresApproval(chat, ParseTool("code_run")), ShouldBeTrue)
+			So(e.RequiresApproval(chat, ParseTool("web_search")), ShouldBeFalse)
+			So(e.RequiresApproval(schedule, ParseTool("code_run")), ShouldBeFalse)
+		})
+
+		Convey("default deny applies when no allow list matches", func() {
+			So(e.Load(config.MCPPolicyConfig{Default: constant.ToolPolicyDefaultDeny}), ShouldBeNil)
+			So(e.Visible(chat, ParseTool("web_search")), ShouldBeFalse)
+		})
+
+		Convey("nil engine allows everything", func() {
+			var nilEngine *Engine
+			So(nilEngine.Visible(chat, ParseTool("anything")), ShouldBeTrue)
+			So(nilEngine.Check(chat, ParseTool("anything"), nil), ShouldBeNil)
+		})
+	})
+}
+
+func Test_limiter(t *testing.T) {
+	Convey("Test sliding window limiter", t, func() {
+		l := newLimiter()
+		now := time.Now()
+		keys := []limitKey{{key: "k", limit: 2, window: time.Minute}}
+		k, _ := l.take(keys, now)
+		So(k, ShouldBeNil)
+		k, _ = l.take(keys, now.Add(10*time.Second))
+		So(k, ShouldBeNil)
+		k, wait := l.take(keys, now.Add(20*time.Second))
+		So(k, ShouldNotBeNil)
+		So(wait, ShouldEqual, 40*time.Second)
+		k, _ = l.take(keys, now.Add(61*time.Second))
+		So(k, ShouldBeNil)
+	})
+}
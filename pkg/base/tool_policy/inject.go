@@ -0,0 +1,122 @@
+package tool_policy
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// Injector 声明式参数注入：user_id、cookie、学期等由 Host 从已认证的请求上下文中填写，
+// 不交给模型决定，避免提示词注入借模型之手读取他人数据。nil Injector 不做任何改写
+type Injector struct {
+	rules []config.MCPArgInjection
+}
+
+// NewInjector 校验失败的规则会被跳过并在返回的错误中说明，其余规则照常生效
+func NewInjector(cfg []config.MCPArgInjection) (*Injector, error) {
+	var errs []error
+	inj := &Injector{}
+	for i, r := range cfg {
+		if err := validInjection(r); err != nil {
+			errs = append(errs, fmt.Errorf("tool_policy: skip inject#%d (%s): %w", i, r.Arg, err))
+			continue
+		}
+		if r.Mode == "" {
+			r.Mode = constant.ToolArgInjectOverride
+		}
+		inj.rules = append(inj.rules, r)
+	}
+	return inj, errors.Join(errs...)
+}
+
+func validInjection(r config.MCPArgInjection) error {
+	if r.Arg == "" || len(r.Tools) == 0 {
+		return fmt.Errorf("arg and tools are required")
+	}
+	switch r.Source {
+	case constant.ToolArgSourceUserID, constant.ToolArgSourceCookie, constant.ToolArgSourceTerm:
+	default:
+		return fmt.Errorf("unknown source %q", r.Source)
+	}
+	switch r.Mode {
+	case "", constant.ToolArgInjectOverride, constant.ToolArgInjectDefault:
+	default:
+		return fmt.Errorf("unknown mode %q", r.Mode)
+	}
+	for _, p := range r.Tools {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("bad tool pattern %q: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// Schema 返回模型看到的参数 schema：override 参数从 properties 与 required 中移除，
+// default 参数只从 required 中移除。不修改传入的 schema
+func (i *Injector) Schema(tool Tool, params map[string]any) map[string]any {
+	if i == nil || params == nil {
+		return params
+	}
+	var hidden, optional []string
+	for _, r := range i.rules {
+		if !matchTool(r.Tools, tool) {
+			continue
+		}
+		optional = append(optional, r.Arg)
+		if r.Mode == constant.ToolArgInjectOverride {
+			hidden = append(hidden, r.Arg)
+		}
+	}
+	if len(optional) == 0 {
+		return params
+	}
+
+	out := make(map[string]any, len(params))
+	for k, v := range params {
+		out[k] = v
+	}
+	if props, ok := params["properties"].(map[string]any); ok {
+		kept := make(map[string]any, len(props))
+		for k, v := range props {
+			if !slices.Contains(hidden, k) {
+				kept[k] = v
+			}
+		}
+		out["properties"] = kept
+	}
+	if required, ok := params["required"].([]any); ok {
+		out["required"] = slices.DeleteFunc(slices.Clone(required), func(v any) bool {
+			s, _ := v.(string)
+			return slices.Contains(optional, s)
+		})
+	}
+	return out
+}
+
+// Apply 按规则改写参数，values 为各来源（见 constant.ToolArgSource*）的取值。
+// 来源取不到值时，override 参数仍会删除模型给出的值，交由工具自身报缺参
+func (i *Injector) Apply(tool Tool, args map[string]any, values map[string]string) {
+	if i == nil {
+		return
+	}
+	for _, r := range i.rules {
+		if !matchTool(r.Tools, tool) {
+			continue
+		}
+		v := values[r.Source]
+		switch {
+		case r.Mode == constant.ToolArgInjectDefault:
+			if _, ok := args[r.Arg]; !ok && v != "" {
+				args[r.Arg] = v
+			}
+		case v == "":
+			delete(args, r.Arg)
+		default:
+			args[r.Arg] = v
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package tool_policy
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/FantasyRL/go-mcp-demo/config"
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+func Test_Injector(t *testing.T) {
+	Convey("Test argument injector", t, func() {
+		inj, err := NewInjector([]config.MCPArgInjection{
+			{Tools: []string{"get_todos", "get_course_*"}, Arg: "user_id", Source: constant.ToolArgSourceUserID},
+			{Tools: []string{"get_course_*"}, Arg: "term", Source: constant.ToolArgSourceTerm, Mode: constant.ToolArgInjectDefault},
+			{Tools: []string{"x"}, Arg: "y", Source: "password"},
+		})
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "password")
+
+		values := map[string]string{constant.ToolArgSourceUserID: "102301000", constant.ToolArgSourceTerm: "202501"}
+
+		Convey("schema hides override args and relaxes default args", func() {
+			params := map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"user_id": map[string]any{"type": "string"},
+					"term":    map[string]any{"type": "string"},
+				},
+				"required": []any{"user_id", "term"},
+			}
+			out := inj.Schema(ParseTool("get_course_local"), params)
+			So(out["properties"], ShouldContainKey, "term")
+			So(out["properties"], ShouldNotContainKey, "user_id")
+			So(out["required"], ShouldBeEmpty)
+			So(params["required"], ShouldHaveLength, 2)
+			So(inj.Schema(ParseTool("web_search"), params), ShouldEqual, params)
+		})
+
+		Convey("override replaces model supplied values, default only fills gaps", func() {
+			args := map[string]any{"user_id": "someone_else"}
+			inj.Apply(ParseTool("get_course_local"), args, values)
+			So(args["user_id"], ShouldEqual, "102301000")
+			So(args["term"], ShouldEqual, "202501")
+
+			args = map[string]any{"term": "202402"}
+			inj.Apply(ParseTool("get_course_local"), args, values)
+			So(args["term"], ShouldEqual, "202402")
+		})
+
+		Convey("namespaced tools are injected by their original name", func() {
+			tool := Tool{Exposed: "mcp_local__get_todos", Service: "mcp_local", Name: "get_todos"}
+			args := map[string]any{"user_id": "someone_else"}
+			inj.Apply(tool, args, values)
+			So(args["user_id"], ShouldEqual, "102301000")
+
+			params := map[string]any{"properties": map[string]any{"user_id": map[string]any{"type": "string"}}}
+			So(inj.Schema(tool, params)["properties"], ShouldBeEmpty)
+		})
+
+		Convey("missing source value drops the model supplied arg", func() {
+			args := map[string]any{"user_id": "someone_else"}
+			inj.Apply(ParseTool("get_todos"), args, nil)
+			So(args, ShouldNotContainKey, "user_id")
+		})
+	})
+}
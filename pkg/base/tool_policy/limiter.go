@@ -0,0 +1,61 @@
+package tool_policy
+
+import (
+	"sync"
+	"time"
+)
+
+// limitKey 一次调用需要计数的限流桶
+type limitKey struct {
+	key    string
+	limit  int
+	window time.Duration
+	rule   string
+}
+
+// limiter 进程内滑动窗口限流，多实例部署时每个实例各自计数
+type limiter struct {
+	mu      sync.Mutex
+	buckets map[string][]time.Time // 窗口内的调用时刻，按时间递增
+}
+
+func newLimiter() *limiter {
+	return &limiter{buckets: map[string][]time.Time{}}
+}
+
+// take 所有桶都有余量时在每个桶中记一次并返回 nil；
+// 否则不计数，返回第一个已满的桶及需要等待的时长
+func (l *limiter) take(keys []limitKey, now time.Time) (*limitKey, time.Duration) {
+	if len(keys) == 0 {
+		return nil, 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := range keys {
+		k := &keys[i]
+		calls := l.prune(k.key, now.Add(-k.window))
+		if len(calls) >= k.limit {
+			return k, calls[len(calls)-k.limit].Add(k.window).Sub(now)
+		}
+	}
+	for _, k := range keys {
+		l.buckets[k.key] = append(l.buckets[k.key], now)
+	}
+	return nil, 0
+}
+
+// prune 丢弃 since 之前的调用记录
+func (l *limiter) prune(key string, since time.Time) []time.Time {
+	calls := l.buckets[key]
+	i := 0
+	for i < len(calls) && !calls[i].After(since) {
+		i++
+	}
+	calls = calls[i:]
+	if len(calls) == 0 {
+		delete(l.buckets, key)
+		return nil
+	}
+	l.buckets[key] = calls
+	return calls
+}
@@ -0,0 +1,31 @@
+package tool_policy
+
+import (
+	"strings"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+// Tool 策略匹配的工具身份。暴露给模型的名字可能带 服务__ 前缀或是配置的别名，
+// 规则只按原始工具名（以及 服务/工具）匹配，不受命名空间配置影响
+type Tool struct {
+	Exposed string // 暴露给模型的名字，用于拒绝说明
+	Service string // 提供工具的服务，未知时为空
+	Name    string // 服务端的原始工具名
+}
+
+// ParseTool 无法从 MCP 客户端解析时的兜底：按 服务__工具 拆分暴露名
+func ParseTool(exposed string) Tool {
+	if svc, name, ok := strings.Cut(exposed, constant.MCPToolNamespaceSeparator); ok && svc != "" && name != "" {
+		return Tool{Exposed: exposed, Service: svc, Name: name}
+	}
+	return Tool{Exposed: exposed, Name: exposed}
+}
+
+// String 暴露名，未设置时为原始工具名
+func (t Tool) String() string {
+	if t.Exposed != "" {
+		return t.Exposed
+	}
+	return t.Name
+}
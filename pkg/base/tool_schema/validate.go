@@ -0,0 +1,294 @@
+// Package tool_schema 在 Host 侧按工具的 InputSchema 校验模型给出的参数，
+// 并修正模型常见的类型错误（数字写成字符串、数组写成 JSON 字符串等），
+// 校验失败的调用不再发往 MCP 服务
+package tool_schema
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bytedance/sonic"
+)
+
+// ValidationError 参数不满足 schema，Path 形如 "items[0].name"
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("argument %q %s", e.Path, e.Message)
+}
+
+// Validate 按 schema 校验 args，可修正的类型错误直接在 args 上改写；
+// 返回首个无法修正的问题，schema 为空时不做任何检查
+func Validate(schema map[string]any, args map[string]any) *ValidationError {
+	if len(schema) == 0 {
+		return nil
+	}
+	return validateObject(schema, args, "")
+}
+
+func validateObject(schema map[string]any, obj map[string]any, path string) *ValidationError {
+	props, _ := schema["properties"].(map[string]any)
+	for _, r := range asSlice(schema["required"]) {
+		name, _ := r.(string)
+		if v, ok := obj[name]; name != "" && (!ok || v == nil) {
+			return &ValidationError{Path: join(path, name), Message: "is required"}
+		}
+	}
+	for _, name := range sortedKeys(obj) {
+		p := join(path, name)
+		sub, ok := props[name].(map[string]any)
+		if !ok {
+			if additional, isBool := schema["additionalProperties"].(bool); isBool && !additional {
+				return &ValidationError{Path: p, Message: fmt.Sprintf("is not a known argument, expected one of %v", sortedKeys(props))}
+			}
+			continue
+		}
+		if obj[name] == nil && !allows(sub, "null") {
+			// 非必填参数传 null 视同未传
+			delete(obj, name)
+			continue
+		}
+		v, err := validate(sub, obj[name], p)
+		if err != nil {
+			return err
+		}
+		obj[name] = v
+	}
+	return nil
+}
+
+// validate 返回修正后的值
+func validate(schema map[string]any, v any, path string) (any, *ValidationError) {
+	if types := schemaTypes(schema); len(types) > 0 && !slices.ContainsFunc(types, func(t string) bool { return is(t, v) }) {
+		coerced, ok := coerce(types, v)
+		if !ok {
+			return nil, &ValidationError{Path: path, Message: fmt.Sprintf("must be %s, got %s", strings.Join(types, " or "), describe(v))}
+		}
+		v = coerced
+	}
+
+	if enum := asSlice(schema["enum"]); len(enum) > 0 && !slices.ContainsFunc(enum, func(e any) bool { return equal(e, v) }) {
+		return nil, &ValidationError{Path: path, Message: fmt.Sprintf("= %v is not one of %v", v, enum)}
+	}
+
+	switch t := v.(type) {
+	case string:
+		n := utf8.RuneCountInString(t)
+		if min, ok := number(schema["minLength"]); ok && float64(n) < min {
+			return nil, &ValidationError{Path: path, Message: fmt.Sprintf("must be at least %v characters", min)}
+		}
+		if max, ok := number(schema["maxLength"]); ok && float64(n) > max {
+			return nil, &ValidationError{Path: path, Message: fmt.Sprintf("must be at most %v characters", max)}
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(t) {
+				return nil, &ValidationError{Path: path, Message: fmt.Sprintf("= %q does not match %s", t, pattern)}
+			}
+		}
+	case float64:
+		if min, ok := number(schema["minimum"]); ok && t < min {
+			return nil, &ValidationError{Path: path, Message: fmt.Sprintf("= %v is below minimum %v", t, min)}
+		}
+		if max, ok := number(schema["maximum"]); ok && t > max {
+			return nil, &ValidationError{Path: path, Message: fmt.Sprintf("= %v exceeds maximum %v", t, max)}
+		}
+	case []any:
+		if min, ok := number(schema["minItems"]); ok && float64(len(t)) < min {
+			return nil, &ValidationError{Path: path, Message: fmt.Sprintf("must have at least %v items", min)}
+		}
+		if max, ok := number(schema["maxItems"]); ok && float64(len(t)) > max {
+			return nil, &ValidationError{Path: path, Message: fmt.Sprintf("must have at most %v items", max)}
+		}
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i := range t {
+				item, err := validate(items, t[i], fmt.Sprintf("%s[%d]", path, i))
+				if err != nil {
+					return nil, err
+				}
+				t[i] = item
+			}
+		}
+	case map[string]any:
+		if err := validateObject(schema, t, path); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// coerce 尝试把 v 转成 types 中的某个类型
+func coerce(types []string, v any) (any, bool) {
+	for _, t := range types {
+		switch t {
+		case "number", "integer":
+			n, ok := v.(float64)
+			if s, isStr := v.(string); isStr {
+				f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+				n, ok = f, err == nil
+			}
+			if ok && (t == "number" || n == math.Trunc(n)) {
+				return n, true
+			}
+		case "boolean":
+			if s, ok := v.(string); ok {
+				if b, err := strconv.ParseBool(strings.TrimSpace(s)); err == nil {
+					return b, true
+				}
+			}
+		case "string":
+			switch x := v.(type) {
+			case float64:
+				return strconv.FormatFloat(x, 'f', -1, 64), true
+			case bool:
+				return strconv.FormatBool(x), true
+			}
+		case "array":
+			if s, ok := v.(string); ok {
+				var arr []any
+				if err := sonic.UnmarshalString(s, &arr); err == nil {
+					return arr, true
+				}
+			}
+			if v != nil {
+				if _, isObj := v.(map[string]any); !isObj {
+					return []any{v}, true
+				}
+			}
+		case "object":
+			if s, ok := v.(string); ok {
+				var obj map[string]any
+				if err := sonic.UnmarshalString(s, &obj); err == nil && obj != nil {
+					return obj, true
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+func is(t string, v any) bool {
+	switch t {
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "number":
+		_, ok := v.(float64)
+		return ok
+	case "integer":
+		n, ok := v.(float64)
+		return ok && n == math.Trunc(n)
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "array":
+		_, ok := v.([]any)
+		return ok
+	case "object":
+		_, ok := v.(map[string]any)
+		return ok
+	case "null":
+		return v == nil
+	}
+	return true
+}
+
+func describe(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return fmt.Sprintf("string %q", x)
+	case float64:
+		return fmt.Sprintf("number %v", x)
+	case bool:
+		return fmt.Sprintf("boolean %v", x)
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func schemaTypes(schema map[string]any) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []any:
+		out := make([]string, 0, len(t))
+		for _, s := range t {
+			if s, ok := s.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func allows(schema map[string]any, t string) bool {
+	return slices.Contains(schemaTypes(schema), t)
+}
+
+func asSlice(v any) []any {
+	switch s := v.(type) {
+	case []any:
+		return s
+	case []string:
+		out := make([]any, len(s))
+		for i := range s {
+			out[i] = s[i]
+		}
+		return out
+	}
+	return nil
+}
+
+func number(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// equal enum 比较：数字统一按 float64 比较
+func equal(a, b any) bool {
+	if x, ok := number(a); ok {
+		y, ok := number(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,77 @@
+package tool_schema
+
+import (
+	"testing"
+
+	"github.com/bytedance/sonic"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const searchSchema = `{
+	"type": "object",
+	"properties": {
+		"query": {"type": "string", "maxLength": 10},
+		"max_results": {"type": "integer", "minimum": 1, "maximum": 10},
+		"safe": {"type": "boolean"},
+		"lang": {"type": "string", "enum": ["zh", "en"]},
+		"sites": {"type": "array", "items": {"type": "string"}},
+		"filter": {
+			"type": "object",
+			"properties": {"year": {"type": "integer"}},
+			"required": ["year"]
+		}
+	},
+	"required": ["query"],
+	"additionalProperties": false
+}`
+
+func Test_Validate(t *testing.T) {
+	Convey("Test tool argument validation", t, func() {
+		var schema map[string]any
+		So(sonic.UnmarshalString(searchSchema, &schema), ShouldBeNil)
+
+		Convey("coerces common model mistakes", func() {
+			args := map[string]any{
+				"query":       12345.0,
+				"max_results": "5",
+				"safe":        "true",
+				"sites":       `["a.com","b.com"]`,
+				"filter":      `{"year":"2025"}`,
+				"lang":        nil,
+			}
+			So(Validate(schema, args), ShouldBeNil)
+			So(args["query"], ShouldEqual, "12345")
+			So(args["max_results"], ShouldEqual, 5.0)
+			So(args["safe"], ShouldEqual, true)
+			So(args["sites"], ShouldResemble, []any{"a.com", "b.com"})
+			So(args["filter"], ShouldResemble, map[string]any{"year": 2025.0})
+			So(args, ShouldNotContainKey, "lang")
+
+			args = map[string]any{"query": "go", "sites": "a.com"}
+			So(Validate(schema, args), ShouldBeNil)
+			So(args["sites"], ShouldResemble, []any{"a.com"})
+		})
+
+		Convey("reports the offending argument", func() {
+			err := Validate(schema, map[string]any{})
+			So(err.Path, ShouldEqual, "query")
+			So(err.Message, ShouldEqual, "is required")
+
+			err = Validate(schema, map[string]any{"query": "go", "max_results": "many"})
+			So(err.Path, ShouldEqual, "max_results")
+			So(err.Error(), ShouldContainSubstring, `must be integer, got string "many"`)
+
+			So(Validate(schema, map[string]any{"query": "go", "max_results": 2.5}).Path, ShouldEqual, "max_results")
+			So(Validate(schema, map[string]any{"query": "go", "max_results": 50.0}).Message, ShouldContainSubstring, "exceeds maximum 10")
+			So(Validate(schema, map[string]any{"query": "a very long query"}).Message, ShouldContainSubstring, "at most 10")
+			So(Validate(schema, map[string]any{"query": "go", "lang": "fr"}).Path, ShouldEqual, "lang")
+			So(Validate(schema, map[string]any{"query": "go", "filter": map[string]any{}}).Path, ShouldEqual, "filter.year")
+			So(Validate(schema, map[string]any{"query": "go", "sites": []any{"a", map[string]any{}}}).Path, ShouldEqual, "sites[1]")
+			So(Validate(schema, map[string]any{"query": "go", "page": 2.0}).Message, ShouldContainSubstring, "not a known argument")
+		})
+
+		Convey("empty schema accepts anything", func() {
+			So(Validate(nil, map[string]any{"x": 1.0}), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,37 @@
+package tool_set
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/constant"
+)
+
+type progressTokenKey struct{}
+
+func withProgressToken(ctx context.Context, req mcp.CallToolRequest) context.Context {
+	if req.Params.Meta == nil || req.Params.Meta.ProgressToken == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, progressTokenKey{}, req.Params.Meta.ProgressToken)
+}
+
+// ReportProgress 在 AddTool 注册的 handler 内向调用方上报执行进度（notifications/progress），
+// total 未知时传 0；调用方没有携带进度令牌时什么也不做
+func ReportProgress(ctx context.Context, progress, total float64, message string) error {
+	token := ctx.Value(progressTokenKey{})
+	s := server.ServerFromContext(ctx)
+	if token == nil || s == nil {
+		return nil
+	}
+	params := map[string]any{"progressToken": token, "progress": progress}
+	if total > 0 {
+		params["total"] = total
+	}
+	if message != "" {
+		params["message"] = message
+	}
+	return s.SendNotificationToClient(ctx, constant.MCPNotificationProgress, params)
+}
@@ -0,0 +1,107 @@
+package tool_set
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/bytedance/sonic"
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/FantasyRL/go-mcp-demo/pkg/base/tool_schema"
+	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
+)
+
+// Handler 类型化的工具实现：In 由调用参数解码而来，Out 作为工具结果返回
+type Handler[In, Out any] func(ctx context.Context, in In) (Out, error)
+
+// Validator 输入结构体可实现该接口，补充 schema 表达不了的校验
+type Validator interface {
+	Validate() error
+}
+
+// AddTool 以类型化函数注册工具：
+//   - 输入 schema 由 In 的 json / jsonschema / jsonschema_description 标签生成，不带 omitempty 的字段为必填；
+//   - 参数先按 schema 校验并修正常见类型错误，再解码为 In；
+//   - Out 为结构体时同时声明输出 schema，以结构化内容返回并附带 JSON 文本；为 string 时原样作为文本返回；
+//   - 参数错误、handler 返回的 error 与 panic 均以 isError 结果返回给调用方；
+//   - handler 内可用 ReportProgress 上报进度
+func AddTool[In, Out any](ts *ToolSet, name, description string, h Handler[In, Out], opts ...mcp.ToolOption) {
+	opts = append([]mcp.ToolOption{mcp.WithDescription(description), mcp.WithInputSchema[In]()}, opts...)
+	structured := isStruct[Out]()
+	if structured {
+		opts = append(opts, mcp.WithOutputSchema[Out]())
+	}
+	tool := mcp.NewTool(name, opts...)
+
+	var schema map[string]any
+	if err := sonic.Unmarshal(tool.RawInputSchema, &schema); err != nil {
+		logger.Errorf("tool_set: %s input schema: %v", name, err)
+	}
+
+	ts.Tools = append(ts.Tools, &tool)
+	ts.HandlerFunc[name] = func(ctx context.Context, req mcp.CallToolRequest) (res *mcp.CallToolResult, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Errorf("tool_set: %s panic: %v", name, r)
+				res, err = mcp.NewToolResultError(fmt.Sprintf("tool %s failed unexpectedly", name)), nil
+			}
+		}()
+
+		in, err := decode[In](schema, req.GetArguments())
+		if err != nil {
+			return mcp.NewToolResultError("invalid arguments: " + err.Error()), nil
+		}
+		out, err := h(withProgressToken(ctx, req), in)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return toResult(out, structured)
+	}
+}
+
+func decode[In any](schema map[string]any, args map[string]any) (In, error) {
+	var in In
+	if args == nil {
+		args = map[string]any{}
+	}
+	if verr := tool_schema.Validate(schema, args); verr != nil {
+		return in, verr
+	}
+	data, err := sonic.Marshal(args)
+	if err != nil {
+		return in, err
+	}
+	if err := sonic.Unmarshal(data, &in); err != nil {
+		return in, err
+	}
+	if v, ok := any(&in).(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return in, err
+		}
+	}
+	return in, nil
+}
+
+func toResult(out any, structured bool) (*mcp.CallToolResult, error) {
+	if s, ok := out.(string); ok {
+		return mcp.NewToolResultText(s), nil
+	}
+	text, err := sonic.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("marshal result: %v", err)), nil
+	}
+	if structured {
+		return mcp.NewToolResultStructured(out, string(text)), nil
+	}
+	return mcp.NewToolResultText(string(text)), nil
+}
+
+// isStruct MCP 要求结构化内容为 JSON 对象，只有结构体输出才声明输出 schema
+func isStruct[T any]() bool {
+	t := reflect.TypeFor[T]()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
@@ -0,0 +1,92 @@
+package tool_set
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type echoInput struct {
+	Name  string `json:"name" jsonschema:"minLength=1" jsonschema_description:"名字"`
+	Times int    `json:"times,omitempty" jsonschema:"minimum=1,maximum=3"`
+}
+
+func (in *echoInput) Validate() error {
+	if in.Name == "nobody" {
+		return errors.New("name must not be nobody")
+	}
+	return nil
+}
+
+type echoOutput struct {
+	Words []string `json:"words"`
+}
+
+func call(ts *ToolSet, name string, args map[string]any) *mcp.CallToolResult {
+	req := mcp.CallToolRequest{}
+	req.Params.Name = name
+	req.Params.Arguments = args
+	res, err := ts.HandlerFunc[name](context.Background(), req)
+	So(err, ShouldBeNil)
+	return res
+}
+
+func text(res *mcp.CallToolResult) string {
+	return res.Content[0].(mcp.TextContent).Text
+}
+
+func Test_AddTool(t *testing.T) {
+	Convey("Test typed tool registration", t, func() {
+		ts := &ToolSet{HandlerFunc: map[string]server.ToolHandlerFunc{}}
+		AddTool(ts, "echo", "重复名字", func(ctx context.Context, in echoInput) (*echoOutput, error) {
+			if in.Name == "boom" {
+				return nil, errors.New("exploded")
+			}
+			out := &echoOutput{}
+			for range max(in.Times, 1) {
+				out.Words = append(out.Words, in.Name)
+			}
+			return out, nil
+		})
+		AddTool(ts, "hello", "问好", func(ctx context.Context, _ struct{}) (string, error) {
+			panic("oops")
+		})
+		So(ts.Tools, ShouldHaveLength, 2)
+
+		Convey("schema is derived from struct tags", func() {
+			var schema map[string]any
+			So(sonic.Unmarshal(ts.Tools[0].RawInputSchema, &schema), ShouldBeNil)
+			So(schema["required"], ShouldResemble, []any{"name"})
+			props := schema["properties"].(map[string]any)
+			So(props["name"].(map[string]any)["description"], ShouldEqual, "名字")
+			So(props["times"].(map[string]any)["type"], ShouldEqual, "integer")
+			So(ts.Tools[0].OutputSchema.Properties, ShouldContainKey, "words")
+		})
+
+		Convey("arguments are coerced and decoded, output is structured", func() {
+			res := call(ts, "echo", map[string]any{"name": "go", "times": "2"})
+			So(res.IsError, ShouldBeFalse)
+			So(res.StructuredContent, ShouldResemble, &echoOutput{Words: []string{"go", "go"}})
+			So(text(res), ShouldContainSubstring, `"words"`)
+		})
+
+		Convey("validation and handler errors become error results", func() {
+			res := call(ts, "echo", map[string]any{})
+			So(res.IsError, ShouldBeTrue)
+			So(text(res), ShouldContainSubstring, `"name" is required`)
+
+			So(text(call(ts, "echo", map[string]any{"name": "go", "times": 9.0})), ShouldContainSubstring, "exceeds maximum 3")
+			So(text(call(ts, "echo", map[string]any{"name": "nobody"})), ShouldContainSubstring, "must not be nobody")
+			So(text(call(ts, "echo", map[string]any{"name": "boom"})), ShouldEqual, "exploded")
+
+			res = call(ts, "hello", nil)
+			So(res.IsError, ShouldBeTrue)
+			So(text(res), ShouldContainSubstring, "failed unexpectedly")
+		})
+	})
+}
@@ -0,0 +1,29 @@
+package constant
+
+import "time"
+
+const (
+	AiProviderTypeOpenAI    = "openai"    // OpenAI 兼容接口（DashScope/DeepSeek/OpenAI...）
+	AiProviderTypeOllama    = "ollama"    // 本地 Ollama，经由其 OpenAI 兼容层调用
+	AiProviderTypeAnthropic = "anthropic" // Anthropic Messages API
+
+	AiRouteChat      = "chat"      // 普通对话
+	AiRouteVision    = "vision"    // 携带图片的对话
+	AiRouteSummarize = "summarize" // 对话总结/上下文压缩
+	AiRouteSchedule  = "schedule"  // 每日日程生成
+
+	AiLegacyVisionModel = "qwen3-vl-flash" // 旧配置（未声明 providers）下携带图片的对话使用的模型
+
+	AiProviderDefaultRequestTimeout = 60 * time.Second // 单次模型请求默认超时
+
+	AiRetryDefaultMaxAttempts        = 3                      // 单个模型默认最多调用次数（含首次）
+	AiRetryDefaultBaseDelay          = 500 * time.Millisecond // 默认首次重试退避
+	AiRetryDefaultMaxDelay           = 10 * time.Second       // 默认单次等待上限
+	AiBreakerDefaultFailureThreshold = 5                      // 默认连续失败多少次后熔断
+	AiBreakerDefaultOpenTimeout      = 30 * time.Second       // 默认熔断持续时间
+
+	AnthropicDefaultBaseURL   = "https://api.anthropic.com"
+	AnthropicAPIVersion       = "2023-06-01" // anthropic-version 请求头
+	AnthropicDefaultMaxTokens = 4096         // Messages API 要求必填 max_tokens
+	AnthropicStatusOverloaded = 529          // Anthropic 服务过载时的状态码
+)
@@ -0,0 +1,10 @@
+package constant
+
+const (
+	ContextDefaultWindow                    = 32768 // 未配置的模型默认上下文窗口(token)
+	ContextDefaultReserveTokens             = 2048  // 默认为模型输出预留的 token
+	ContextDefaultToolResultMaxChars        = 16000 // 单条工具结果进入上下文的默认最大字符数
+	ContextDefaultHistoryToolResultMaxChars = 2000  // 历史轮次中工具结果默认保留的最大字符数
+	ContextCompactTargetRatio               = 0.6   // 压缩后的历史占预算的比例，留出余量避免每轮都重新摘要
+	ContextMessageOverheadTokens            = 4     // 每条消息的角色/分隔符等固定开销
+)
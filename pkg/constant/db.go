@@ -11,7 +11,12 @@ const (
 
 // Expire Time
 const (
-	CourseTermsKeyExpire = 3 * ONE_DAY // [course] 学期列表
-	TermInfoKeyExpire    = 7 * ONE_DAY // [common] 学期详细信息
-	DailyScheduleExpire  = 1 * ONE_DAY // [schedule] 每日日程缓存
+	CourseTermsKeyExpire = 3 * ONE_DAY     // [course] 学期列表
+	TermInfoKeyExpire    = 7 * ONE_DAY     // [common] 学期详细信息
+	DailyScheduleExpire  = 1 * ONE_DAY     // [schedule] 每日日程缓存
+	ChatStreamExpire     = 10 * ONE_MINUTE // [chat] 流式事件缓冲，超时后无法再断点续传
+	ChatStreamLockExpire = 5 * ONE_MINUTE  // [chat] 对话生成锁，同时也是单轮生成的最长时间
+	ChatStreamSeqExpire  = 7 * ONE_DAY     // [chat] 对话事件 id 计数器，对话闲置超过该时间后 id 重新计数
+	AiResponseExpire     = 1 * ONE_DAY     // [ai] 模型响应缓存默认有效期
+	ChatApprovalGrace    = 1 * ONE_MINUTE  // [chat] 待确认状态在确认截止后再保留的时间，截止时刚到的决定仍按超时回填
 )
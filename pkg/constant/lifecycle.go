@@ -0,0 +1,10 @@
+package constant
+
+import "time"
+
+const (
+	ShutdownDefaultTimeout = 20 * time.Second // 排空进行中请求的默认时限，超时后取消剩余请求
+
+	ProbeLivenessPath  = "/healthz" // 存活探针：进程能响应即返回 200
+	ProbeReadinessPath = "/readyz"  // 就绪探针：启动完成且未进入关闭流程时返回 200
+)
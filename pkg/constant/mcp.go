@@ -8,9 +8,45 @@ const (
 	MCPTransportHTTP           = "http"           // MCP基于http连接
 	MCPClientInitTimeout       = 10 * time.Second // MCP客户端初始化超时时间
 	MCPDefaultCallTimeout      = 30 * time.Second // MCP调用默认超时时间
+	MCPDefaultToolConcurrency  = 4                // 同一轮内工具调用默认并发数
 	MCPServerHeartbeatInterval = 25 * time.Second // MCP服务器心跳间隔
+	MCPPingTimeout             = 5 * time.Second  // 会话存活检查超时
+	MCPReconnectBaseDelay      = 1 * time.Second  // 会话失效后首次重连等待
+	MCPReconnectMaxDelay       = 30 * time.Second // 重连退避上限
+	MCPCancelNotifyTimeout     = 3 * time.Second  // 发送 notifications/cancelled 的超时
 
-	AiProviderModeLocal   = "local"  // 本地模型
-	AiProviderModeRemote  = "remote" // 远程模型
-	FzuHelperServerMCPUrl = "https://fzuhelper.west2.online/mcp"
+	MCPNotificationProgress  = "notifications/progress"    // 工具执行进度通知
+	MCPNotificationCancelled = "notifications/cancelled"   // 调用方放弃请求的通知
+	MCPResourceTodos         = "todo://{user_id}"          // 用户待办事项资源的 URI 模板
+	MCPResourceTimetable     = "course://{user_id}/{term}" // 用户缓存课表资源的 URI 模板
+	MCPTrustedArgUserID      = "user_id"                   // 提示词参数与资源 URI 变量：由 Host 按登录用户填写，不对用户展示
+	MCPTrustedArgTerm        = "term"                      // 提示词参数与资源 URI 变量：用户未给出时由 Host 填写当前学期
+	MCPMetaRequestID         = "go-mcp-demo/jsonrpc_id"    // 服务端写入工具调用 _meta 的 JSON-RPC 请求 ID，用于按 ID 取消
+
+	MCPBalanceRoundRobin     = "round_robin"     // 轮询
+	MCPBalanceLeastInFlight  = "least_inflight"  // 在途调用最少
+	MCPBalanceWeighted       = "weighted"        // 按注册元信息中的 weight 加权随机
+	MCPBalanceConsistentHash = "consistent_hash" // 按 user_id 一致性哈希，同一用户固定落到同一实例
+	MCPHealthRecoverWindow   = 30 * time.Second  // 实例健康分从 0 恢复到满分所需时间
+	MCPHealthMinScore        = 0.3               // 健康分低于该值的实例不参与选择（全部低于时仍会选择）
+
+	MCPToolNamespaceSeparator = "__"        // 工具全名中服务名与工具名的分隔符
+	MCPToolNamespaceCollision = "collision" // 仅同名冲突时使用全名
+	MCPToolNamespaceAlways    = "always"    // 总是使用全名
+
+	ToolPolicyDefaultAllow          = "allow"          // 未命中 allow 列表时放行
+	ToolPolicyDefaultDeny           = "deny"           // 未命中 allow 列表时拒绝
+	ToolPolicyEndpointChat          = "chat"           // 通用对话（流式/非流式）
+	ToolPolicyEndpointLocalChat     = "local_chat"     // 本地模型对话
+	ToolPolicyEndpointDailySchedule = "daily_schedule" // 每日日程生成
+	ToolApprovalDefaultTimeout      = 5 * time.Minute  // 等待用户确认工具调用的默认时限
+
+	ToolArgSourceUserID   = "user_id"  // 当前登录用户的学号
+	ToolArgSourceCookie   = "cookie"   // 当前登录用户的教务处 cookie
+	ToolArgSourceTerm     = "term"     // 当前学期代码，如 202501
+	ToolArgInjectOverride = "override" // 总是覆盖，并对模型隐藏该参数
+	ToolArgInjectDefault  = "default"  // 仅在模型未给出时填写
+
+	AiProviderModeLocal  = "local"  // 本地模型
+	AiProviderModeRemote = "remote" // 远程模型
 )
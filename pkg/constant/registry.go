@@ -7,11 +7,22 @@ const (
 	RegistryProviderEtcd   = "etcd"
 	RegistryProviderNacos  = "nacos"
 	RegistryProviderNone   = "none"
+	RegistryProviderStatic = "static"
 
 	RegistryMCPTag         = "mcp"
 	RegistryMCPDefaultPath = "/mcp"
 
+	RegistryMetaAddr   = "addr"   // 注册元信息：实例地址
+	RegistryMetaWeight = "weight" // 注册元信息：负载均衡权重
+
+	RegistryEtcdDefaultPrefix      = "/go-mcp-demo/services" // etcd 实例键前缀，键为 前缀/服务名/实例ID
+	RegistryEtcdDefaultDialTimeout = 5 * time.Second
+	RegistryNacosDefaultGroup      = "DEFAULT_GROUP"
+	RegistryNacosBeatInterval      = 5 * time.Second // Nacos 临时实例心跳间隔，服务端 15s 未收到心跳标记为不健康
+	RegistryNacosRequestTimeout    = 5 * time.Second
+
 	RegistryCheckInterval                  = 5 * time.Second
 	RegistryDeregisterAfter                = 15 * time.Second
 	RegistryResolverDefaultRefreshInterval = 10 * time.Second
+	RegistryStaticReloadDebounce           = 100 * time.Millisecond // 静态列表文件变更后等待写入完成再重新载入
 )
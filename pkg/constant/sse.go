@@ -1,9 +1,22 @@
 package constant
 
 const (
-	SSEEventDelta         = "delta"           // 模型内容增量
-	SSEEventDone          = "done"            // 流结束事件
-	SSEEventStartToolCall = "start_tool_call" // 开始工具调用
-	SSEEventToolCall      = "tool_call"       // 工具调用
-	SSEEventToolResult    = "tool_result"     // 工具调用结果
+	SSEEventDelta         = "delta"             // 模型内容增量
+	SSEEventDone          = "done"              // 流结束事件
+	SSEEventStartToolCall = "start_tool_call"   // 开始工具调用
+	SSEEventToolCall      = "tool_call"         // 工具调用
+	SSEEventToolResult    = "tool_result"       // 工具调用结果
+	SSEEventToolProgress  = "tool_progress"     // 工具执行进度，由 MCP 服务端的 notifications/progress 转发
+	SSEEventError         = "error"             // 生成出错，流随之结束
+	SSEEventUsage         = "usage"             // token 用量，done 之前发送
+	SSEEventHeartbeat     = "heartbeat"         // 空闲保活，不带 id，不参与续传
+	SSEEventApproval      = "approval_required" // 工具调用等待用户确认，生成随之暂停
+)
+
+// SSEPayloadVersion 事件负载的 schema 版本，负载字段出现不兼容变更时递增
+const SSEPayloadVersion int32 = 1
+
+const (
+	SSEChatIdleTimeout   = 2 * ONE_MINUTE  // 续传连接长时间收不到新事件时断开
+	SSEHeartbeatInterval = 15 * ONE_SECOND // 空闲时 heartbeat 事件的发送间隔
 )
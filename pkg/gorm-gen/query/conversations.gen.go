@@ -33,6 +33,8 @@ func newConversations(db *gorm.DB, opts ...gen.DOOption) conversations {
 	_conversations.Messages = field.NewString(tableName, "messages")
 	_conversations.IsSummarized = field.NewInt16(tableName, "is_summarized")
 	_conversations.Title = field.NewString(tableName, "title")
+	_conversations.CompactSummary = field.NewString(tableName, "compact_summary")
+	_conversations.CompactedUntil = field.NewInt32(tableName, "compacted_until")
 	_conversations.CreatedAt = field.NewTime(tableName, "created_at")
 	_conversations.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_conversations.DeletedAt = field.NewField(tableName, "deleted_at")
@@ -45,15 +47,17 @@ func newConversations(db *gorm.DB, opts ...gen.DOOption) conversations {
 type conversations struct {
 	conversationsDo conversationsDo
 
-	ALL          field.Asterisk
-	ID           field.String // 对话ID
-	UserID       field.String // 用户ID
-	Messages     field.String // 对话消息，JSON格式存储
-	IsSummarized field.Int16  // 是否已生成摘要，0-否，1-是
-	Title        field.String // 对话标题
-	CreatedAt    field.Time   // 创建时间
-	UpdatedAt    field.Time   // 更新时间
-	DeletedAt    field.Field  // 删除时间
+	ALL            field.Asterisk
+	ID             field.String // 对话ID
+	UserID         field.String // 用户ID
+	Messages       field.String // 对话消息，JSON格式存储
+	IsSummarized   field.Int16  // 是否已生成摘要，0-否，1-是
+	Title          field.String // 对话标题
+	CompactSummary field.String // 上下文压缩生成的滚动摘要，与用户手动生成的总结分开存放
+	CompactedUntil field.Int32  // 滚动摘要覆盖的消息数，即 messages 中未被覆盖的第一条消息的下标
+	CreatedAt      field.Time   // 创建时间
+	UpdatedAt      field.Time   // 更新时间
+	DeletedAt      field.Field  // 删除时间
 
 	fieldMap map[string]field.Expr
 }
@@ -75,6 +79,8 @@ func (c *conversations) updateTableName(table string) *conversations {
 	c.Messages = field.NewString(table, "messages")
 	c.IsSummarized = field.NewInt16(table, "is_summarized")
 	c.Title = field.NewString(table, "title")
+	c.CompactSummary = field.NewString(table, "compact_summary")
+	c.CompactedUntil = field.NewInt32(table, "compacted_until")
 	c.CreatedAt = field.NewTime(table, "created_at")
 	c.UpdatedAt = field.NewTime(table, "updated_at")
 	c.DeletedAt = field.NewField(table, "deleted_at")
@@ -106,12 +112,14 @@ func (c *conversations) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (c *conversations) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 8)
+	c.fieldMap = make(map[string]field.Expr, 10)
 	c.fieldMap["id"] = c.ID
 	c.fieldMap["user_id"] = c.UserID
 	c.fieldMap["messages"] = c.Messages
 	c.fieldMap["is_summarized"] = c.IsSummarized
 	c.fieldMap["title"] = c.Title
+	c.fieldMap["compact_summary"] = c.CompactSummary
+	c.fieldMap["compacted_until"] = c.CompactedUntil
 	c.fieldMap["created_at"] = c.CreatedAt
 	c.fieldMap["updated_at"] = c.UpdatedAt
 	c.fieldMap["deleted_at"] = c.DeletedAt
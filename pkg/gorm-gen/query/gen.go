@@ -20,6 +20,8 @@ var (
 	Conversations *conversations
 	Summaries     *summaries
 	Todolists     *todolists
+	TokenUsages   *tokenUsages
+	ToolPolicies  *toolPolicies
 	Users         *users
 )
 
@@ -28,6 +30,8 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	Conversations = &Q.Conversations
 	Summaries = &Q.Summaries
 	Todolists = &Q.Todolists
+	TokenUsages = &Q.TokenUsages
+	ToolPolicies = &Q.ToolPolicies
 	Users = &Q.Users
 }
 
@@ -37,6 +41,8 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		Conversations: newConversations(db, opts...),
 		Summaries:     newSummaries(db, opts...),
 		Todolists:     newTodolists(db, opts...),
+		TokenUsages:   newTokenUsages(db, opts...),
+		ToolPolicies:  newToolPolicies(db, opts...),
 		Users:         newUsers(db, opts...),
 	}
 }
@@ -47,6 +53,8 @@ type Query struct {
 	Conversations conversations
 	Summaries     summaries
 	Todolists     todolists
+	TokenUsages   tokenUsages
+	ToolPolicies  toolPolicies
 	Users         users
 }
 
@@ -58,6 +66,8 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		Conversations: q.Conversations.clone(db),
 		Summaries:     q.Summaries.clone(db),
 		Todolists:     q.Todolists.clone(db),
+		TokenUsages:   q.TokenUsages.clone(db),
+		ToolPolicies:  q.ToolPolicies.clone(db),
 		Users:         q.Users.clone(db),
 	}
 }
@@ -76,6 +86,8 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		Conversations: q.Conversations.replaceDB(db),
 		Summaries:     q.Summaries.replaceDB(db),
 		Todolists:     q.Todolists.replaceDB(db),
+		TokenUsages:   q.TokenUsages.replaceDB(db),
+		ToolPolicies:  q.ToolPolicies.replaceDB(db),
 		Users:         q.Users.replaceDB(db),
 	}
 }
@@ -84,6 +96,8 @@ type queryCtx struct {
 	Conversations IConversationsDo
 	Summaries     ISummariesDo
 	Todolists     ITodolistsDo
+	TokenUsages   ITokenUsagesDo
+	ToolPolicies  IToolPoliciesDo
 	Users         IUsersDo
 }
 
@@ -92,6 +106,8 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		Conversations: q.Conversations.WithContext(ctx),
 		Summaries:     q.Summaries.WithContext(ctx),
 		Todolists:     q.Todolists.WithContext(ctx),
+		TokenUsages:   q.TokenUsages.WithContext(ctx),
+		ToolPolicies:  q.ToolPolicies.WithContext(ctx),
 		Users:         q.Users.WithContext(ctx),
 	}
 }
@@ -2,12 +2,14 @@ package utils
 
 import (
 	"errors"
+	"fmt"
 	"github.com/FantasyRL/go-mcp-demo/config"
 	"github.com/FantasyRL/go-mcp-demo/pkg/logger"
 	"net"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // GetAvailablePort 会尝试获取可用的监听地址
@@ -121,3 +123,16 @@ func ParseCookies(rawData string) []*http.Cookie {
 
 	return cookies
 }
+
+// CurrentTerm 返回 t 所在的学期代码 YYYYSS：8 月至次年 1 月为 YYYY01（秋季学期），2 月至 7 月为 (YYYY-1)02（春季学期）
+func CurrentTerm(t time.Time) string {
+	year, month := t.Year(), t.Month()
+	switch {
+	case month >= time.August:
+		return fmt.Sprintf("%d01", year)
+	case month >= time.February:
+		return fmt.Sprintf("%d02", year-1)
+	default:
+		return fmt.Sprintf("%d01", year-1)
+	}
+}